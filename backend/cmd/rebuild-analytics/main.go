@@ -0,0 +1,74 @@
+// backend/cmd/rebuild-analytics/main.go
+//
+// Rebuilds the analytics read model from orders and refunds. Run it once
+// after deploying the fact tables, or whenever the facts are suspected to
+// have drifted:
+//
+//	go run ./cmd/rebuild-analytics              # every event
+//	go run ./cmd/rebuild-analytics -event <id>  # a single event
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/eventify/backend/pkg/analytics"
+	"github.com/eventify/backend/pkg/db"
+	serviceanalytics "github.com/eventify/backend/pkg/services/analytics"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const serviceName = "rebuild-analytics"
+
+func main() {
+	eventFlag := flag.String("event", "", "rebuild only this event ID")
+	timeout := flag.Duration("timeout", time.Hour, "give up after this long")
+	flag.Parse()
+
+	utils.InitLogger()
+
+	var eventID uuid.UUID
+	if *eventFlag != "" {
+		id, err := uuid.Parse(*eventFlag)
+		if err != nil {
+			utils.LogError(serviceName, "args", "Invalid event ID", err)
+			os.Exit(2)
+		}
+		eventID = id
+	}
+
+	if err := run(eventID, *timeout); err != nil {
+		utils.LogError(serviceName, "rebuild", "Analytics rebuild failed", err)
+		os.Exit(1)
+	}
+}
+
+// run rebuilds one event, or every event when eventID is zero
+func run(eventID uuid.UUID, timeout time.Duration) error {
+	db.ConnectDB()
+	defer db.CloseDB()
+
+	service := serviceanalytics.NewAnalyticsService(analytics.NewPostgresAnalyticsRepository(db.GetDB()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	if eventID != uuid.Nil {
+		if err := service.RefreshEventFacts(ctx, eventID); err != nil {
+			return err
+		}
+		log.Info().Str("event_id", eventID.String()).Dur("took", time.Since(start)).Msg("📊 Event facts rebuilt")
+		return nil
+	}
+
+	rebuilt, err := service.RebuildFacts(ctx)
+	log.Info().Int("events", rebuilt).Dur("took", time.Since(start)).Msg("📊 Analytics facts rebuilt")
+	return err
+}
@@ -18,35 +18,52 @@ import (
 
 	// Repositories (aliased)
 	repoauth "github.com/eventify/backend/pkg/repository/auth"
+	repobooking "github.com/eventify/backend/pkg/repository/booking"
 	repoevent "github.com/eventify/backend/pkg/repository/event"
+	repoexport "github.com/eventify/backend/pkg/repository/export"
 	repofeedback "github.com/eventify/backend/pkg/repository/feedback"
 	repoinquiries "github.com/eventify/backend/pkg/repository/inquiries"
 	repolike "github.com/eventify/backend/pkg/repository/like"
+	repomedia "github.com/eventify/backend/pkg/repository/media"
 	repoorder "github.com/eventify/backend/pkg/repository/order"
+	reporecommendation "github.com/eventify/backend/pkg/repository/recommendation"
 	reporeview "github.com/eventify/backend/pkg/repository/review"
+	repotracking "github.com/eventify/backend/pkg/repository/tracking"
 	repovendor "github.com/eventify/backend/pkg/repository/vendor"
 
 	// Services (aliased)
 	serviceanalytics "github.com/eventify/backend/pkg/services/analytics"
 	serviceevent "github.com/eventify/backend/pkg/services/event"
+	serviceexport "github.com/eventify/backend/pkg/services/export"
 	servicefeedback "github.com/eventify/backend/pkg/services/feedback"
 	serviceinquiries "github.com/eventify/backend/pkg/services/inquiries"
+	"github.com/eventify/backend/pkg/services/geocoding"
 	servicejwt "github.com/eventify/backend/pkg/services/jwt"
 	serviceauth "github.com/eventify/backend/pkg/services/auth"
+	servicebooking "github.com/eventify/backend/pkg/services/booking"
 	servicelike "github.com/eventify/backend/pkg/services/like"
+	servicemedia "github.com/eventify/backend/pkg/services/media"
 	serviceorder "github.com/eventify/backend/pkg/services/order"
 	servicepricing "github.com/eventify/backend/pkg/services/pricing"
+	servicerecommendation "github.com/eventify/backend/pkg/services/recommendation"
 	servicereview "github.com/eventify/backend/pkg/services/review"
+	"github.com/eventify/backend/pkg/services/storage"
+	servicetracking "github.com/eventify/backend/pkg/services/tracking"
 	servicevendor "github.com/eventify/backend/pkg/services/vendor"
 
 	// Handlers (aliased)
 	handleranalytics "github.com/eventify/backend/pkg/handlers/analytics"
 	handlerauth "github.com/eventify/backend/pkg/handlers/auth"
+	handlerbooking "github.com/eventify/backend/pkg/handlers/booking"
 	handlerevent "github.com/eventify/backend/pkg/handlers/event"
+	handlerexport "github.com/eventify/backend/pkg/handlers/export"
 	handlerfeedback "github.com/eventify/backend/pkg/handlers/feedback"
 	handlerinquiries "github.com/eventify/backend/pkg/handlers/inquiries"
+	handlermedia "github.com/eventify/backend/pkg/handlers/media"
 	handlerorder "github.com/eventify/backend/pkg/handlers/order"
+	handlerrecommendation "github.com/eventify/backend/pkg/handlers/recommendation"
 	handlerreview "github.com/eventify/backend/pkg/handlers/review"
+	handlertracking "github.com/eventify/backend/pkg/handlers/tracking"
 	handlervendor "github.com/eventify/backend/pkg/handlers/vendor"
 
 	"github.com/gin-gonic/gin"
@@ -147,11 +164,17 @@ func main() {
 	feedbackRepo := repofeedback.NewFeedbackRepository(dbClient)
 	orderRepo := repoorder.NewPostgresOrderRepository(dbClient)
 	eventRepo := repoevent.NewPostgresEventRepository(dbClient)
+	assetRepo := repomedia.NewPostgresAssetRepository(dbClient)
+	recommendationRepo := reporecommendation.NewPostgresRecommendationRepository(dbClient)
+	trackingRepo := repotracking.NewPostgresTrackingRepository(dbClient)
+	exportRepo := repoexport.NewPostgresExportRepository(dbClient)
+	bookingRepo := repobooking.NewPostgresBookingRepository(dbClient)
 
 	analyticsRepo := analytics.NewPostgresAnalyticsRepository(dbClient)
 	vendorCoreMetricsRepo := repovendor.NewVendorCoreMetricsRepository(dbClient)
 	vendorMetricsRepo := repovendor.NewVendorMetricsRepository(dbClient)
 	vendorDataRepo := repovendor.NewVendorDataRepository(dbClient)
+	vendorBenchmarkRepo := repovendor.NewVendorBenchmarkRepository(dbClient)
 
 	utils.LogSuccess(serviceName, "repositories", "All repositories initialized")
 
@@ -159,17 +182,23 @@ func main() {
 	// STEP 6: SERVICE INITIALIZATION
 	// ============================================================================
 	authService := serviceauth.NewAuthService(authRepo, refreshTokenRepo, jwtService) 
-	eventService := serviceevent.NewEventService(dbClient, eventRepo)
+	blobStore := storage.NewBlobStoreFromEnv()
+	mediaService := servicemedia.NewMediaService(assetRepo, blobStore)
+	trackingService := servicetracking.NewTrackingService(trackingRepo)
+	eventService := serviceevent.NewEventService(dbClient, eventRepo, likeRepo, geocoding.NewGeocoderFromEnv(), mediaService)
 	likeService := servicelike.NewLikeService(likeRepo)
-	vendorService := servicevendor.NewVendorService(vendorRepo)
+	vendorService := servicevendor.NewVendorService(vendorRepo, mediaService)
 	reviewService := servicereview.NewReviewService(reviewRepo, vendorRepo, inquiryRepo)
 	inquiryService := serviceinquiries.NewInquiryService(inquiryRepo, inquiryRepo, vendorRepo)
-	feedbackService := servicefeedback.NewFeedbackService(feedbackRepo)
+	feedbackService := servicefeedback.NewFeedbackService(feedbackRepo, mediaService)
 	analyticsService := serviceanalytics.NewAnalyticsService(analyticsRepo)
+	recommendationService := servicerecommendation.NewRecommendationService(recommendationRepo)
+	bookingService := servicebooking.NewBookingService(bookingRepo, inquiryRepo)
 	vendorAnalyticsService := servicevendor.NewVendorAnalyticsService(
 		vendorCoreMetricsRepo,
 		vendorMetricsRepo,
 		vendorDataRepo,
+		vendorBenchmarkRepo,
 	)
 
 	paystackClient := &serviceorder.PaystackClientImpl{
@@ -178,12 +207,16 @@ func main() {
 		FrontendBaseURL: os.Getenv("FRONTEND_URL"),
 	}
 
+	exportService := serviceexport.NewExportService(exportRepo, orderRepo, analyticsService, vendorAnalyticsService)
+
 	pricingService := servicepricing.NewPricingService(eventRepo)
 	orderService := serviceorder.NewOrderService(
 		orderRepo,
 		eventRepo,
 		pricingService,
 		paystackClient,
+		trackingService,
+		analyticsService,
 	)
 
 	utils.LogSuccess(serviceName, "services", "All services initialized")
@@ -192,14 +225,19 @@ func main() {
 	// STEP 7: HANDLER INITIALIZATION
 	// ============================================================================
 	authHandler := handlerauth.NewAuthHandler(authService)
-	eventHandler := handlerevent.NewEventHandler(eventService, likeService)
+	eventHandler := handlerevent.NewEventHandler(eventService, likeService, trackingService)
 	vendorHandler := handlervendor.NewVendorHandler(vendorService)
 	reviewHandler := handlerreview.NewReviewHandler(reviewService)
 	inquiryHandler := handlerinquiries.NewInquiryHandler(inquiryService)
 	feedbackHandler := handlerfeedback.NewFeedbackHandler(feedbackService)
 	orderHandler := handlerorder.NewOrderHandler(orderService)
-	analyticsHandler := handleranalytics.NewAnalyticsHandler(analyticsService)
-	vendorAnalyticsHandler := handlervendor.NewVendorAnalyticsHandler(vendorAnalyticsService)
+	analyticsHandler := handleranalytics.NewAnalyticsHandler(analyticsService, exportService)
+	vendorAnalyticsHandler := handlervendor.NewVendorAnalyticsHandler(vendorAnalyticsService, exportService)
+	mediaHandler := handlermedia.NewMediaHandler(mediaService)
+	recommendationHandler := handlerrecommendation.NewRecommendationHandler(recommendationService)
+	trackingHandler := handlertracking.NewTrackingHandler(trackingService)
+	exportHandler := handlerexport.NewExportHandler(exportService)
+	bookingHandler := handlerbooking.NewBookingHandler(bookingService)
 
 	utils.LogSuccess(serviceName, "handlers", "All handlers initialized")
 
@@ -208,6 +246,17 @@ func main() {
 // ============================================================================
 startTokenCleanup(refreshTokenRepo, authRepo) 
 go orderService.StartStockReleaseWorker(context.Background(), 1*time.Minute, 15*time.Minute)
+go eventService.StartSeriesMaterializer(context.Background(), 1*time.Hour)
+go eventService.StartScheduledPublisher(context.Background(), 1*time.Minute)
+go eventService.StartTrendingMaterializer(context.Background(), 15*time.Minute)
+go trackingService.StartRollupWorker(context.Background(), 5*time.Minute)
+go orderService.StartRefundWorker(context.Background(), 30*time.Second)
+go recommendationService.StartRecommendationWorker(context.Background(), 1*time.Hour)
+go exportService.StartExportWorker(context.Background(), 5*time.Second)
+go analyticsService.StartFactsRefresher(context.Background(), 5*time.Minute)
+go vendorAnalyticsService.StartPVSSnapshotWorker(context.Background(), 1*time.Hour)
+go vendorAnalyticsService.StartBenchmarkWorker(context.Background(), 6*time.Hour)
+go vendorService.StartVisitorCleanupWorker(context.Background(), 6*time.Hour)
 
 	// ============================================================================
 	// STEP 9: ROUTER CONFIGURATION
@@ -225,6 +274,12 @@ go orderService.StartStockReleaseWorker(context.Background(), 1*time.Minute, 15*
 		vendorAnalyticsHandler,
 		jwtService,
 		authService,
+		mediaHandler,
+		blobStore,
+		recommendationHandler,
+		trackingHandler,
+		exportHandler,
+		bookingHandler,
 	)
 
 	utils.LogSuccess(serviceName, "router", "Router configured with all endpoints")
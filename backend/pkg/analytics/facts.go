@@ -0,0 +1,349 @@
+// backend/pkg/analytics/facts.go
+// Analytics read model - per-event fact tables rebuilt from orders
+package analytics
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+
+	"github.com/google/uuid"
+)
+
+// FactsTimezone is the zone whose calendar dates the daily facts use
+const FactsTimezone = "Africa/Lagos"
+
+// factsDay buckets an order on the day it was paid, or created if unpaid
+const factsDay = `(COALESCE(o.paid_at, o.created_at) AT TIME ZONE '` + FactsTimezone + `')::date`
+
+// refundCompleted matches orders whose refund Paystack has paid out. The
+// order only turns 'refunded' once its tickets are released, so until then
+// it still says 'success' and would otherwise keep counting as a sale.
+// 'refunded' is the one refund state where money went back; 'submitted'
+// refunds may still fail, and 'failed' ones leave the sale standing. Refunds are per event, so only
+// the refund of the event being refreshed ($1) counts; a multi-event order
+// stays a sale for the events that kept theirs.
+const refundCompleted = `EXISTS (
+	SELECT 1 FROM order_refunds rf
+	WHERE rf.order_id = o.id AND rf.event_id = $1 AND rf.status = 'refunded'
+)`
+
+// soldOrder matches orders that still count as sales
+const soldOrder = `o.status = 'success' AND NOT ` + refundCompleted
+
+// ============================================================================
+// REFRESH
+// ============================================================================
+
+// RefreshEventFacts recomputes every fact row for one event from its orders
+// and refunds. The event's rows are replaced in a single transaction, so
+// readers never see a half-built event.
+func (r *PostgresAnalyticsRepository) RefreshEventFacts(
+	ctx context.Context,
+	eventID uuid.UUID,
+) error {
+
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Serialise refreshes of the same event; two concurrent rebuilds would
+	// otherwise both insert after both deleted
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1::text))`, eventID); err != nil {
+		return fmt.Errorf("failed to lock event facts: %w", err)
+	}
+
+	for _, table := range []string{"analytics_event_daily", "analytics_tier_daily", "analytics_event_channels"} {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE event_id = $1`, eventID); err != nil {
+			return fmt.Errorf("failed to clear %s: %w", table, err)
+		}
+	}
+
+	dailyQuery := `
+		WITH event_orders AS (
+			SELECT
+				o.id,
+				CASE WHEN ` + refundCompleted + ` THEN 'refunded' ELSE o.status END as status,
+				o.final_total,
+				o.service_fee,
+				o.vat_amount,
+				` + factsDay + ` as day,
+				SUM(oi.quantity) as tickets,
+				SUM(oi.subtotal) as subtotal
+			FROM orders o
+			INNER JOIN order_items oi ON oi.order_id = o.id
+			WHERE oi.event_id = $1
+			GROUP BY o.id
+		),
+		refunds AS (
+			SELECT
+				(updated_at AT TIME ZONE '` + FactsTimezone + `')::date as day,
+				SUM(amount_kobo) as refunded
+			FROM order_refunds
+			WHERE event_id = $1 AND status = 'refunded'
+			GROUP BY 1
+		),
+		days AS (
+			SELECT day FROM event_orders
+			UNION
+			SELECT day FROM refunds
+		)
+		INSERT INTO analytics_event_daily (
+			event_id, day,
+			orders_total, orders_success, orders_pending, orders_failed, orders_fraud, orders_refunded,
+			tickets_sold, gross_kobo, subtotal_kobo, service_fee_kobo, vat_kobo, refunded_kobo,
+			updated_at
+		)
+		SELECT
+			$1,
+			d.day,
+			COUNT(o.id),
+			COUNT(o.id) FILTER (WHERE o.status = 'success'),
+			COUNT(o.id) FILTER (WHERE o.status = 'pending'),
+			COUNT(o.id) FILTER (WHERE o.status = 'failed'),
+			COUNT(o.id) FILTER (WHERE o.status = 'fraud'),
+			COUNT(o.id) FILTER (WHERE o.status = 'refunded'),
+			COALESCE(SUM(o.tickets) FILTER (WHERE o.status = 'success'), 0),
+			COALESCE(SUM(o.final_total) FILTER (WHERE o.status = 'success'), 0),
+			COALESCE(SUM(o.subtotal) FILTER (WHERE o.status = 'success'), 0),
+			COALESCE(SUM(o.service_fee) FILTER (WHERE o.status = 'success'), 0),
+			COALESCE(SUM(o.vat_amount) FILTER (WHERE o.status = 'success'), 0),
+			COALESCE(MAX(rf.refunded), 0),
+			NOW()
+		FROM days d
+		LEFT JOIN event_orders o ON o.day = d.day
+		LEFT JOIN refunds rf ON rf.day = d.day
+		GROUP BY d.day
+	`
+	if _, err := tx.ExecContext(ctx, dailyQuery, eventID); err != nil {
+		return fmt.Errorf("failed to build daily facts: %w", err)
+	}
+
+	tierQuery := `
+		INSERT INTO analytics_tier_daily (
+			event_id, ticket_tier_id, day, tier_name, tickets_sold, revenue_kobo, orders, updated_at
+		)
+		SELECT
+			$1,
+			oi.ticket_tier_id,
+			` + factsDay + `,
+			MAX(oi.tier_name),
+			SUM(oi.quantity),
+			SUM(oi.subtotal),
+			COUNT(DISTINCT o.id),
+			NOW()
+		FROM orders o
+		INNER JOIN order_items oi ON oi.order_id = o.id
+		WHERE oi.event_id = $1 AND ` + soldOrder + `
+		GROUP BY oi.ticket_tier_id, 3
+	`
+	if _, err := tx.ExecContext(ctx, tierQuery, eventID); err != nil {
+		return fmt.Errorf("failed to build tier facts: %w", err)
+	}
+
+	channelQuery := `
+		INSERT INTO analytics_event_channels (
+			event_id, channel, orders, success_count, fail_count, revenue_kobo
+		)
+		SELECT
+			$1,
+			o.payment_channel,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE ` + soldOrder + `),
+			COUNT(*) FILTER (WHERE o.status = 'failed'),
+			COALESCE(SUM(o.final_total) FILTER (WHERE ` + soldOrder + `), 0)
+		FROM orders o
+		WHERE o.id IN (SELECT order_id FROM order_items WHERE event_id = $1)
+			AND o.payment_channel IS NOT NULL
+			AND o.payment_channel != ''
+		GROUP BY o.payment_channel
+	`
+	if _, err := tx.ExecContext(ctx, channelQuery, eventID); err != nil {
+		return fmt.Errorf("failed to build channel facts: %w", err)
+	}
+
+	totalsQuery := `
+		INSERT INTO analytics_event_totals (event_id, unique_customers, repeat_customers, refreshed_at)
+		SELECT
+			$1,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE orders > 1),
+			NOW()
+		FROM (
+			SELECT LOWER(o.customer_email) as email, COUNT(DISTINCT o.id) as orders
+			FROM orders o
+			INNER JOIN order_items oi ON oi.order_id = o.id
+			WHERE oi.event_id = $1 AND ` + soldOrder + `
+			GROUP BY 1
+		) buyers
+		ON CONFLICT (event_id) DO UPDATE SET
+			unique_customers = EXCLUDED.unique_customers,
+			repeat_customers = EXCLUDED.repeat_customers,
+			refreshed_at = EXCLUDED.refreshed_at
+	`
+	if _, err := tx.ExecContext(ctx, totalsQuery, eventID); err != nil {
+		return fmt.Errorf("failed to build event totals: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// GetEventIDsChangedSince lists events with an order or refund touched
+// since the cutoff, for the catch-up refresher
+func (r *PostgresAnalyticsRepository) GetEventIDsChangedSince(
+	ctx context.Context,
+	since time.Time,
+) ([]uuid.UUID, error) {
+
+	query := `
+		SELECT oi.event_id
+		FROM orders o
+		INNER JOIN order_items oi ON oi.order_id = o.id
+		WHERE o.updated_at >= $1
+		UNION
+		SELECT event_id
+		FROM order_refunds
+		WHERE updated_at >= $1
+	`
+
+	var ids []uuid.UUID
+	err := r.DB.SelectContext(ctx, &ids, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changed events: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetAllEventIDs lists every event that isn't deleted, for a full rebuild
+func (r *PostgresAnalyticsRepository) GetAllEventIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.DB.SelectContext(ctx, &ids, `SELECT id FROM events WHERE is_deleted = false ORDER BY created_at ASC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	return ids, nil
+}
+
+// ============================================================================
+// READS
+// ============================================================================
+
+// GetEventFacts sums an event's daily facts. RefreshedAt is nil when the
+// event has never been refreshed.
+func (r *PostgresAnalyticsRepository) GetEventFacts(
+	ctx context.Context,
+	eventID uuid.UUID,
+) (*models.EventFactsRaw, error) {
+
+	query := `
+		SELECT
+			COALESCE(SUM(d.orders_total), 0) as orders_total,
+			COALESCE(SUM(d.orders_success), 0) as orders_success,
+			COALESCE(SUM(d.orders_pending), 0) as orders_pending,
+			COALESCE(SUM(d.orders_failed), 0) as orders_failed,
+			COALESCE(SUM(d.orders_fraud), 0) as orders_fraud,
+			COALESCE(SUM(d.orders_refunded), 0) as orders_refunded,
+			COALESCE(SUM(d.tickets_sold), 0) as tickets_sold,
+			COALESCE(SUM(d.gross_kobo), 0) as gross_kobo,
+			COALESCE(SUM(d.subtotal_kobo), 0) as subtotal_kobo,
+			COALESCE(SUM(d.service_fee_kobo), 0) as service_fee_kobo,
+			COALESCE(SUM(d.vat_kobo), 0) as vat_kobo,
+			COALESCE(SUM(d.refunded_kobo), 0) as refunded_kobo,
+			COALESCE(MAX(t.unique_customers), 0) as unique_customers,
+			COALESCE(MAX(t.repeat_customers), 0) as repeat_customers,
+			MAX(t.refreshed_at) as refreshed_at
+		FROM analytics_event_totals t
+		LEFT JOIN analytics_event_daily d ON d.event_id = t.event_id
+		WHERE t.event_id = $1
+	`
+
+	var facts models.EventFactsRaw
+	err := r.DB.GetContext(ctx, &facts, query, eventID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get event facts: %w", err)
+	}
+
+	return &facts, nil
+}
+
+// GetTierFacts returns each tier's successful sales for the event
+func (r *PostgresAnalyticsRepository) GetTierFacts(
+	ctx context.Context,
+	eventID uuid.UUID,
+) ([]models.TierFactsRaw, error) {
+
+	query := `
+		SELECT
+			ticket_tier_id,
+			SUM(tickets_sold) as tickets_sold,
+			SUM(revenue_kobo) as revenue_kobo,
+			SUM(orders) as orders
+		FROM analytics_tier_daily
+		WHERE event_id = $1
+		GROUP BY ticket_tier_id
+	`
+
+	var tiers []models.TierFactsRaw
+	err := r.DB.SelectContext(ctx, &tiers, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tier facts: %w", err)
+	}
+
+	return tiers, nil
+}
+
+// GetChannelFacts returns the event's payment channels, highest revenue first
+func (r *PostgresAnalyticsRepository) GetChannelFacts(
+	ctx context.Context,
+	eventID uuid.UUID,
+) ([]models.PaymentChannelRaw, error) {
+
+	query := `
+		SELECT channel, orders, revenue_kobo, success_count, fail_count
+		FROM analytics_event_channels
+		WHERE event_id = $1
+		ORDER BY revenue_kobo DESC, orders DESC
+	`
+
+	var channels []models.PaymentChannelRaw
+	err := r.DB.SelectContext(ctx, &channels, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channel facts: %w", err)
+	}
+
+	return channels, nil
+}
+
+// GetDailyFacts returns the event's successful sales per FactsTimezone day,
+// skipping days with no sales
+func (r *PostgresAnalyticsRepository) GetDailyFacts(
+	ctx context.Context,
+	eventID uuid.UUID,
+) ([]models.DailySalesRaw, error) {
+
+	query := `
+		SELECT
+			day::timestamp AT TIME ZONE '` + FactsTimezone + `' as day,
+			tickets_sold,
+			subtotal_kobo as revenue,
+			orders_success as order_count
+		FROM analytics_event_daily
+		WHERE event_id = $1 AND orders_success > 0
+		ORDER BY day ASC
+	`
+
+	var days []models.DailySalesRaw
+	err := r.DB.SelectContext(ctx, &days, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily facts: %w", err)
+	}
+
+	return days, nil
+}
@@ -0,0 +1,178 @@
+// backend/pkg/analytics/platform.go
+// Platform-wide queries for the admin dashboard
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+)
+
+// GetPlatformDaily returns one row per FactsTimezone day in [from, to),
+// zero-filled. Sales are bucketed on the day an order was paid.
+func (r *PostgresAnalyticsRepository) GetPlatformDaily(
+	ctx context.Context,
+	from, to time.Time,
+) ([]models.PlatformDailyRaw, error) {
+
+	query := `
+		WITH days AS (
+			SELECT generate_series(
+				($1::timestamptz AT TIME ZONE '` + FactsTimezone + `')::date,
+				($2::timestamptz AT TIME ZONE '` + FactsTimezone + `')::date - 1,
+				interval '1 day'
+			)::date as day
+		),
+		sales AS (
+			SELECT
+				(COALESCE(paid_at, created_at) AT TIME ZONE '` + FactsTimezone + `')::date as day,
+				SUM(final_total) as gmv,
+				SUM(app_profit) as platform_fees,
+				COUNT(*) as orders
+			FROM orders
+			WHERE status = 'success'
+				AND COALESCE(paid_at, created_at) >= $1
+				AND COALESCE(paid_at, created_at) < $2
+			GROUP BY 1
+		),
+		new_vendors AS (
+			SELECT (created_at AT TIME ZONE '` + FactsTimezone + `')::date as day, COUNT(*) as count
+			FROM vendors
+			WHERE created_at >= $1 AND created_at < $2
+			GROUP BY 1
+		),
+		new_users AS (
+			SELECT (created_at AT TIME ZONE '` + FactsTimezone + `')::date as day, COUNT(*) as count
+			FROM users
+			WHERE created_at >= $1 AND created_at < $2
+			GROUP BY 1
+		),
+		active_users AS (
+			SELECT day, COUNT(*) as count
+			FROM user_activity_daily
+			WHERE day >= ($1::timestamptz AT TIME ZONE '` + FactsTimezone + `')::date
+				AND day < ($2::timestamptz AT TIME ZONE '` + FactsTimezone + `')::date
+			GROUP BY day
+		),
+		feedback_days AS (
+			SELECT (created_at AT TIME ZONE '` + FactsTimezone + `')::date as day, COUNT(*) as count
+			FROM feedback
+			WHERE created_at >= $1 AND created_at < $2
+			GROUP BY 1
+		)
+		SELECT
+			d.day::timestamp AT TIME ZONE '` + FactsTimezone + `' as day,
+			COALESCE(s.gmv, 0) as gmv,
+			COALESCE(s.platform_fees, 0) as platform_fees,
+			COALESCE(s.orders, 0) as successful_orders,
+			COALESCE(v.count, 0) as new_vendors,
+			COALESCE(u.count, 0) as new_users,
+			COALESCE(a.count, 0) as active_users,
+			COALESCE(f.count, 0) as feedback
+		FROM days d
+		LEFT JOIN sales s ON s.day = d.day
+		LEFT JOIN new_vendors v ON v.day = d.day
+		LEFT JOIN new_users u ON u.day = d.day
+		LEFT JOIN active_users a ON a.day = d.day
+		LEFT JOIN feedback_days f ON f.day = d.day
+		ORDER BY d.day ASC
+	`
+
+	var days []models.PlatformDailyRaw
+	err := r.DB.SelectContext(ctx, &days, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get platform daily metrics: %w", err)
+	}
+
+	return days, nil
+}
+
+// GetOrderStatusCounts counts orders created in [from, to) by status
+func (r *PostgresAnalyticsRepository) GetOrderStatusCounts(
+	ctx context.Context,
+	from, to time.Time,
+) ([]models.StatusCountRaw, error) {
+
+	query := `
+		SELECT status, COUNT(*) as count
+		FROM orders
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY status
+	`
+
+	var counts []models.StatusCountRaw
+	err := r.DB.SelectContext(ctx, &counts, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order status counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetFeedbackTypeCounts counts feedback submitted in [from, to) by type
+func (r *PostgresAnalyticsRepository) GetFeedbackTypeCounts(
+	ctx context.Context,
+	from, to time.Time,
+) ([]models.StatusCountRaw, error) {
+
+	query := `
+		SELECT type as status, COUNT(*) as count
+		FROM feedback
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY type
+	`
+
+	var counts []models.StatusCountRaw
+	err := r.DB.SelectContext(ctx, &counts, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback type counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetVendorFunnel counts every vendor at each verification step
+func (r *PostgresAnalyticsRepository) GetVendorFunnel(ctx context.Context) (*models.PlatformVendorFunnel, error) {
+	query := `
+		SELECT
+			COUNT(*) as total,
+			COUNT(*) FILTER (WHERE status = 'active') as active,
+			COUNT(*) FILTER (WHERE status = 'suspended') as suspended,
+			COUNT(*) FILTER (WHERE is_identity_verified) as identity_verified,
+			COUNT(*) FILTER (WHERE is_identity_verified AND is_business_registered) as business_registered,
+			COUNT(*) FILTER (WHERE is_identity_verified AND is_business_verified IS TRUE) as business_verified
+		FROM vendors
+	`
+
+	var funnel models.PlatformVendorFunnel
+	err := r.DB.GetContext(ctx, &funnel, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor funnel: %w", err)
+	}
+
+	return &funnel, nil
+}
+
+// GetActiveUserCount counts distinct users active on any day in [from, to)
+func (r *PostgresAnalyticsRepository) GetActiveUserCount(
+	ctx context.Context,
+	from, to time.Time,
+) (int, error) {
+
+	query := `
+		SELECT COUNT(DISTINCT user_id)
+		FROM user_activity_daily
+		WHERE day >= ($1::timestamptz AT TIME ZONE '` + FactsTimezone + `')::date
+			AND day < ($2::timestamptz AT TIME ZONE '` + FactsTimezone + `')::date
+	`
+
+	var count int
+	err := r.DB.GetContext(ctx, &count, query, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count active users: %w", err)
+	}
+
+	return count, nil
+}
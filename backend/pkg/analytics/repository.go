@@ -4,6 +4,7 @@ package analytics
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +14,9 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+// ErrEventNotFound is returned when the event is missing or soft-deleted
+var ErrEventNotFound = errors.New("event not found or deleted")
+
 // ============================================================================
 // INTERFACE
 // ============================================================================
@@ -22,23 +26,34 @@ type AnalyticsRepository interface {
 	// Event queries
 	GetEventInfo(ctx context.Context, eventID uuid.UUID) (*models.EventBasicInfo, error)
 
-	// Ticket queries
-	GetTicketsSold(ctx context.Context, eventID uuid.UUID) (int64, error)
-	GetTicketsSoldByTier(ctx context.Context, eventID uuid.UUID) (map[string]int64, error)
-
-	// Order queries
-	GetOrderMetrics(ctx context.Context, eventID uuid.UUID) (*models.OrderMetricsRaw, error)
-	GetRevenueMetrics(ctx context.Context, eventID uuid.UUID) (*models.RevenueMetricsRaw, error)
-
-	// Customer queries
-	GetCustomerMetrics(ctx context.Context, eventID uuid.UUID) (*models.CustomerMetricsRaw, error)
-	GetTopCountries(ctx context.Context, eventID uuid.UUID, limit int) ([]models.CountryData, error)
-
-	// Payment queries
-	GetPaymentChannels(ctx context.Context, eventID uuid.UUID) ([]models.PaymentChannelRaw, error)
-
-	// Timeline queries
-	GetSalesTimeline(ctx context.Context, eventID uuid.UUID, groupBy string) ([]models.TimelineData, error)
+	// Read model (see facts.go)
+	RefreshEventFacts(ctx context.Context, eventID uuid.UUID) error
+	GetEventIDsChangedSince(ctx context.Context, since time.Time) ([]uuid.UUID, error)
+	GetAllEventIDs(ctx context.Context) ([]uuid.UUID, error)
+	GetEventFacts(ctx context.Context, eventID uuid.UUID) (*models.EventFactsRaw, error)
+	GetTierFacts(ctx context.Context, eventID uuid.UUID) ([]models.TierFactsRaw, error)
+	GetChannelFacts(ctx context.Context, eventID uuid.UUID) ([]models.PaymentChannelRaw, error)
+	GetDailyFacts(ctx context.Context, eventID uuid.UUID) ([]models.DailySalesRaw, error)
+
+	// Timeline queries (raw orders, for hourly and timezone-aware buckets)
+	GetSalesTimeline(ctx context.Context, eventID uuid.UUID, q models.TimelineQuery) ([]models.SalesTimelineRaw, error)
+	GetSalesBefore(ctx context.Context, eventID uuid.UUID, before time.Time) ([]models.SalesTimelineRaw, error)
+
+	// Funnel queries
+	GetFunnelCounts(ctx context.Context, eventID uuid.UUID) (map[string]models.FunnelCountsRaw, error)
+
+	// Organizer-wide queries
+	GetOrganizerEvents(ctx context.Context, organizerID uuid.UUID) ([]models.OrganizerEventRaw, error)
+	GetOrganizerDailySales(ctx context.Context, organizerID uuid.UUID, since time.Time) ([]models.DailySalesRaw, error)
+	GetOrganizerTopTiers(ctx context.Context, organizerID uuid.UUID, limit int) ([]models.TierSalesRaw, error)
+	GetOrganizerRepeatBuyers(ctx context.Context, organizerID uuid.UUID) (*models.RepeatBuyersRaw, error)
+
+	// Platform-wide queries (see platform.go)
+	GetPlatformDaily(ctx context.Context, from, to time.Time) ([]models.PlatformDailyRaw, error)
+	GetOrderStatusCounts(ctx context.Context, from, to time.Time) ([]models.StatusCountRaw, error)
+	GetFeedbackTypeCounts(ctx context.Context, from, to time.Time) ([]models.StatusCountRaw, error)
+	GetVendorFunnel(ctx context.Context) (*models.PlatformVendorFunnel, error)
+	GetActiveUserCount(ctx context.Context, from, to time.Time) (int, error)
 }
 
 // ============================================================================
@@ -70,7 +85,7 @@ func (r *PostgresAnalyticsRepository) GetEventInfo(
 	query := `
 		SELECT 
 			e.id,
-			e.title,
+			e.event_title as title,
 			e.organizer_id,
 			e.start_date,
 			e.end_date
@@ -89,7 +104,7 @@ func (r *PostgresAnalyticsRepository) GetEventInfo(
 	err := r.DB.GetContext(ctx, &result, query, eventID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("event not found or deleted")
+			return nil, ErrEventNotFound
 		}
 		return nil, fmt.Errorf("failed to fetch event: %w", err)
 	}
@@ -97,12 +112,13 @@ func (r *PostgresAnalyticsRepository) GetEventInfo(
 	// Fetch ticket tiers
 	tierQuery := `
 		SELECT 
-			tier_name,
-			price,
-			quantity
+			id as tier_id,
+			name as tier_name,
+			price_kobo / 100.0 as price,
+			capacity as quantity
 		FROM ticket_tiers
 		WHERE event_id = $1
-		ORDER BY price ASC
+		ORDER BY price_kobo ASC
 	`
 
 	var tiers []models.TierInfo
@@ -124,367 +140,350 @@ func (r *PostgresAnalyticsRepository) GetEventInfo(
 }
 
 // ============================================================================
-// TICKET QUERIES
+// TIMELINE QUERIES
 // ============================================================================
 
-// GetTicketsSold counts total tickets sold for an event
-func (r *PostgresAnalyticsRepository) GetTicketsSold(
+// GetSalesTimeline buckets successful sales by paid_at in the query's
+// timezone. Each bucket comes back once per tier plus once as a total.
+func (r *PostgresAnalyticsRepository) GetSalesTimeline(
 	ctx context.Context,
 	eventID uuid.UUID,
-) (int64, error) {
-
-	query := `
-		SELECT COUNT(*) 
-		FROM tickets 
-		WHERE event_id = $1
-	`
-
-	var count int64
-	err := r.DB.GetContext(ctx, &count, query, eventID)
-	if err != nil {
-		return 0, fmt.Errorf("failed to count tickets: %w", err)
+	q models.TimelineQuery,
+) ([]models.SalesTimelineRaw, error) {
+
+	// Only these reach date_trunc; anything else falls back to days
+	groupBy := "day"
+	switch q.GroupBy {
+	case "hour", "week":
+		groupBy = q.GroupBy
+	}
+	timezone := q.Timezone
+	if timezone == "" {
+		timezone = "UTC"
 	}
-
-	return count, nil
-}
-
-// GetTicketsSoldByTier returns tickets sold per tier
-func (r *PostgresAnalyticsRepository) GetTicketsSoldByTier(
-	ctx context.Context,
-	eventID uuid.UUID,
-) (map[string]int64, error) {
 
 	query := `
+		WITH sales AS (
+			SELECT 
+				date_trunc($2, o.paid_at AT TIME ZONE $3) AT TIME ZONE $3 as bucket,
+				oi.tier_name,
+				oi.quantity,
+				oi.subtotal,
+				o.id as order_id
+			FROM orders o
+			INNER JOIN order_items oi ON oi.order_id = o.id
+			WHERE oi.event_id = $1 
+				AND o.status = 'success' 
+				AND o.paid_at IS NOT NULL
+				AND ($4::timestamptz IS NULL OR o.paid_at >= $4)
+				AND ($5::timestamptz IS NULL OR o.paid_at < $5)
+		)
 		SELECT 
-			tt.tier_name,
-			COUNT(t.id) as count
-		FROM ticket_tiers tt
-		LEFT JOIN tickets t ON t.ticket_tier_id = tt.id AND t.event_id = $1
-		WHERE tt.event_id = $1
-		GROUP BY tt.tier_name
-		ORDER BY tt.tier_name
+			bucket,
+			COALESCE(tier_name, '') as tier_name,
+			GROUPING(tier_name) = 1 as is_total,
+			SUM(quantity) as tickets_sold,
+			SUM(subtotal) as revenue,
+			COUNT(DISTINCT order_id) as order_count
+		FROM sales
+		GROUP BY GROUPING SETS ((bucket, tier_name), (bucket))
+		ORDER BY bucket ASC, is_total DESC, tier_name ASC
 	`
 
 	var results []struct {
-		TierName string `db:"tier_name"`
-		Count    int64  `db:"count"`
+		Bucket      time.Time `db:"bucket"`
+		TierName    string    `db:"tier_name"`
+		IsTotal     bool      `db:"is_total"`
+		TicketsSold int       `db:"tickets_sold"`
+		Revenue     int       `db:"revenue"`
+		OrderCount  int       `db:"order_count"`
 	}
 
-	err := r.DB.SelectContext(ctx, &results, query, eventID)
+	err := r.DB.SelectContext(ctx, &results, query, eventID, groupBy, timezone, q.From, q.To)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tickets by tier: %w", err)
+		return nil, fmt.Errorf("failed to get sales timeline: %w", err)
 	}
 
-	tierMap := make(map[string]int64)
-	for _, result := range results {
-		tierMap[result.TierName] = result.Count
+	timeline := make([]models.SalesTimelineRaw, len(results))
+	for i, result := range results {
+		timeline[i] = models.SalesTimelineRaw{
+			Bucket:      result.Bucket,
+			TierName:    result.TierName,
+			IsTotal:     result.IsTotal,
+			TicketsSold: result.TicketsSold,
+			Revenue:     result.Revenue,
+			OrderCount:  result.OrderCount,
+		}
 	}
 
-	return tierMap, nil
+	return timeline, nil
 }
 
-// ============================================================================
-// ORDER QUERIES
-// ============================================================================
-
-// GetOrderMetrics fetches order status breakdown
-func (r *PostgresAnalyticsRepository) GetOrderMetrics(
+// GetSalesBefore totals successful sales paid before the cutoff, per tier and
+// once overall (IsTotal), so a timeline starting at from opens with the
+// running totals it already had
+func (r *PostgresAnalyticsRepository) GetSalesBefore(
 	ctx context.Context,
 	eventID uuid.UUID,
-) (*models.OrderMetricsRaw, error) {
+	before time.Time,
+) ([]models.SalesTimelineRaw, error) {
 
 	query := `
 		SELECT 
-			o.status,
-			COUNT(*) as count
+			COALESCE(oi.tier_name, '') as tier_name,
+			GROUPING(oi.tier_name) = 1 as is_total,
+			COALESCE(SUM(oi.quantity), 0) as tickets_sold,
+			COALESCE(SUM(oi.subtotal), 0) as revenue,
+			COUNT(DISTINCT o.id) as order_count
 		FROM orders o
 		INNER JOIN order_items oi ON oi.order_id = o.id
-		WHERE oi.event_id = $1
-		GROUP BY o.status
+		WHERE oi.event_id = $1 
+			AND o.status = 'success' 
+			AND o.paid_at IS NOT NULL
+			AND o.paid_at < $2
+		GROUP BY GROUPING SETS ((oi.tier_name), ())
 	`
 
 	var results []struct {
-		Status string `db:"status"`
-		Count  int    `db:"count"`
+		TierName    string `db:"tier_name"`
+		IsTotal     bool   `db:"is_total"`
+		TicketsSold int    `db:"tickets_sold"`
+		Revenue     int    `db:"revenue"`
+		OrderCount  int    `db:"order_count"`
 	}
 
-	err := r.DB.SelectContext(ctx, &results, query, eventID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get order metrics: %w", err)
+	if err := r.DB.SelectContext(ctx, &results, query, eventID, before); err != nil {
+		return nil, fmt.Errorf("failed to get sales before %s: %w", before.Format(time.RFC3339), err)
 	}
 
-	metrics := &models.OrderMetricsRaw{}
-
-	for _, result := range results {
-		metrics.Total += result.Count
-
-		switch result.Status {
-		case "success":
-			metrics.Successful = result.Count
-		case "pending":
-			metrics.Pending = result.Count
-		case "failed":
-			metrics.Failed = result.Count
-		case "fraud":
-			metrics.Fraud = result.Count
+	opening := make([]models.SalesTimelineRaw, len(results))
+	for i, result := range results {
+		opening[i] = models.SalesTimelineRaw{
+			TierName:    result.TierName,
+			IsTotal:     result.IsTotal,
+			TicketsSold: result.TicketsSold,
+			Revenue:     result.Revenue,
+			OrderCount:  result.OrderCount,
 		}
 	}
 
-	return metrics, nil
+	return opening, nil
 }
 
-// GetRevenueMetrics fetches financial data from successful orders
-func (r *PostgresAnalyticsRepository) GetRevenueMetrics(
+// ============================================================================
+// FUNNEL QUERIES
+// ============================================================================
+
+// GetFunnelCounts sums the daily tracking rollup per funnel step
+func (r *PostgresAnalyticsRepository) GetFunnelCounts(
 	ctx context.Context,
 	eventID uuid.UUID,
-) (*models.RevenueMetricsRaw, error) {
+) (map[string]models.FunnelCountsRaw, error) {
 
 	query := `
 		SELECT 
-			SUM(o.final_total) as total_revenue,
-			SUM(o.subtotal) as subtotal_revenue,
-			SUM(o.service_fee) as service_fees,
-			SUM(o.vat_amount) as vat_amount,
-			COUNT(DISTINCT o.id) as order_count,
-			AVG(o.final_total) as avg_order_value
-		FROM orders o
-		INNER JOIN order_items oi ON oi.order_id = o.id
-		WHERE oi.event_id = $1 AND o.status = 'success'
+			event_type,
+			SUM(total) as count,
+			SUM(visitors) as visitors
+		FROM event_tracking_daily
+		WHERE event_id = $1
+		GROUP BY event_type
 	`
 
-	var result struct {
-		TotalRevenue     sql.NullInt64   `db:"total_revenue"`
-		SubtotalRevenue  sql.NullInt64   `db:"subtotal_revenue"`
-		ServiceFees      sql.NullInt64   `db:"service_fees"`
-		VATAmount        sql.NullInt64   `db:"vat_amount"`
-		OrderCount       sql.NullInt64   `db:"order_count"`
-		AvgOrderValue    sql.NullFloat64 `db:"avg_order_value"`
+	var results []struct {
+		EventType string `db:"event_type"`
+		Count     int    `db:"count"`
+		Visitors  int    `db:"visitors"`
 	}
 
-	err := r.DB.GetContext(ctx, &result, query, eventID)
+	err := r.DB.SelectContext(ctx, &results, query, eventID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get revenue metrics: %w", err)
+		return nil, fmt.Errorf("failed to get funnel counts: %w", err)
 	}
 
-	metrics := &models.RevenueMetricsRaw{
-		TotalRevenue:     int(result.TotalRevenue.Int64),
-		SubtotalRevenue:  int(result.SubtotalRevenue.Int64),
-		ServiceFees:      int(result.ServiceFees.Int64),
-		VATAmount:        int(result.VATAmount.Int64),
-		OrderCount:       int(result.OrderCount.Int64),
-		TotalOrderValue:  result.AvgOrderValue.Float64,
+	counts := make(map[string]models.FunnelCountsRaw, len(results))
+	for _, result := range results {
+		counts[result.EventType] = models.FunnelCountsRaw{
+			Count:    result.Count,
+			Visitors: result.Visitors,
+		}
 	}
 
-	return metrics, nil
+	return counts, nil
 }
 
 // ============================================================================
-// CUSTOMER QUERIES
+// ORGANIZER-WIDE QUERIES
 // ============================================================================
 
-// GetCustomerMetrics fetches unique customer count
-func (r *PostgresAnalyticsRepository) GetCustomerMetrics(
+// organizerSales is the organizer's successful order lines, used where the
+// read model's per-event facts can't answer (buyers across events). Revenue
+// is the ticket subtotal; fees and VAT aren't the organizer's money.
+const organizerSales = `
+	organizer_sales AS (
+		SELECT
+			oi.event_id,
+			oi.ticket_tier_id,
+			oi.quantity,
+			oi.subtotal,
+			o.id as order_id,
+			o.paid_at,
+			COALESCE(o.user_id::text, LOWER(o.customer_email)) as buyer
+		FROM orders o
+		INNER JOIN order_items oi ON oi.order_id = o.id
+		INNER JOIN events e ON e.id = oi.event_id
+		WHERE e.organizer_id = $1 
+			AND e.is_deleted = false 
+			AND o.status = 'success'
+	)`
+
+// GetOrganizerEvents returns every live event the organizer owns with its
+// sales (from the read model) and total tier capacity
+func (r *PostgresAnalyticsRepository) GetOrganizerEvents(
 	ctx context.Context,
-	eventID uuid.UUID,
-) (*models.CustomerMetricsRaw, error) {
+	organizerID uuid.UUID,
+) ([]models.OrganizerEventRaw, error) {
 
 	query := `
+		WITH event_sales AS (
+			SELECT 
+				d.event_id,
+				SUM(d.tickets_sold) as tickets_sold,
+				SUM(d.subtotal_kobo) as revenue,
+				SUM(d.orders_success) as order_count
+			FROM analytics_event_daily d
+			INNER JOIN events e ON e.id = d.event_id
+			WHERE e.organizer_id = $1
+			GROUP BY d.event_id
+		),
+		event_capacity AS (
+			SELECT event_id, SUM(capacity) as capacity
+			FROM ticket_tiers
+			GROUP BY event_id
+		)
 		SELECT 
-			ARRAY_AGG(DISTINCT o.customer_email) as unique_emails,
-			COUNT(DISTINCT o.id) as total_orders
-		FROM orders o
-		INNER JOIN order_items oi ON oi.order_id = o.id
-		WHERE oi.event_id = $1 AND o.status = 'success'
+			e.id as event_id,
+			e.event_title as title,
+			e.start_date,
+			e.status,
+			e.cancelled_at IS NOT NULL as cancelled,
+			COALESCE(c.capacity, 0) as capacity,
+			COALESCE(s.tickets_sold, 0) as tickets_sold,
+			COALESCE(s.revenue, 0) as revenue,
+			COALESCE(s.order_count, 0) as order_count
+		FROM events e
+		LEFT JOIN event_sales s ON s.event_id = e.id
+		LEFT JOIN event_capacity c ON c.event_id = e.id
+		WHERE e.organizer_id = $1 AND e.is_deleted = false
+		ORDER BY e.start_date DESC
 	`
 
-	var result struct {
-		UniqueEmails []string `db:"unique_emails"`
-		TotalOrders  int      `db:"total_orders"`
-	}
-
-	err := r.DB.GetContext(ctx, &result, query, eventID)
+	var events []models.OrganizerEventRaw
+	err := r.DB.SelectContext(ctx, &events, query, organizerID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get customer metrics: %w", err)
-	}
-
-	metrics := &models.CustomerMetricsRaw{
-		UniqueEmails: result.UniqueEmails,
-		TotalOrders:  result.TotalOrders,
+		return nil, fmt.Errorf("failed to get organizer events: %w", err)
 	}
 
-	return metrics, nil
+	return events, nil
 }
 
-// GetTopCountries fetches top countries by revenue (requires country field)
-func (r *PostgresAnalyticsRepository) GetTopCountries(
+// GetOrganizerDailySales sums the organizer's daily facts since the cutoff.
+// Days are FactsTimezone dates, returned as that zone's midnight.
+func (r *PostgresAnalyticsRepository) GetOrganizerDailySales(
 	ctx context.Context,
-	eventID uuid.UUID,
-	limit int,
-) ([]models.CountryData, error) {
+	organizerID uuid.UUID,
+	since time.Time,
+) ([]models.DailySalesRaw, error) {
 
-	// Note: This assumes you have a 'country' field in orders table
-	// If not, you may need to add it or modify this query
 	query := `
 		SELECT 
-			o.country,
-			COUNT(DISTINCT o.id) as order_count,
-			SUM(o.final_total) as revenue,
-			SUM(oi.quantity) as tickets_sold
-		FROM orders o
-		INNER JOIN order_items oi ON oi.order_id = o.id
-		WHERE oi.event_id = $1 AND o.status = 'success' AND o.country IS NOT NULL
-		GROUP BY o.country
-		ORDER BY revenue DESC
-		LIMIT $2
+			d.day::timestamp AT TIME ZONE '` + FactsTimezone + `' as day,
+			SUM(d.tickets_sold) as tickets_sold,
+			SUM(d.subtotal_kobo) as revenue,
+			SUM(d.orders_success) as order_count
+		FROM analytics_event_daily d
+		INNER JOIN events e ON e.id = d.event_id
+		WHERE e.organizer_id = $1 
+			AND e.is_deleted = false
+			AND d.day >= ($2::timestamptz AT TIME ZONE '` + FactsTimezone + `')::date
+		GROUP BY d.day
+		ORDER BY d.day ASC
 	`
 
-	var results []struct {
-		Country     string `db:"country"`
-		OrderCount  int    `db:"order_count"`
-		Revenue     int    `db:"revenue"`
-		TicketsSold int    `db:"tickets_sold"`
-	}
-
-	err := r.DB.SelectContext(ctx, &results, query, eventID, limit)
+	var days []models.DailySalesRaw
+	err := r.DB.SelectContext(ctx, &days, query, organizerID, since)
 	if err != nil {
-		// If country column doesn't exist, return empty slice
-		if err == sql.ErrNoRows {
-			return []models.CountryData{}, nil
-		}
-		return nil, fmt.Errorf("failed to get top countries: %w", err)
-	}
-
-	countryData := make([]models.CountryData, len(results))
-	for i, result := range results {
-		countryData[i] = models.CountryData{
-			Country:     result.Country,
-			OrderCount:  result.OrderCount,
-			TicketsSold: result.TicketsSold,
-			Revenue:     result.Revenue,
-		}
+		return nil, fmt.Errorf("failed to get organizer daily sales: %w", err)
 	}
 
-	return countryData, nil
+	return days, nil
 }
 
-// ============================================================================
-// PAYMENT QUERIES
-// ============================================================================
-
-// GetPaymentChannels fetches payment method breakdown
-func (r *PostgresAnalyticsRepository) GetPaymentChannels(
+// GetOrganizerTopTiers ranks the organizer's tiers by revenue
+func (r *PostgresAnalyticsRepository) GetOrganizerTopTiers(
 	ctx context.Context,
-	eventID uuid.UUID,
-) ([]models.PaymentChannelRaw, error) {
+	organizerID uuid.UUID,
+	limit int,
+) ([]models.TierSalesRaw, error) {
 
 	query := `
 		SELECT 
-			o.payment_channel as channel,
-			COUNT(DISTINCT o.id) as order_count,
-			SUM(CASE WHEN o.status = 'success' THEN o.final_total ELSE 0 END) as revenue,
-			SUM(CASE WHEN o.status = 'success' THEN 1 ELSE 0 END) as success_count,
-			SUM(CASE WHEN o.status = 'failed' THEN 1 ELSE 0 END) as fail_count
-		FROM orders o
-		INNER JOIN order_items oi ON oi.order_id = o.id
-		WHERE oi.event_id = $1 
-			AND o.payment_channel IS NOT NULL 
-			AND o.payment_channel != ''
-		GROUP BY o.payment_channel
-		ORDER BY revenue DESC
+			e.id as event_id,
+			e.event_title,
+			tt.name as tier_name,
+			tt.capacity,
+			SUM(f.tickets_sold) as tickets_sold,
+			SUM(f.revenue_kobo) as revenue
+		FROM analytics_tier_daily f
+		INNER JOIN ticket_tiers tt ON tt.id = f.ticket_tier_id
+		INNER JOIN events e ON e.id = f.event_id
+		WHERE e.organizer_id = $1 AND e.is_deleted = false
+		GROUP BY e.id, e.event_title, tt.id, tt.name, tt.capacity
+		ORDER BY revenue DESC, tickets_sold DESC
+		LIMIT $2
 	`
 
-	var results []struct {
-		Channel      sql.NullString `db:"channel"`
-		OrderCount   int            `db:"order_count"`
-		Revenue      int            `db:"revenue"`
-		SuccessCount int            `db:"success_count"`
-		FailCount    int            `db:"fail_count"`
-	}
-
-	err := r.DB.SelectContext(ctx, &results, query, eventID)
+	var tiers []models.TierSalesRaw
+	err := r.DB.SelectContext(ctx, &tiers, query, organizerID, limit)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get payment channels: %w", err)
+		return nil, fmt.Errorf("failed to get organizer top tiers: %w", err)
 	}
 
-	channels := make([]models.PaymentChannelRaw, len(results))
-	for i, result := range results {
-		channel := "unknown"
-		if result.Channel.Valid {
-			channel = result.Channel.String
-		}
-
-		channels[i] = models.PaymentChannelRaw{
-			Channel:      channel,
-			OrderCount:   result.OrderCount,
-			Revenue:      result.Revenue,
-			SuccessCount: result.SuccessCount,
-			FailCount:    result.FailCount,
-		}
-	}
-
-	return channels, nil
+	return tiers, nil
 }
 
-// ============================================================================
-// TIMELINE QUERIES
-// ============================================================================
-
-// GetSalesTimeline fetches sales data grouped by time period
-func (r *PostgresAnalyticsRepository) GetSalesTimeline(
+// GetOrganizerRepeatBuyers counts buyers across the organizer's events and
+// how many of them bought for more than one
+func (r *PostgresAnalyticsRepository) GetOrganizerRepeatBuyers(
 	ctx context.Context,
-	eventID uuid.UUID,
-	groupBy string,
-) ([]models.TimelineData, error) {
-
-	// Determine date truncation based on grouping
-	var dateFormat string
-	switch groupBy {
-	case "day":
-		dateFormat = "DATE(o.paid_at)"
-	case "week":
-		dateFormat = "DATE_TRUNC('week', o.paid_at)"
-	case "month":
-		dateFormat = "DATE_TRUNC('month', o.paid_at)"
-	default:
-		dateFormat = "DATE(o.paid_at)"
-	}
+	organizerID uuid.UUID,
+) (*models.RepeatBuyersRaw, error) {
 
-	query := fmt.Sprintf(`
+	query := `
+		WITH ` + organizerSales + `,
+		buyers AS (
+			SELECT 
+				buyer,
+				COUNT(DISTINCT event_id) as events,
+				SUM(subtotal) as revenue
+			FROM organizer_sales
+			WHERE buyer IS NOT NULL
+			GROUP BY buyer
+		)
 		SELECT 
-			TO_CHAR(%s, 'YYYY-MM-DD') as date,
-			SUM(oi.quantity) as tickets_sold,
-			SUM(oi.subtotal) as revenue,
-			COUNT(DISTINCT o.id) as order_count
-		FROM orders o
-		INNER JOIN order_items oi ON oi.order_id = o.id
-		WHERE oi.event_id = $1 
-			AND o.status = 'success' 
-			AND o.paid_at IS NOT NULL
-		GROUP BY %s
-		ORDER BY date ASC
-	`, dateFormat, dateFormat)
-
-	var results []struct {
-		Date        string `db:"date"`
-		TicketsSold int    `db:"tickets_sold"`
-		Revenue     int    `db:"revenue"`
-		OrderCount  int    `db:"order_count"`
-	}
+			COUNT(*) as unique_buyers,
+			COUNT(*) FILTER (WHERE events > 1) as repeat_buyers,
+			COALESCE(SUM(revenue) FILTER (WHERE events > 1), 0) as repeat_revenue,
+			COALESCE(SUM(revenue), 0) as total_revenue,
+			COALESCE(SUM(events), 0) as event_attendances
+		FROM buyers
+	`
 
-	err := r.DB.SelectContext(ctx, &results, query, eventID)
+	var result models.RepeatBuyersRaw
+	err := r.DB.GetContext(ctx, &result, query, organizerID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get sales timeline: %w", err)
+		return nil, fmt.Errorf("failed to get repeat buyers: %w", err)
 	}
 
-	timeline := make([]models.TimelineData, len(results))
-	for i, result := range results {
-		timeline[i] = models.TimelineData{
-			Date:        result.Date,
-			TicketsSold: result.TicketsSold,
-			Revenue:     result.Revenue,
-			OrderCount:  result.OrderCount,
-		}
-	}
-
-	return timeline, nil
-}
\ No newline at end of file
+	return &result, nil
+}
@@ -0,0 +1,134 @@
+// backend/pkg/export/export.go
+
+// Package export writes tabular data as CSV or XLSX, one row at a time, so
+// large exports never have to sit in memory.
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// TimeLayout is how time cells are written; callers convert to the zone
+// they want shown first
+const TimeLayout = "2006-01-02 15:04:05"
+
+func ParseFormat(s string) (Format, bool) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(s))); f {
+	case FormatCSV, FormatXLSX:
+		return f, true
+	}
+	return "", false
+}
+
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Filename builds a download name like "afro-nation-attendees-2025-01-15.csv"
+func (f Format) Filename(base string, at time.Time) string {
+	return fmt.Sprintf("%s-%s.%s", base, at.Format("2006-01-02"), f)
+}
+
+// RowWriter writes one row per call. Cells may be strings, integers,
+// floats, bools, time.Time, *time.Time or nil. Close must be called to
+// finish the file.
+type RowWriter interface {
+	WriteRow(cells ...any) error
+	Close() error
+}
+
+// NewRowWriter starts a file of the given format on w. sheet names the
+// worksheet for XLSX and is ignored for CSV.
+func NewRowWriter(format Format, w io.Writer, sheet string) (RowWriter, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w, sheet)
+	}
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteRow(cells ...any) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		record[i] = escapeFormula(formatCell(cell))
+	}
+	if err := c.w.Write(record); err != nil {
+		return err
+	}
+	// Flush per row so the response streams instead of buffering
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func formatCell(cell any) string {
+	switch v := cell.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		if v {
+			return "Yes"
+		}
+		return "No"
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.Format(TimeLayout)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return formatCell(*v)
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(cell)
+}
+
+// escapeFormula stops spreadsheet apps from evaluating user-entered text
+// (names, emails) that happens to start like a formula
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		if _, err := strconv.ParseFloat(s, 64); err == nil {
+			return s
+		}
+		return "'" + s
+	}
+	return s
+}
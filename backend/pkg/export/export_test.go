@@ -0,0 +1,65 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter(FormatCSV, &buf, "ignored")
+	require.NoError(t, err)
+
+	paid := time.Date(2026, 2, 14, 18, 30, 0, 0, time.UTC)
+	require.NoError(t, w.WriteRow("Name", "Tickets", "Total", "Checked In", "Paid At"))
+	require.NoError(t, w.WriteRow("Ada, Lovelace", 2, 1500.5, true, paid))
+	require.NoError(t, w.WriteRow("=HYPERLINK(\"x\")", -3, nil, false, (*time.Time)(nil)))
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "Name,Tickets,Total,Checked In,Paid At\n"+
+		"\"Ada, Lovelace\",2,1500.5,Yes,2026-02-14 18:30:00\n"+
+		"\"'=HYPERLINK(\"\"x\"\")\",-3,,No,\n", buf.String())
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter(FormatXLSX, &buf, "Attendees: Lagos/Abuja")
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteRow("Name", "Tickets"))
+	require.NoError(t, w.WriteRow("Tolu & Femi <VIP>", 4))
+	require.NoError(t, w.Close())
+
+	z, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	for _, f := range z.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		body, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		files[f.Name] = string(body)
+	}
+
+	require.Contains(t, files, "[Content_Types].xml")
+	assert.Contains(t, files["xl/workbook.xml"], `name="Attendees LagosAbuja"`)
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<c r="A1" t="inlineStr" s="1"><is><t xml:space="preserve">Name</t></is></c>`)
+	assert.Contains(t, sheet, `Tolu &amp; Femi &lt;VIP&gt;`)
+	assert.Contains(t, sheet, `<c r="B2"><v>4</v></c>`)
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "A", columnName(0))
+	assert.Equal(t, "Z", columnName(25))
+	assert.Equal(t, "AA", columnName(26))
+	assert.Equal(t, "BA", columnName(52))
+}
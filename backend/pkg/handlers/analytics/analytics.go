@@ -5,10 +5,12 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
 	serviceanalytics "github.com/eventify/backend/pkg/services/analytics"
+	serviceexport "github.com/eventify/backend/pkg/services/export"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -17,14 +19,15 @@ import (
 
 type AnalyticsHandler struct {
 	analyticsService serviceanalytics.AnalyticsService
+	exportService    serviceexport.ExportService
 }
 
-func NewAnalyticsHandler(analyticsService serviceanalytics.AnalyticsService) *AnalyticsHandler {
-	return &AnalyticsHandler{analyticsService: analyticsService}
+func NewAnalyticsHandler(analyticsService serviceanalytics.AnalyticsService, exportService serviceexport.ExportService) *AnalyticsHandler {
+	return &AnalyticsHandler{analyticsService: analyticsService, exportService: exportService}
 }
 
 func (h *AnalyticsHandler) FetchEventAnalytics(c *gin.Context) {
-	organizerIDVal, exists := c.Get("userID")
+	organizerIDVal, exists := c.Get("user_id")
 	if !exists {
 		log.Warn().Msg("User ID not found in context")
 		c.JSON(http.StatusUnauthorized, gin.H{
@@ -81,9 +84,7 @@ func (h *AnalyticsHandler) FetchEventAnalytics(c *gin.Context) {
 
 	analytics, err := h.analyticsService.GetEventAnalytics(ctx, eventID, organizerID, includeTimeline)
 	if err != nil {
-		errorMessage := err.Error()
-
-		if errorMessage == "unauthorized: event does not belong to this organizer" {
+		if errors.Is(err, serviceanalytics.ErrNotEventOrganizer) {
 			log.Warn().
 				Str("event_id", eventID.String()).
 				Str("organizer_id", organizerID.String()).
@@ -96,7 +97,7 @@ func (h *AnalyticsHandler) FetchEventAnalytics(c *gin.Context) {
 			return
 		}
 
-		if errorMessage == "event not found or deleted" {
+		if errors.Is(err, serviceanalytics.ErrEventNotFound) {
 			log.Warn().Str("event_id", eventID.String()).Msg("Event not found")
 			c.JSON(http.StatusNotFound, gin.H{
 				"status":  "error",
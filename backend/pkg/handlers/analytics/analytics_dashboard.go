@@ -0,0 +1,52 @@
+// backend/pkg/handlers/analytics/analytics_dashboard.go
+// Analytics handler - organizer-wide dashboard endpoint
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// FetchOrganizerDashboard returns analytics across all the caller's events
+// GET /api/events/analytics/dashboard?days=30&refresh=true
+func (h *AnalyticsHandler) FetchOrganizerDashboard(c *gin.Context) {
+	organizerIDVal, exists := c.Get("user_id")
+	organizerID, ok := organizerIDVal.(uuid.UUID)
+	if !exists || !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Authentication required",
+		})
+		return
+	}
+
+	days := utils.ParsePageLimit(c.Query("days"), 30, 365)
+	refresh := c.DefaultQuery("refresh", "false") == "true"
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 20*time.Second)
+	defer cancel()
+
+	dashboard, err := h.analyticsService.GetOrganizerDashboard(ctx, organizerID, days, refresh)
+	if err != nil {
+		log.Error().Err(err).Str("organizer_id", organizerID.String()).Msg("Failed to fetch organizer dashboard")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Failed to fetch dashboard. Please try again later.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Dashboard retrieved successfully",
+		"data":    dashboard,
+	})
+}
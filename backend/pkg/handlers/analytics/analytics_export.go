@@ -0,0 +1,171 @@
+// backend/pkg/handlers/analytics/analytics_export.go
+// Analytics handler - attendee, order and summary exports
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/export"
+	"github.com/eventify/backend/pkg/models"
+	serviceexport "github.com/eventify/backend/pkg/services/export"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ExportEventAnalytics downloads attendees, the order ledger or the analytics
+// summary. Small exports stream straight back; large ones (or async=true)
+// are queued and answered with 202 and a download token.
+// GET /api/events/:eventId/analytics/export?type=attendees|orders|summary&format=csv|xlsx&async=false
+func (h *AnalyticsHandler) ExportEventAnalytics(c *gin.Context) {
+	organizerIDVal, exists := c.Get("user_id")
+	organizerID, ok := organizerIDVal.(uuid.UUID)
+	if !exists || !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Authentication required",
+		})
+		return
+	}
+
+	eventID, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Invalid event ID format",
+		})
+		return
+	}
+
+	kind := models.ExportKind(c.DefaultQuery("type", string(models.ExportKindSummary)))
+	if !kind.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "type must be attendees, orders or summary",
+		})
+		return
+	}
+	format, ok := export.ParseFormat(c.DefaultQuery("format", string(export.FormatCSV)))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "format must be csv or xlsx",
+		})
+		return
+	}
+
+	req := models.ExportRequest{EventID: eventID, OrganizerID: organizerID, Kind: kind, Format: string(format)}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Minute)
+	defer cancel()
+
+	rowCount, err := h.exportService.PrepareEventExport(ctx, req)
+	if err != nil {
+		respondExportError(c, err, eventID)
+		return
+	}
+
+	if c.Query("async") == "true" || rowCount > serviceexport.SyncRowLimit {
+		job, token, err := h.exportService.QueueEventExport(ctx, req)
+		if err != nil {
+			log.Error().Err(err).Str("event_id", eventID.String()).Msg("Failed to queue export")
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "Failed to start export. Please try again later.",
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, gin.H{
+			"status":  "success",
+			"message": "Export is being prepared",
+			"data": gin.H{
+				"job":           job,
+				"downloadToken": token,
+				"statusUrl":     "/api/v1/exports/" + job.ID.String(),
+				"downloadUrl":   "/api/v1/exports/download/" + token,
+			},
+		})
+		return
+	}
+
+	// The server's write timeout is sized for JSON; give file streams longer
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Now().Add(2 * time.Minute))
+
+	out := &attachmentWriter{
+		c:           c,
+		contentType: format.ContentType(),
+		filename:    serviceexport.Filename(req, format, time.Now()),
+	}
+	rows, err := h.exportService.WriteEventExport(ctx, req, out)
+	if err != nil {
+		log.Error().Err(err).Str("event_id", eventID.String()).Str("kind", string(kind)).Msg("Export failed")
+		// Nothing sent yet, so the client can still get a proper JSON error
+		if !out.started {
+			respondExportError(c, err, eventID)
+		}
+		return
+	}
+	if !out.started {
+		out.start()
+		c.Writer.WriteHeaderNow()
+	}
+
+	log.Info().
+		Str("event_id", eventID.String()).
+		Str("kind", string(kind)).
+		Str("format", string(format)).
+		Int("rows", rows).
+		Msg("Export streamed")
+}
+
+// attachmentWriter holds back the file headers until the export writes its
+// first bytes, so errors raised before then still go out as plain JSON
+type attachmentWriter struct {
+	c           *gin.Context
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (w *attachmentWriter) start() {
+	w.started = true
+	w.c.Header("Content-Type", w.contentType)
+	w.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, w.filename))
+	w.c.Header("Cache-Control", "no-store")
+	w.c.Status(http.StatusOK)
+}
+
+func (w *attachmentWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.start()
+	}
+	return w.c.Writer.Write(p)
+}
+
+func respondExportError(c *gin.Context, err error, eventID uuid.UUID) {
+	switch {
+	case errors.Is(err, serviceexport.ErrEventNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"status":  "error",
+			"message": "Event not found or has been deleted",
+		})
+	case errors.Is(err, serviceexport.ErrNotEventOrganizer):
+		c.JSON(http.StatusForbidden, gin.H{
+			"status":  "error",
+			"message": "You don't have permission to export this event's data",
+		})
+	default:
+		log.Error().Err(err).Str("event_id", eventID.String()).Msg("Failed to prepare export")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status":  "error",
+			"message": "Failed to export. Please try again later.",
+		})
+	}
+}
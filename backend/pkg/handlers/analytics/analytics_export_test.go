@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachmentWriter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newWriter := func() (*attachmentWriter, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(rec)
+		return &attachmentWriter{c: c, contentType: "text/csv; charset=utf-8", filename: "gala-orders.csv"}, rec
+	}
+
+	t.Run("Errors before the first row stay JSON", func(t *testing.T) {
+		w, rec := newWriter()
+		w.c.JSON(http.StatusInternalServerError, gin.H{"status": "error"})
+
+		assert.False(t, w.started)
+		assert.Contains(t, rec.Header().Get("Content-Type"), "application/json")
+		assert.Empty(t, rec.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("The first row sends the attachment headers", func(t *testing.T) {
+		w, rec := newWriter()
+		_, err := w.Write([]byte("Reference,Status\n"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="gala-orders.csv"`, rec.Header().Get("Content-Disposition"))
+		assert.Equal(t, "Reference,Status\n", rec.Body.String())
+	})
+}
@@ -0,0 +1,91 @@
+// backend/pkg/handlers/analytics/analytics_platform.go
+// Analytics handler - platform-wide admin endpoints
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/export"
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// GetPlatformAnalytics returns marketplace-wide sales, orders, vendor,
+// feedback and user metrics for admins
+// GET /api/v1/admin/analytics?from=2025-01-01&to=2025-01-31
+func (h *AnalyticsHandler) GetPlatformAnalytics(c *gin.Context) {
+	q := models.PlatformAnalyticsQuery{From: c.Query("from"), To: c.Query("to")}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	result, err := h.analyticsService.GetPlatformAnalytics(ctx, q)
+	if err != nil {
+		respondPlatformError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Platform analytics retrieved successfully",
+		"data":    result,
+	})
+}
+
+// ExportPlatformAnalytics downloads the same report as a file
+// GET /api/v1/admin/analytics/export?from=2025-01-01&to=2025-01-31&format=csv|xlsx
+func (h *AnalyticsHandler) ExportPlatformAnalytics(c *gin.Context) {
+	format, ok := export.ParseFormat(c.DefaultQuery("format", string(export.FormatCSV)))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "format must be csv or xlsx",
+		})
+		return
+	}
+	q := models.PlatformAnalyticsQuery{From: c.Query("from"), To: c.Query("to")}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Minute)
+	defer cancel()
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, format.Filename("platform-analytics", time.Now())))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+
+	if _, err := h.exportService.WritePlatformExport(ctx, q, format, c.Writer); err != nil {
+		// Nothing sent yet, so the client can still get a proper error
+		if !c.Writer.Written() {
+			c.Writer.Header().Del("Content-Disposition")
+			c.Writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+			respondPlatformError(c, err)
+			return
+		}
+		log.Error().Err(err).Msg("Platform analytics export failed")
+	}
+}
+
+func respondPlatformError(c *gin.Context, err error) {
+	var appErr *utils.AppError
+	if errors.As(err, &appErr) && appErr.Category == utils.ErrCategoryValidation {
+		c.JSON(appErr.HTTPStatus(), gin.H{
+			"status":  "error",
+			"message": appErr.Message,
+		})
+		return
+	}
+
+	log.Error().Err(err).Msg("Failed to fetch platform analytics")
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"status":  "error",
+		"message": "Failed to fetch platform analytics. Please try again later.",
+	})
+}
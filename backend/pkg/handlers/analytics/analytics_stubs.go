@@ -1,100 +0,0 @@
-// backend/pkg/handlers/analytics/analytics_stubs.go
-// Analytics handler - stub/placeholder endpoints
-
-package handlers
-
-import (
-	"net/http"
-
-	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
-	"github.com/rs/zerolog/log"
-)
-
-func (h *AnalyticsHandler) FetchEventTimeline(c *gin.Context) {
-	eventIDParam := c.Param("eventId")
-	groupBy := c.DefaultQuery("groupBy", "day")
-
-	eventID, err := uuid.Parse(eventIDParam)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"message": "Invalid event ID format",
-		})
-		return
-	}
-
-	log.Info().Str("event_id", eventID.String()).Str("group_by", groupBy).Msg("Timeline endpoint called")
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"status":  "info",
-		"message": "Timeline analytics coming soon",
-		"params": gin.H{
-			"eventId": eventID.String(),
-			"groupBy": groupBy,
-		},
-	})
-}
-
-func (h *AnalyticsHandler) FetchOrganizerDashboard(c *gin.Context) {
-	organizerIDVal, exists := c.Get("userID")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"message": "Authentication required",
-		})
-		return
-	}
-
-	var organizerID uuid.UUID
-	var err error
-	switch v := organizerIDVal.(type) {
-	case string:
-		organizerID, err = uuid.Parse(v)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"status":  "error",
-				"message": "Internal server error",
-			})
-			return
-		}
-	case uuid.UUID:
-		organizerID = v
-	default:
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"message": "Internal server error",
-		})
-		return
-	}
-
-	log.Info().Str("organizer_id", organizerID.String()).Msg("Organizer dashboard endpoint called")
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"status":      "info",
-		"message":     "Organizer-wide analytics coming soon",
-		"organizerId": organizerID.String(),
-	})
-}
-
-func (h *AnalyticsHandler) ExportEventAnalytics(c *gin.Context) {
-	eventIDParam := c.Param("eventId")
-	format := c.DefaultQuery("format", "csv")
-
-	eventID, err := uuid.Parse(eventIDParam)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"message": "Invalid event ID format",
-		})
-		return
-	}
-
-	log.Info().Str("event_id", eventID.String()).Str("format", format).Msg("Export endpoint called")
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"status":  "info",
-		"message": "Export functionality coming soon",
-		"params": gin.H{
-			"eventId": eventID.String(),
-			"format":  format,
-		},
-	})
-}
\ No newline at end of file
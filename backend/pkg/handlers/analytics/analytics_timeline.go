@@ -0,0 +1,125 @@
+// backend/pkg/handlers/analytics/analytics_timeline.go
+// Analytics handler - sales timeline endpoint
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	serviceanalytics "github.com/eventify/backend/pkg/services/analytics"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// FetchEventTimeline returns tickets and revenue per bucket and per tier
+// GET /api/events/:eventId/analytics/timeline?groupBy=hour|day|week&tz=Africa/Lagos&from=&to=
+// from/to accept RFC3339 timestamps or plain dates (read in tz)
+func (h *AnalyticsHandler) FetchEventTimeline(c *gin.Context) {
+	organizerIDVal, exists := c.Get("user_id")
+	organizerID, ok := organizerIDVal.(uuid.UUID)
+	if !exists || !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "error",
+			"message": "Authentication required",
+		})
+		return
+	}
+
+	eventID, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Invalid event ID format",
+		})
+		return
+	}
+
+	query := models.TimelineQuery{
+		GroupBy:  c.DefaultQuery("groupBy", "day"),
+		Timezone: c.DefaultQuery("tz", serviceanalytics.DefaultTimelineTimezone),
+	}
+
+	loc, err := time.LoadLocation(query.Timezone)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  "error",
+			"message": "Unknown timezone: " + query.Timezone,
+		})
+		return
+	}
+
+	for _, bound := range []struct {
+		param string
+		dest  **time.Time
+	}{{"from", &query.From}, {"to", &query.To}} {
+		raw := c.Query(bound.param)
+		if raw == "" {
+			continue
+		}
+		t, err := parseTimelineBound(raw, loc)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  "error",
+				"message": "Invalid " + bound.param + ": use RFC3339 or YYYY-MM-DD",
+			})
+			return
+		}
+		*bound.dest = &t
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	timeline, err := h.analyticsService.GetSalesTimeline(ctx, eventID, organizerID, query)
+	if err != nil {
+		var appErr *utils.AppError
+		switch {
+		case errors.Is(err, serviceanalytics.ErrNotEventOrganizer):
+			log.Warn().
+				Str("event_id", eventID.String()).
+				Str("organizer_id", organizerID.String()).
+				Msg("Unauthorized timeline access attempt")
+			c.JSON(http.StatusForbidden, gin.H{
+				"status":  "error",
+				"message": "You don't have permission to view this event's analytics",
+			})
+		case errors.Is(err, serviceanalytics.ErrEventNotFound):
+			c.JSON(http.StatusNotFound, gin.H{
+				"status":  "error",
+				"message": "Event not found or has been deleted",
+			})
+		case errors.As(err, &appErr):
+			c.JSON(appErr.HTTPStatus(), gin.H{
+				"status":  "error",
+				"message": appErr.Message,
+			})
+		default:
+			log.Error().Err(err).Str("event_id", eventID.String()).Msg("Failed to fetch sales timeline")
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status":  "error",
+				"message": "Failed to fetch timeline. Please try again later.",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Timeline retrieved successfully",
+		"data":    timeline,
+	})
+}
+
+func parseTimelineBound(raw string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02", raw, loc)
+}
@@ -0,0 +1,360 @@
+// backend/pkg/handlers/booking/booking.go
+
+package booking
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	servicebooking "github.com/eventify/backend/pkg/services/booking"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type BookingHandler struct {
+	bookingService servicebooking.BookingService
+}
+
+func NewBookingHandler(bookingService servicebooking.BookingService) *BookingHandler {
+	return &BookingHandler{bookingService: bookingService}
+}
+
+// ============================================================================
+// PACKAGES
+// ============================================================================
+
+// ListPackages returns a vendor's service packages
+// GET /api/v1/vendors/:id/packages
+func (h *BookingHandler) ListPackages(c *gin.Context) {
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	packages, err := h.bookingService.ListPackages(ctx, vendorID)
+	if err != nil {
+		respondBookingError(c, err, "Failed to fetch packages")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": packages})
+}
+
+// CreatePackage publishes a new package on the caller's vendor profile
+// POST /api/v1/vendors/:id/packages
+func (h *BookingHandler) CreatePackage(c *gin.Context) {
+	ownerID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+
+	var input models.VendorPackageInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "Invalid request body", "errors": utils.GetValidationErrors(err)})
+		return
+	}
+
+	pkg, err := h.bookingService.CreatePackage(c.Request.Context(), vendorID, ownerID, input)
+	if err != nil {
+		respondBookingError(c, err, "Failed to create package")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "success", "data": pkg})
+}
+
+// UpdatePackage replaces a package's details
+// PUT /api/v1/vendors/:id/packages/:packageId
+func (h *BookingHandler) UpdatePackage(c *gin.Context) {
+	ownerID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+	packageID, ok := parseID(c, "packageId", "package")
+	if !ok {
+		return
+	}
+
+	var input models.VendorPackageInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "Invalid request body", "errors": utils.GetValidationErrors(err)})
+		return
+	}
+
+	pkg, err := h.bookingService.UpdatePackage(c.Request.Context(), vendorID, packageID, ownerID, input)
+	if err != nil {
+		respondBookingError(c, err, "Failed to update package")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": pkg})
+}
+
+// DeletePackage removes a package from the caller's vendor profile
+// DELETE /api/v1/vendors/:id/packages/:packageId
+func (h *BookingHandler) DeletePackage(c *gin.Context) {
+	ownerID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+	packageID, ok := parseID(c, "packageId", "package")
+	if !ok {
+		return
+	}
+
+	if err := h.bookingService.DeletePackage(c.Request.Context(), vendorID, packageID, ownerID); err != nil {
+		respondBookingError(c, err, "Failed to delete package")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "message": "Package deleted"})
+}
+
+// ============================================================================
+// QUOTES (VENDOR)
+// ============================================================================
+
+// CreateQuote sends a quote for one of the vendor's inquiries
+// POST /api/v1/vendors/:id/quotes
+func (h *BookingHandler) CreateQuote(c *gin.Context) {
+	ownerID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+
+	var input models.QuoteInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "Invalid request body", "errors": utils.GetValidationErrors(err)})
+		return
+	}
+
+	quote, err := h.bookingService.CreateQuote(c.Request.Context(), vendorID, ownerID, input)
+	if err != nil {
+		respondBookingError(c, err, "Failed to create quote")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "success", "data": quote})
+}
+
+// ListInquiryQuotes returns the quotes sent for one inquiry
+// GET /api/v1/vendors/:id/quotes?inquiryId=
+func (h *BookingHandler) ListInquiryQuotes(c *gin.Context) {
+	ownerID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+	inquiryID, err := uuid.Parse(c.Query("inquiryId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "inquiryId is required"})
+		return
+	}
+
+	quotes, err := h.bookingService.ListInquiryQuotes(c.Request.Context(), vendorID, ownerID, inquiryID)
+	if err != nil {
+		respondBookingError(c, err, "Failed to fetch quotes")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": quotes})
+}
+
+// ============================================================================
+// QUOTES (CLIENT)
+// ============================================================================
+
+// GetQuote shows a quote to the client who sent the inquiry
+// GET /api/v1/quotes/:quoteId
+func (h *BookingHandler) GetQuote(c *gin.Context) {
+	quoteID, ok := parseID(c, "quoteId", "quote")
+	if !ok {
+		return
+	}
+
+	quote, err := h.bookingService.GetClientQuote(c.Request.Context(), quoteID, quoteClient(c))
+	if err != nil {
+		respondBookingError(c, err, "Failed to fetch quote")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": quote})
+}
+
+// AcceptQuote accepts an open quote, creating the booking
+// POST /api/v1/quotes/:quoteId/accept
+func (h *BookingHandler) AcceptQuote(c *gin.Context) {
+	quoteID, ok := parseID(c, "quoteId", "quote")
+	if !ok {
+		return
+	}
+
+	booking, err := h.bookingService.AcceptQuote(c.Request.Context(), quoteID, quoteClient(c))
+	if err != nil {
+		respondBookingError(c, err, "Failed to accept quote")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "success", "data": booking})
+}
+
+// DeclineQuote turns down an open quote
+// POST /api/v1/quotes/:quoteId/decline
+func (h *BookingHandler) DeclineQuote(c *gin.Context) {
+	quoteID, ok := parseID(c, "quoteId", "quote")
+	if !ok {
+		return
+	}
+
+	if err := h.bookingService.DeclineQuote(c.Request.Context(), quoteID, quoteClient(c)); err != nil {
+		respondBookingError(c, err, "Failed to decline quote")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "message": "Quote declined"})
+}
+
+// ============================================================================
+// BOOKINGS
+// ============================================================================
+
+// ListBookings returns the vendor's bookings, optionally between two dates
+// GET /api/v1/vendors/:id/bookings?from=YYYY-MM-DD&to=YYYY-MM-DD
+func (h *BookingHandler) ListBookings(c *gin.Context) {
+	ownerID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+
+	bookings, err := h.bookingService.ListBookings(c.Request.Context(), vendorID, ownerID, c.Query("from"), c.Query("to"))
+	if err != nil {
+		respondBookingError(c, err, "Failed to fetch bookings")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": bookings})
+}
+
+// UpdateBooking changes a booking's status or deposit status
+// PATCH /api/v1/vendors/:id/bookings/:bookingId
+func (h *BookingHandler) UpdateBooking(c *gin.Context) {
+	ownerID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+	bookingID, ok := parseID(c, "bookingId", "booking")
+	if !ok {
+		return
+	}
+
+	var update models.BookingUpdate
+	if err := c.ShouldBindJSON(&update); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "Invalid request body"})
+		return
+	}
+
+	booking, err := h.bookingService.UpdateBooking(c.Request.Context(), vendorID, bookingID, ownerID, update)
+	if err != nil {
+		respondBookingError(c, err, "Failed to update booking")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": booking})
+}
+
+// ============================================================================
+// HELPERS
+// ============================================================================
+
+func requireUser(c *gin.Context) (uuid.UUID, bool) {
+	userIDVal, exists := c.Get("user_id")
+	userID, ok := userIDVal.(uuid.UUID)
+	if !exists || !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": "error", "message": "Authentication required"})
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+func parseID(c *gin.Context, param, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(param))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "Invalid " + name + " ID format"})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// quoteClient identifies the caller by user if signed in and by the
+// guest_id cookie either way
+func quoteClient(c *gin.Context) models.QuoteClient {
+	client := models.QuoteClient{}
+	if idVal, exists := c.Get("user_id"); exists {
+		if id, ok := idVal.(uuid.UUID); ok {
+			client.UserID = &id
+		}
+	}
+	client.GuestID, _ = c.Cookie("guest_id")
+	return client
+}
+
+func respondBookingError(c *gin.Context, err error, fallback string) {
+	var appErr *utils.AppError
+	switch {
+	case errors.As(err, &appErr):
+		c.JSON(appErr.HTTPStatus(), gin.H{"status": "error", "message": appErr.Message})
+	case errors.Is(err, servicebooking.ErrVendorNotFound),
+		errors.Is(err, servicebooking.ErrInquiryNotFound),
+		errors.Is(err, servicebooking.ErrPackageNotFound),
+		errors.Is(err, servicebooking.ErrQuoteNotFound),
+		errors.Is(err, servicebooking.ErrBookingNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "message": err.Error()})
+	case errors.Is(err, servicebooking.ErrNotVendorOwner),
+		errors.Is(err, servicebooking.ErrNotQuoteClient):
+		c.JSON(http.StatusForbidden, gin.H{"status": "error", "message": err.Error()})
+	case errors.Is(err, servicebooking.ErrQuoteNotOpen),
+		errors.Is(err, servicebooking.ErrInquiryBooked),
+		errors.Is(err, servicebooking.ErrBookingChanged),
+		errors.Is(err, servicebooking.ErrDateUnavailable):
+		c.JSON(http.StatusConflict, gin.H{"status": "error", "message": err.Error()})
+	default:
+		log.Error().Err(err).Str("path", c.FullPath()).Msg(fallback)
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "message": fallback})
+	}
+}
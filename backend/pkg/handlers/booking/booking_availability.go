@@ -0,0 +1,90 @@
+// backend/pkg/handlers/booking/booking_availability.go
+
+package booking
+
+import (
+	"net/http"
+
+	"github.com/eventify/backend/pkg/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetAvailability returns the days a vendor is booked or has blocked
+// GET /api/v1/vendors/:id/availability?from=YYYY-MM-DD&to=YYYY-MM-DD
+func (h *BookingHandler) GetAvailability(c *gin.Context) {
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+
+	var viewerID *uuid.UUID
+	if idVal, exists := c.Get("user_id"); exists {
+		if id, ok := idVal.(uuid.UUID); ok {
+			viewerID = &id
+		}
+	}
+
+	availability, err := h.bookingService.GetAvailability(c.Request.Context(), vendorID, viewerID, c.Query("from"), c.Query("to"))
+	if err != nil {
+		respondBookingError(c, err, "Failed to fetch availability")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": availability})
+}
+
+// BlockDates marks days unavailable on the caller's vendor calendar
+// POST /api/v1/vendors/:id/availability/blocks  {"from", "to", "reason"}
+func (h *BookingHandler) BlockDates(c *gin.Context) {
+	ownerID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+
+	var input models.DateBlockInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "from is required"})
+		return
+	}
+
+	blocked, err := h.bookingService.BlockDates(c.Request.Context(), vendorID, ownerID, input)
+	if err != nil {
+		respondBookingError(c, err, "Failed to block dates")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": gin.H{"blocked": blocked}})
+}
+
+// UnblockDates frees blocked days on the caller's vendor calendar
+// DELETE /api/v1/vendors/:id/availability/blocks?from=YYYY-MM-DD&to=YYYY-MM-DD
+func (h *BookingHandler) UnblockDates(c *gin.Context) {
+	ownerID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	vendorID, ok := parseID(c, "id", "vendor")
+	if !ok {
+		return
+	}
+
+	var input models.DateBlockInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "from is required"})
+		return
+	}
+
+	unblocked, err := h.bookingService.UnblockDates(c.Request.Context(), vendorID, ownerID, input)
+	if err != nil {
+		respondBookingError(c, err, "Failed to unblock dates")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": gin.H{"unblocked": unblocked}})
+}
@@ -5,6 +5,7 @@ package event
 import (
 	serviceevent "github.com/eventify/backend/pkg/services/event"
 	servicelike "github.com/eventify/backend/pkg/services/like"
+	servicetracking "github.com/eventify/backend/pkg/services/tracking"
 	"github.com/eventify/backend/pkg/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -14,12 +15,14 @@ import (
 type EventHandler struct {
 	eventService serviceevent.EventService
     likeService  servicelike.LikeService
+	trackingService servicetracking.TrackingService
 }
 
-func NewEventHandler(eventService serviceevent.EventService, likeService servicelike.LikeService) *EventHandler {
+func NewEventHandler(eventService serviceevent.EventService, likeService servicelike.LikeService, trackingService servicetracking.TrackingService) *EventHandler {
     return &EventHandler{
         eventService: eventService,
         likeService:  likeService,
+        trackingService: trackingService,
     }
 }
 
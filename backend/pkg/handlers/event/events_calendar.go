@@ -0,0 +1,125 @@
+// backend/pkg/handlers/event/events_calendar.go
+
+package event
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ============================================================================
+// CALENDAR HANDLERS
+// ============================================================================
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+// GetEventCalendar downloads one event as .ics (GET /events/:eventId/calendar.ics)
+func (h *EventHandler) GetEventCalendar(c *gin.Context) {
+	eventID, err := parseEventID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid event ID"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	ics, event, err := h.eventService.GetEventCalendar(ctx, eventID, extractOptionalUserID(c), c.Query("token"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"message": "Event not found"})
+		return
+	}
+
+	filename := event.ID.String()
+	if event.EventSlug.Valid && event.EventSlug.String != "" {
+		filename = event.EventSlug.String
+	}
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`.ics"`)
+	c.Data(http.StatusOK, calendarContentType, ics)
+}
+
+// GetCalendarLinks returns add-to-calendar links (GET /events/:eventId/calendar-links)
+func (h *EventHandler) GetCalendarLinks(c *gin.Context) {
+	eventID, err := parseEventID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid event ID"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	links, err := h.eventService.GetCalendarLinks(ctx, eventID, extractOptionalUserID(c), c.Query("token"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"message": "Event not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, links)
+}
+
+// GetMyCalendarFeed downloads the signed-in user's ticket calendar
+// (GET /api/events/my-tickets/calendar.ics)
+func (h *EventHandler) GetMyCalendarFeed(c *gin.Context) {
+	userID, err := extractUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication required"})
+		return
+	}
+	h.writeUserCalendarFeed(c, userID)
+}
+
+// GetMyCalendarFeedURL returns a private subscription URL for calendar apps
+// (GET /api/events/my-tickets/calendar-url)
+func (h *EventHandler) GetMyCalendarFeedURL(c *gin.Context) {
+	userID, err := extractUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication required"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"url": "/calendar/feed/" + utils.SignCalendarFeedToken(userID.String()) + ".ics",
+	})
+}
+
+// GetCalendarFeedByToken serves a subscribed calendar (GET /calendar/feed/:token).
+// Calendar apps can't log in, so the signed token identifies the user.
+func (h *EventHandler) GetCalendarFeedByToken(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	rawUserID, ok := utils.VerifyCalendarFeedToken(token)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"message": "Calendar feed not found"})
+		return
+	}
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"message": "Calendar feed not found"})
+		return
+	}
+
+	h.writeUserCalendarFeed(c, userID)
+}
+
+func (h *EventHandler) writeUserCalendarFeed(c *gin.Context, userID uuid.UUID) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	ics, err := h.eventService.GetUserCalendarFeed(ctx, userID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", userID.String()).Msg("Failed to build calendar feed")
+		respondServiceError(c, err, "Failed to build calendar feed")
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=900")
+	c.Data(http.StatusOK, calendarContentType, ics)
+}
@@ -0,0 +1,81 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	repoevent "github.com/eventify/backend/pkg/repository/event"
+	serviceevent "github.com/eventify/backend/pkg/services/event"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// visibilityRepo serves a single event; the other repository methods are unused here
+type visibilityRepo struct {
+	repoevent.EventRepository
+	event *models.Event
+}
+
+func (r *visibilityRepo) GetEventByID(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID) (*models.Event, error) {
+	copied := *r.event
+	return &copied, nil
+}
+
+func (r *visibilityRepo) GetEventSessions(ctx context.Context, eventID uuid.UUID) ([]models.EventSession, error) {
+	return []models.EventSession{}, nil
+}
+
+func TestPublicScheduleRoutesHideUnpublishedEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	token := "share-token"
+	event := &models.Event{
+		ID:          uuid.New(),
+		OrganizerID: uuid.New(),
+		EventTitle:  "Secret launch",
+		StartDate:   time.Now().Add(24 * time.Hour),
+		EndDate:     time.Now().Add(26 * time.Hour),
+		AccessToken: &token,
+	}
+	repo := &visibilityRepo{event: event}
+	handler := NewEventHandler(serviceevent.NewEventService(nil, repo, nil, nil, nil), nil, nil)
+
+	router := gin.New()
+	router.GET("/events/:eventId/sessions", handler.GetEventSessions)
+	router.GET("/events/:eventId/calendar.ics", handler.GetEventCalendar)
+	router.GET("/events/:eventId/calendar-links", handler.GetCalendarLinks)
+
+	get := func(path string) int {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events/"+event.ID.String()+path, nil))
+		return rec.Code
+	}
+	paths := []string{"/sessions", "/calendar.ics", "/calendar-links"}
+
+	t.Run("Draft events are not found", func(t *testing.T) {
+		event.Status = models.EventStatusDraft
+		for _, path := range paths {
+			assert.Equal(t, http.StatusNotFound, get(path), path)
+		}
+	})
+
+	t.Run("Unlisted events need the share token", func(t *testing.T) {
+		event.Status = models.EventStatusUnlisted
+		for _, path := range paths {
+			assert.Equal(t, http.StatusNotFound, get(path), path)
+			assert.Equal(t, http.StatusOK, get(path+"?token="+token), path)
+		}
+	})
+
+	t.Run("Published events are public", func(t *testing.T) {
+		event.Status = models.EventStatusPublished
+		for _, path := range paths {
+			assert.Equal(t, http.StatusOK, get(path), path)
+		}
+	})
+}
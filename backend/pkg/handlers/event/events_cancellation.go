@@ -0,0 +1,101 @@
+// backend/pkg/handlers/event/events_cancellation.go
+
+package event
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// ============================================================================
+// CANCELLATION & POSTPONEMENT HANDLERS
+// ============================================================================
+
+// CancelEvent cancels an event and refunds every holder (POST /api/events/:eventId/cancel)
+func (h *EventHandler) CancelEvent(c *gin.Context) {
+	organizerID, eventID, ok := organizerAndEvent(c)
+	if !ok {
+		return
+	}
+
+	var req models.CancelEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid cancellation data",
+			"errors":  utils.GetValidationErrors(err),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	progress, err := h.eventService.CancelEvent(ctx, eventID, organizerID, req.Reason)
+	if err != nil {
+		log.Error().Err(err).Str("event_id", eventID.String()).Msg("Failed to cancel event")
+		respondServiceError(c, err, "Failed to cancel event")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Event cancelled. Ticket holders are being notified and refunded.",
+		"refunds": progress,
+	})
+}
+
+// PostponeEvent moves an event to new dates (POST /api/events/:eventId/postpone)
+func (h *EventHandler) PostponeEvent(c *gin.Context) {
+	organizerID, eventID, ok := organizerAndEvent(c)
+	if !ok {
+		return
+	}
+
+	var req models.PostponeEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid postponement data",
+			"errors":  utils.GetValidationErrors(err),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	event, err := h.eventService.PostponeEvent(ctx, eventID, organizerID, &req)
+	if err != nil {
+		log.Error().Err(err).Str("event_id", eventID.String()).Msg("Failed to postpone event")
+		respondServiceError(c, err, "Failed to postpone event")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Event postponed. Ticket holders have been notified.",
+		"event":   event,
+	})
+}
+
+// GetRefundProgress reports refund progress (GET /api/events/:eventId/refunds)
+func (h *EventHandler) GetRefundProgress(c *gin.Context) {
+	organizerID, eventID, ok := organizerAndEvent(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	progress, err := h.eventService.GetRefundProgress(ctx, eventID, organizerID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to fetch refund progress")
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
@@ -29,7 +29,8 @@ type EventCreateRequest struct {
 	EventDescription string            `json:"eventDescription" binding:"required"`
 	Category         string            `json:"category" binding:"required"`
 	EventType        string            `json:"eventType" binding:"required,oneof=physical virtual"`
-	EventImageURL    string            `json:"eventImage" binding:"required"`
+	EventImageURL    string            `json:"eventImage" binding:"required_without=ImageAssetID"`
+	ImageAssetID     *uuid.UUID        `json:"imageAssetId"` // From POST /api/v1/media/images; preferred over eventImage
 	VenueName        *string           `json:"venueName"`
 	VenueAddress     *string           `json:"venueAddress"`
 	City             *string           `json:"city"`
@@ -37,10 +38,15 @@ type EventCreateRequest struct {
 	Country          *string           `json:"country"`
 	VirtualPlatform  *string           `json:"virtualPlatform"`
 	MeetingLink      *string           `json:"meetingLink"`
+	Latitude         *float64          `json:"latitude" binding:"omitempty,min=-90,max=90"`
+	Longitude        *float64          `json:"longitude" binding:"omitempty,min=-180,max=180"`
 	StartDate        time.Time         `json:"startDate" binding:"required"`
 	EndDate          time.Time         `json:"endDate" binding:"required"`
 	MaxAttendees     *int32            `json:"maxAttendees"`
 	Tags             []string          `json:"tags"`
+	ReentryPolicy    string            `json:"reentryPolicy" binding:"omitempty,oneof=single in_out"`
+	Status           string            `json:"status" binding:"omitempty,oneof=draft scheduled published unlisted"`
+	PublishAt        *time.Time        `json:"publishAt"`
 	TicketTiers      []TicketTierInput `json:"ticketTiers" binding:"required,min=1"`
 }
 
@@ -53,6 +59,7 @@ func (req *EventCreateRequest) MapToModels() (*models.Event, []models.TicketTier
 		Category:         req.Category,
 		EventType:        models.EventType(req.EventType),
 		EventImageURL:    req.EventImageURL,
+		ImageAssetID:     req.ImageAssetID,
 		VenueName:        req.VenueName,
 		VenueAddress:     req.VenueAddress,
 		City:             req.City,
@@ -60,10 +67,15 @@ func (req *EventCreateRequest) MapToModels() (*models.Event, []models.TicketTier
 		Country:          req.Country,
 		VirtualPlatform:  req.VirtualPlatform,
 		MeetingLink:      req.MeetingLink,
+		Latitude:         req.Latitude,
+		Longitude:        req.Longitude,
 		StartDate:        req.StartDate,
 		EndDate:          req.EndDate,
 		MaxAttendees:     req.MaxAttendees,
 		Tags:             req.Tags,
+		ReentryPolicy:    models.ReentryPolicy(req.ReentryPolicy),
+		Status:           models.EventStatus(req.Status),
+		PublishAt:        req.PublishAt,
 	}
 	if event.Tags == nil {
 		event.Tags = []string{}
@@ -8,7 +8,7 @@ import (
 	"time"
 
 
-	//"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/models"
 	serviceevent "github.com/eventify/backend/pkg/services/event"
 	"github.com/eventify/backend/pkg/utils"
 
@@ -169,7 +169,13 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	
-	updatedEvent, err := h.eventService.UpdateEvent(ctx, eventID, organizerID, &updates)
+	// ?scope=future applies the change to this and every later occurrence of a series
+	var updatedEvent *models.Event
+	if models.UpdateScope(c.DefaultQuery("scope", string(models.UpdateScopeThis))) == models.UpdateScopeFuture {
+		updatedEvent, err = h.eventService.UpdateSeriesFromOccurrence(ctx, eventID, organizerID, &updates)
+	} else {
+		updatedEvent, err = h.eventService.UpdateEvent(ctx, eventID, organizerID, &updates)
+	}
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to update event")
 		
@@ -4,8 +4,10 @@ package event
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/eventify/backend/pkg/models"
@@ -22,6 +24,15 @@ import (
 // PUBLIC EVENT HANDLERS (Public Listing & Likes)
 // ============================================================================
 
+// maxSearchQueryLength caps the ?q= keyword search
+const maxSearchQueryLength = 200
+
+// Radius bounds (km) for ?near= discovery
+const (
+	defaultNearRadiusKm = 25
+	maxNearRadiusKm     = 500
+)
+
 func (h *EventHandler) GetAllEvents(c *gin.Context) {
 	// 1. Build filters from query params
 	filters := repoevent.EventFilters{
@@ -44,6 +55,48 @@ func (h *EventHandler) GetAllEvents(c *gin.Context) {
 		filters.City = &city
 	}
 	
+	// Keyword search
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		if runes := []rune(q); len(runes) > maxSearchQueryLength {
+			q = string(runes[:maxSearchQueryLength])
+		}
+		filters.Query = &q
+	}
+	
+	// Tag filters: ?tags=music,lagos or ?tags=music&tags=lagos
+	for _, raw := range c.QueryArray("tags") {
+		for _, tag := range strings.Split(raw, ",") {
+			if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+				filters.Tags = append(filters.Tags, tag)
+			}
+		}
+	}
+	
+	// Near-me discovery: ?near=6.52,3.37&radius=10
+	if near := c.Query("near"); near != "" {
+		lat, lng, err := utils.ParseLatLng(near)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
+		filters.Near = &models.GeoPoint{Lat: lat, Lng: lng}
+		filters.RadiusKm = defaultNearRadiusKm
+		if radius := c.Query("radius"); radius != "" {
+			r, err := strconv.ParseFloat(radius, 64)
+			if err != nil || r <= 0 || r > maxNearRadiusKm {
+				c.JSON(http.StatusBadRequest, gin.H{"message": "radius must be between 0 and 500 km"})
+				return
+			}
+			filters.RadiusKm = r
+		}
+	}
+	
+	// Sorting: relevance only applies when searching, distance only with near
+	switch sort := c.Query("sort"); sort {
+	case repoevent.SortRelevance, repoevent.SortDistance, repoevent.SortTrending:
+		filters.SortBy = sort
+	}
+	
 	// Date range filters
 	if startDate := c.Query("startDate"); startDate != "" {
 		if t, err := time.Parse(time.RFC3339, startDate); err == nil {
@@ -57,16 +110,12 @@ func (h *EventHandler) GetAllEvents(c *gin.Context) {
 		}
 	}
 	
-	// Pagination
-	if limit := c.Query("limit"); limit != "" {
-		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
-			filters.Limit = l
-		}
-	} else {
-		filters.Limit = 50 // Default
-	}
+	// Pagination: prefer ?cursor= from the previous page; ?offset= is still
+	// honoured for older clients but drifts as new events are added
+	filters.Limit = utils.ParsePageLimit(c.Query("limit"), 50, models.MaxPageLimit)
+	filters.Cursor = c.Query("cursor")
 	
-	if offset := c.Query("offset"); offset != "" {
+	if offset := c.Query("offset"); offset != "" && filters.Cursor == "" {
 		if o, err := strconv.Atoi(offset); err == nil && o >= 0 {
 			filters.Offset = o
 		}
@@ -80,8 +129,12 @@ func (h *EventHandler) GetAllEvents(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	
-	events, err := h.eventService.GetAllEvents(ctx, filters)
+	events, page, err := h.eventService.GetAllEvents(ctx, filters)
 	if err != nil {
+		if appErr, ok := err.(*utils.AppError); ok {
+			c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+			return
+		}
 		log.Error().Err(err).Msg("Failed to fetch public events")
 		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to fetch events"})
 		return
@@ -131,10 +184,16 @@ func (h *EventHandler) GetAllEvents(c *gin.Context) {
 	}
 	
 	// 4. Success response
+	if events == nil {
+		events = []*models.Event{}
+	}
+	
 	c.JSON(http.StatusOK, gin.H{
-		"events": events,
-		"total":  len(events),
-		"filters": filters,
+		"events":      events,
+		"total":       page.Total,
+		"next_cursor": page.NextCursor,
+		"limit":       page.Limit,
+		"filters":     filters,
 	})
 }
 
@@ -158,7 +217,8 @@ func (h *EventHandler) GetPublicEventByID(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	
-	event, err := h.eventService.GetEventByID(ctx, eventID, userID)
+	// Unlisted events need the share token from their link (?token=)
+	event, err := h.eventService.GetPublicEventByID(ctx, eventID, userID, c.Query("token"))
 	if err != nil {
 		log.Error().Err(err).Str("event_id", eventID.String()).Msg("Failed to fetch event")
 		
@@ -171,10 +231,67 @@ func (h *EventHandler) GetPublicEventByID(c *gin.Context) {
 		return
 	}
 	
+	h.trackView(c, event, userID)
+	
 	// 4. Success response
 	c.JSON(http.StatusOK, event)
 }
 
+// GetPublicEventBySlug serves /events/by-slug/:slug. Old slugs from before a
+// rename answer with a 301 to the current one so shared links keep working.
+func (h *EventHandler) GetPublicEventBySlug(c *gin.Context) {
+	slug := strings.ToLower(strings.TrimSpace(c.Param("slug")))
+	if slug == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Slug is required"})
+		return
+	}
+	
+	userID := extractOptionalUserID(c)
+	
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	
+	event, currentSlug, err := h.eventService.GetEventBySlug(ctx, slug, userID, c.Query("token"))
+	if err != nil {
+		if appErr, ok := err.(*utils.AppError); ok {
+			c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+			return
+		}
+		if !errors.Is(err, repoevent.ErrSlugNotFound) {
+			log.Error().Err(err).Str("slug", slug).Msg("Failed to fetch event by slug")
+		}
+		c.JSON(http.StatusNotFound, gin.H{"message": "Event not found"})
+		return
+	}
+	
+	if currentSlug != "" && currentSlug != slug {
+		location := "/events/by-slug/" + currentSlug
+		if c.Request.URL.RawQuery != "" {
+			location += "?" + c.Request.URL.RawQuery // keep ?token= for unlisted events
+		}
+		c.Redirect(http.StatusMovedPermanently, location)
+		return
+	}
+	
+	h.trackView(c, event, userID)
+	c.JSON(http.StatusOK, event)
+}
+
+// trackView feeds the view step of the sales funnel. Organizers checking
+// their own page aren't counted.
+func (h *EventHandler) trackView(c *gin.Context, event *models.Event, userID *uuid.UUID) {
+	if userID != nil && *userID == event.OrganizerID {
+		return
+	}
+	guestID, _ := c.Cookie("guest_id")
+	h.trackingService.TrackAsync(models.TrackingEvent{
+		EventID: event.ID,
+		Type:    models.TrackView,
+		UserID:  userID,
+		GuestID: guestID,
+	})
+}
+
 func (h *EventHandler) ToggleLike(c *gin.Context) {
     // 1. Parse event ID
     eventID, err := parseEventID(c)
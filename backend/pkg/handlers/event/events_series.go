@@ -0,0 +1,104 @@
+// backend/pkg/handlers/event/events_series.go
+
+package event
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ============================================================================
+// RECURRING SERIES HANDLERS
+// ============================================================================
+
+// EventSeriesCreateRequest is a regular create payload plus an RFC 5545 RRULE
+type EventSeriesCreateRequest struct {
+	EventCreateRequest
+	RRule string `json:"rrule" binding:"required"`
+}
+
+// CreateEventSeries creates a recurring event (POST /api/events/series)
+func (h *EventHandler) CreateEventSeries(c *gin.Context) {
+	organizerID, err := extractUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication required"})
+		return
+	}
+
+	var req EventSeriesCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid request data",
+			"errors":  utils.GetValidationErrors(err),
+		})
+		return
+	}
+	req.OrganizerID = organizerID
+
+	event, tiers := req.MapToModels()
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	series, err := h.eventService.CreateEventSeries(ctx, event, tiers, req.RRule)
+	if err != nil {
+		log.Error().Err(err).Str("organizer_id", organizerID.String()).Msg("Failed to create event series")
+		respondServiceError(c, err, "Failed to create event series")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Event series created successfully",
+		"series":  series,
+	})
+}
+
+// GetEventSeries returns a series and its occurrences (GET /api/events/series/:seriesId)
+func (h *EventHandler) GetEventSeries(c *gin.Context) {
+	organizerID, err := extractUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication required"})
+		return
+	}
+
+	seriesID, err := uuid.Parse(c.Param("seriesId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid series ID"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	series, err := h.eventService.GetEventSeries(ctx, seriesID, organizerID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to fetch event series")
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// CancelOccurrence cancels one date of a series (POST /api/events/:eventId/cancel-occurrence)
+func (h *EventHandler) CancelOccurrence(c *gin.Context) {
+	organizerID, eventID, ok := organizerAndEvent(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	if err := h.eventService.CancelOccurrence(ctx, eventID, organizerID); err != nil {
+		respondServiceError(c, err, "Failed to cancel occurrence")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Occurrence cancelled successfully"})
+}
@@ -0,0 +1,200 @@
+// backend/pkg/handlers/event/events_sessions.go
+
+package event
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	serviceevent "github.com/eventify/backend/pkg/services/event"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ============================================================================
+// SESSION & TRACK HANDLERS
+// ============================================================================
+
+// GetEventSessions returns the public schedule (GET /events/:eventId/sessions)
+func (h *EventHandler) GetEventSessions(c *gin.Context) {
+	eventID, err := parseEventID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid event ID"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	sessions, err := h.eventService.GetEventSessions(ctx, eventID, extractOptionalUserID(c), c.Query("token"))
+	if err != nil {
+		if errors.Is(err, serviceevent.ErrEventNotVisible) {
+			c.JSON(http.StatusNotFound, gin.H{"message": "Event not found"})
+			return
+		}
+		respondServiceError(c, err, "Failed to fetch sessions")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"sessions": sessions,
+		"total":    len(sessions),
+	})
+}
+
+// CreateSession adds a session (POST /api/events/:eventId/sessions)
+func (h *EventHandler) CreateSession(c *gin.Context) {
+	organizerID, eventID, ok := organizerAndEvent(c)
+	if !ok {
+		return
+	}
+
+	var input models.EventSessionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid session data",
+			"errors":  utils.GetValidationErrors(err),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	session, err := h.eventService.CreateSession(ctx, eventID, organizerID, &input)
+	if err != nil {
+		respondServiceError(c, err, "Failed to create session")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Session created successfully",
+		"session": session,
+	})
+}
+
+// UpdateSession edits a session (PUT /api/events/:eventId/sessions/:sessionId)
+func (h *EventHandler) UpdateSession(c *gin.Context) {
+	organizerID, eventID, ok := organizerAndEvent(c)
+	if !ok {
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("sessionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid session ID"})
+		return
+	}
+
+	var input models.EventSessionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid session data",
+			"errors":  utils.GetValidationErrors(err),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	session, err := h.eventService.UpdateSession(ctx, eventID, sessionID, organizerID, &input)
+	if err != nil {
+		respondServiceError(c, err, "Failed to update session")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Session updated successfully",
+		"session": session,
+	})
+}
+
+// DeleteSession removes a session (DELETE /api/events/:eventId/sessions/:sessionId)
+func (h *EventHandler) DeleteSession(c *gin.Context) {
+	organizerID, eventID, ok := organizerAndEvent(c)
+	if !ok {
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("sessionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid session ID"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	if err := h.eventService.DeleteSession(ctx, eventID, sessionID, organizerID); err != nil {
+		respondServiceError(c, err, "Failed to delete session")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Session deleted successfully"})
+}
+
+// SetTierAccess restricts a tier to sessions/days (PUT /api/events/:eventId/tiers/:tierId/access)
+func (h *EventHandler) SetTierAccess(c *gin.Context) {
+	organizerID, eventID, ok := organizerAndEvent(c)
+	if !ok {
+		return
+	}
+
+	tierID, err := uuid.Parse(c.Param("tierId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ticket tier ID"})
+		return
+	}
+
+	var input models.TierAccessInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid access data",
+			"errors":  utils.GetValidationErrors(err),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	if err := h.eventService.SetTierAccess(ctx, eventID, tierID, organizerID, &input); err != nil {
+		respondServiceError(c, err, "Failed to update tier access")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tier access updated successfully"})
+}
+
+// organizerAndEvent extracts the authenticated organizer and :eventId, writing the error response on failure
+func organizerAndEvent(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	organizerID, err := extractUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication required"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	eventID, err := parseEventID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid event ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return organizerID, eventID, true
+}
+
+// respondServiceError maps AppErrors to their status and hides anything else behind a 500
+func respondServiceError(c *gin.Context, err error, fallback string) {
+	log.Error().Err(err).Str("path", c.FullPath()).Msg(fallback)
+	if appErr, ok := err.(*utils.AppError); ok {
+		c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"message": fallback})
+}
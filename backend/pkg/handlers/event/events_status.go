@@ -0,0 +1,46 @@
+// backend/pkg/handlers/event/events_status.go
+
+package event
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================================
+// LIFECYCLE HANDLERS
+// ============================================================================
+
+// ChangeEventStatus publishes, schedules, unlists, archives or returns an
+// event to draft (PATCH /api/events/:eventId/status)
+func (h *EventHandler) ChangeEventStatus(c *gin.Context) {
+	organizerID, eventID, ok := organizerAndEvent(c)
+	if !ok {
+		return
+	}
+
+	var req models.EventStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid status data",
+			"errors":  utils.GetValidationErrors(err),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.eventService.ChangeEventStatus(ctx, eventID, organizerID, &req)
+	if err != nil {
+		respondServiceError(c, err, "Failed to update event status")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
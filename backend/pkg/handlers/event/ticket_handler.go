@@ -3,34 +3,189 @@
 package event
 
 import (
+    "context"
     "net/http"
+    "time"
+
+    "github.com/eventify/backend/pkg/models"
+    "github.com/eventify/backend/pkg/utils"
     "github.com/gin-gonic/gin"
+    "github.com/rs/zerolog/log"
 )
 
 // CheckIn handles the gate scan request
 func (h *EventHandler) CheckIn(c *gin.Context) {
-    var req struct {
-        Code string `json:"code" binding:"required"`
-    }
+    var req models.CheckInRequest
 
     if err := c.ShouldBindJSON(&req); err != nil {
         c.JSON(http.StatusBadRequest, gin.H{"error": "Valid ticket code required"})
         return
     }
 
-    // Call the service we built earlier
-    err := h.eventService.ValidateAndCheckInTicket(c.Request.Context(), req.Code)
+    // Record which staff account performed the scan
+    req.StaffID = extractOptionalUserID(c)
+
+    scan, err := h.eventService.ValidateAndCheckInTicket(c.Request.Context(), &req)
     if err != nil {
+        appErr, ok := err.(*utils.AppError)
+        if !ok {
+            log.Error().Err(err).Str("code", req.Code).Msg("Gate check failed")
+            c.JSON(http.StatusInternalServerError, gin.H{
+                "status":  "error",
+                "message": "Could not verify ticket. Please retry.",
+            })
+            return
+        }
+
         // Handle specifically for Nigeria: clear error messages for the staff
         c.JSON(http.StatusConflict, gin.H{
             "status":  "denied",
-            "message": err.Error(),
+            "message": appErr.Message,
+            "scan":    scan,
         })
         return
     }
 
+    message := "Verified! Welcome to the event."
+    if scan.Direction == models.ScanOut {
+        message = "Exit recorded. Ticket can be used to re-enter."
+    }
+
     c.JSON(http.StatusOK, gin.H{
         "status":  "granted",
-        "message": "Verified! Welcome to the event.",
+        "message": message,
+        "scan":    scan,
     })
-}
\ No newline at end of file
+}
+
+// UndoCheckIn reverses an admission (POST /api/events/:eventId/check-ins/:code/undo)
+func (h *EventHandler) UndoCheckIn(c *gin.Context) {
+    organizerID, err := extractUserID(c)
+    if err != nil {
+        c.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication required"})
+        return
+    }
+
+    eventID, err := parseEventID(c)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid event ID"})
+        return
+    }
+
+    // Reason is optional but kept in the audit log
+    var body struct {
+        Reason string `json:"reason"`
+    }
+    _ = c.ShouldBindJSON(&body)
+
+    ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+    defer cancel()
+
+    if err := h.eventService.UndoCheckIn(ctx, eventID, organizerID, c.Param("code"), body.Reason); err != nil {
+        log.Error().Err(err).Str("event_id", eventID.String()).Msg("Failed to undo check-in")
+        if appErr, ok := err.(*utils.AppError); ok {
+            c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to undo check-in"})
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{"message": "Check-in reversed"})
+}
+
+// GetCheckInStats returns live check-in counts for the event dashboard
+func (h *EventHandler) GetCheckInStats(c *gin.Context) {
+    organizerID, err := extractUserID(c)
+    if err != nil {
+        c.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication required"})
+        return
+    }
+
+    eventID, err := parseEventID(c)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid event ID"})
+        return
+    }
+
+    ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+    defer cancel()
+
+    stats, err := h.eventService.GetCheckInStats(ctx, eventID, organizerID)
+    if err != nil {
+        log.Error().Err(err).Str("event_id", eventID.String()).Msg("Failed to load check-in stats")
+        if appErr, ok := err.(*utils.AppError); ok {
+            c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to load check-in stats"})
+        return
+    }
+
+    c.JSON(http.StatusOK, stats)
+}
+
+// GetGateManifest exports the signed offline manifest for scanner devices
+func (h *EventHandler) GetGateManifest(c *gin.Context) {
+    organizerID, err := extractUserID(c)
+    if err != nil {
+        c.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication required"})
+        return
+    }
+
+    eventID, err := parseEventID(c)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid event ID"})
+        return
+    }
+
+    ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+    defer cancel()
+
+    manifest, err := h.eventService.ExportGateManifest(ctx, eventID, organizerID)
+    if err != nil {
+        log.Error().Err(err).Str("event_id", eventID.String()).Msg("Failed to export gate manifest")
+        if appErr, ok := err.(*utils.AppError); ok {
+            c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to export gate manifest"})
+        return
+    }
+
+    c.JSON(http.StatusOK, manifest)
+}
+
+// SyncGateScans uploads offline scan logs collected while the device had no connectivity
+func (h *EventHandler) SyncGateScans(c *gin.Context) {
+    organizerID, err := extractUserID(c)
+    if err != nil {
+        c.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication required"})
+        return
+    }
+
+    var req models.GateSyncRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "message": "Invalid sync payload",
+            "errors":  utils.GetValidationErrors(err),
+        })
+        return
+    }
+
+    ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+    defer cancel()
+
+    result, err := h.eventService.SyncGateScans(ctx, organizerID, &req)
+    if err != nil {
+        log.Error().Err(err).Str("event_id", req.EventID.String()).Msg("Failed to sync gate scans")
+        if appErr, ok := err.(*utils.AppError); ok {
+            c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to sync gate scans"})
+        return
+    }
+
+    c.JSON(http.StatusOK, result)
+}
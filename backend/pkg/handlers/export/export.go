@@ -0,0 +1,92 @@
+// backend/pkg/handlers/export/export.go
+
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/export"
+	serviceexport "github.com/eventify/backend/pkg/services/export"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type ExportHandler struct {
+	exportService serviceexport.ExportService
+}
+
+func NewExportHandler(exportService serviceexport.ExportService) *ExportHandler {
+	return &ExportHandler{exportService: exportService}
+}
+
+// GetExportJob reports a background export's progress to its owner
+// GET /api/v1/exports/:jobId
+func (h *ExportHandler) GetExportJob(c *gin.Context) {
+	userIDVal, exists := c.Get("user_id")
+	organizerID, ok := userIDVal.(uuid.UUID)
+	if !exists || !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": "error", "message": "Authentication required"})
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("jobId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "Invalid export ID format"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	job, err := h.exportService.GetJob(ctx, jobID, organizerID)
+	if err != nil {
+		if errors.Is(err, serviceexport.ErrJobNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"status": "error", "message": "Export not found"})
+			return
+		}
+		log.Error().Err(err).Str("job_id", jobID.String()).Msg("Failed to fetch export job")
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "message": "Failed to fetch export"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success", "data": job})
+}
+
+// DownloadExport serves a finished export. The token is the credential, so
+// the link works straight from a browser or email without a session, and
+// it can be fetched again until the export expires.
+// GET /api/v1/exports/download/:token
+func (h *ExportHandler) DownloadExport(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	job, err := h.exportService.GetDownload(ctx, c.Param("token"))
+	if err != nil {
+		switch {
+		case errors.Is(err, serviceexport.ErrJobNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"status": "error", "message": "Export not found"})
+		case errors.Is(err, serviceexport.ErrJobNotReady):
+			c.Header("Retry-After", "10")
+			c.JSON(http.StatusConflict, gin.H{"status": "error", "message": err.Error()})
+		case errors.Is(err, serviceexport.ErrJobExpired):
+			c.JSON(http.StatusGone, gin.H{"status": "error", "message": "Export has expired; please request a new one"})
+		case errors.Is(err, serviceexport.ErrJobFailed):
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"status": "error", "message": "Export failed; please request a new one"})
+		default:
+			log.Error().Err(err).Msg("Failed to fetch export download")
+			c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "message": "Failed to fetch export"})
+		}
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, job.Filename))
+	c.Header("Cache-Control", "no-store")
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Now().Add(2 * time.Minute))
+	c.Data(http.StatusOK, export.Format(job.Format).ContentType(), job.Content)
+}
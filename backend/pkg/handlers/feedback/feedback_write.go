@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -85,6 +86,16 @@ func (h *FeedbackHandler) CreateFeedback(c *gin.Context) {
 			return
 		}
 
+		// Upload pipeline errors (unknown or someone else's image)
+		if appErr, ok := err.(*utils.AppError); ok {
+			log.Warn().Err(err).Msg("Feedback image rejected")
+			c.JSON(appErr.HTTPStatus(), ErrorResponse{
+				Status:  "error",
+				Message: appErr.Message,
+			})
+			return
+		}
+
 		// Check for context errors
 		if errors.Is(err, context.DeadlineExceeded) {
 			log.Error().Err(err).Msg("Request timeout creating feedback")
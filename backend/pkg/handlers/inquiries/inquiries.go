@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
 	serviceinquiries "github.com/eventify/backend/pkg/services/inquiries"
 
 	"github.com/gin-gonic/gin"
@@ -79,16 +80,27 @@ func (h *InquiryHandler) GetVendorInquiries(c *gin.Context) {
 		return
 	}
 
-	inquiries, err := h.Service.GetInquiriesByVendor(c.Request.Context(), vendorID)
+	limit := utils.ParsePageLimit(c.Query("limit"), models.DefaultPageLimit, models.MaxPageLimit)
+	inquiries, page, err := h.Service.GetInquiriesByVendor(c.Request.Context(), vendorID, c.Query("cursor"), limit)
 	if err != nil {
+		if appErr, ok := err.(*utils.AppError); ok {
+			c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to fetch inquiries"})
 		return
 	}
 
+	if inquiries == nil {
+		inquiries = []models.Inquiry{}
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"vendor_id": vendorID,
-		"count":     len(inquiries),
-		"inquiries": inquiries,
+		"vendor_id":   vendorID,
+		"count":       len(inquiries),
+		"total":       page.Total,
+		"next_cursor": page.NextCursor,
+		"inquiries":   inquiries,
 	})
 }
 
@@ -0,0 +1,126 @@
+// backend/pkg/handlers/media/media.go
+
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	repomedia "github.com/eventify/backend/pkg/repository/media"
+	servicemedia "github.com/eventify/backend/pkg/services/media"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// multipartOverhead leaves room for form boundaries and the purpose field
+const multipartOverhead = 1 << 20
+
+type MediaHandler struct {
+	mediaService servicemedia.MediaService
+}
+
+func NewMediaHandler(mediaService servicemedia.MediaService) *MediaHandler {
+	return &MediaHandler{mediaService: mediaService}
+}
+
+// UploadImage accepts a multipart "file" plus a "purpose" (event, vendor or
+// feedback) and returns the stored asset with its variant URLs.
+// POST /api/v1/media/images
+func (h *MediaHandler) UploadImage(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, servicemedia.MaxUploadBytes+multipartOverhead)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"message": "Image must be 10MB or smaller"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"message": "An image file is required"})
+		return
+	}
+	if fileHeader.Size > servicemedia.MaxUploadBytes {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"message": "Image must be 10MB or smaller"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Could not read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, servicemedia.MaxUploadBytes+1))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Could not read uploaded file"})
+		return
+	}
+	if len(data) > servicemedia.MaxUploadBytes {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"message": "Image must be 10MB or smaller"})
+		return
+	}
+
+	owner := models.AssetOwner{}
+	if idVal, exists := c.Get("user_id"); exists {
+		if id, ok := idVal.(uuid.UUID); ok {
+			owner.UserID = &id
+		}
+	}
+	owner.GuestID, _ = c.Cookie("guest_id")
+
+	purpose := models.AssetPurpose(strings.ToLower(strings.TrimSpace(c.PostForm("purpose"))))
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	asset, err := h.mediaService.UploadImage(ctx, owner, purpose, data)
+	if err != nil {
+		if appErr, ok := err.(*utils.AppError); ok {
+			if appErr.Category != utils.ErrCategoryValidation {
+				log.Error().Err(err).Msg("Failed to upload image")
+			}
+			c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+			return
+		}
+		log.Error().Err(err).Msg("Failed to upload image")
+		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to upload image"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, asset)
+}
+
+// GetAsset returns an asset's metadata and variant URLs
+// GET /api/v1/media/:assetId
+func (h *MediaHandler) GetAsset(c *gin.Context) {
+	assetID, err := uuid.Parse(c.Param("assetId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid asset ID"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	asset, err := h.mediaService.GetAsset(ctx, assetID)
+	if err != nil {
+		if errors.Is(err, repomedia.ErrAssetNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"message": "Asset not found"})
+			return
+		}
+		log.Error().Err(err).Str("asset_id", assetID.String()).Msg("Failed to fetch asset")
+		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to fetch asset"})
+		return
+	}
+
+	c.JSON(http.StatusOK, asset)
+}
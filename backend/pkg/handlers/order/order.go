@@ -10,6 +10,7 @@ import (
 	"strings"
 	
 	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
      serviceorder "github.com/eventify/backend/pkg/services/order"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -141,4 +142,45 @@ func (h *OrderHandler) GetOrderByReference(c *gin.Context) {
 		"status": "success",
 		"data":   order,
 	})
-}
\ No newline at end of file
+}
+// RequestEventRefund lets a ticket holder opt into a refund for a postponed event
+// (POST /api/orders/:reference/refunds)
+func (h *OrderHandler) RequestEventRefund(c *gin.Context) {
+	reference := c.Param("reference")
+
+	var req struct {
+		EventID uuid.UUID `json:"eventId" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "message": "eventId is required"})
+		return
+	}
+
+	guestID, _ := c.Cookie("guest_id")
+	var userID *uuid.UUID
+	if val, exists := c.Get("user_id"); exists {
+		if id, ok := val.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	refund, err := h.OrderService.RequestEventRefund(ctx, reference, req.EventID, userID, guestID)
+	if err != nil {
+		if appErr, ok := err.(*utils.AppError); ok {
+			c.JSON(appErr.HTTPStatus(), gin.H{"status": "error", "message": appErr.Message})
+			return
+		}
+		log.Error().Err(err).Str("ref", reference).Msg("Failed to request refund")
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "message": "Internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"status":  "success",
+		"message": "Refund requested. It will be sent back to your original payment method.",
+		"data":    refund,
+	})
+}
@@ -0,0 +1,75 @@
+// backend/pkg/handlers/recommendation/recommendation.go
+
+package recommendation
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	servicerecommendation "github.com/eventify/backend/pkg/services/recommendation"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type RecommendationHandler struct {
+	recommendationService servicerecommendation.RecommendationService
+}
+
+func NewRecommendationHandler(recommendationService servicerecommendation.RecommendationService) *RecommendationHandler {
+	return &RecommendationHandler{recommendationService: recommendationService}
+}
+
+// GetMyRecommendations returns events picked for the signed-in user
+// GET /api/v1/me/recommendations?limit=20
+func (h *RecommendationHandler) GetMyRecommendations(c *gin.Context) {
+	userID, ok := c.Get("user_id")
+	id, isUUID := userID.(uuid.UUID)
+	if !ok || !isUUID {
+		c.JSON(http.StatusUnauthorized, gin.H{"message": "Unauthorized"})
+		return
+	}
+
+	h.respond(c, models.RecommendationSubject{UserID: &id})
+}
+
+// GetGuestRecommendations returns events picked for the current browser,
+// keyed by the guest_id cookie. Signed-in callers get their own list.
+// GET /api/v1/recommendations?limit=20
+func (h *RecommendationHandler) GetGuestRecommendations(c *gin.Context) {
+	subject := models.RecommendationSubject{}
+	if idVal, exists := c.Get("user_id"); exists {
+		if id, ok := idVal.(uuid.UUID); ok {
+			subject.UserID = &id
+		}
+	}
+	if subject.UserID == nil {
+		subject.GuestID, _ = c.Cookie("guest_id")
+	}
+
+	h.respond(c, subject)
+}
+
+func (h *RecommendationHandler) respond(c *gin.Context, subject models.RecommendationSubject) {
+	limit := utils.ParsePageLimit(c.Query("limit"), 20, 50)
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.recommendationService.GetRecommendations(ctx, subject, limit)
+	if err != nil {
+		log.Error().Err(err).Str("subject", subject.Key()).Msg("Failed to fetch recommendations")
+		if appErr, ok := err.(*utils.AppError); ok {
+			c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to fetch recommendations"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"strings"
 	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
 	"github.com/eventify/backend/pkg/repository/review"
 	 servicereview "github.com/eventify/backend/pkg/services/review"
 
@@ -116,33 +117,31 @@ func (h *ReviewHandler) GetVendorReviews(c *gin.Context) {
     // 2. Determine Permission Level (REMOVED: Simplified access model)
     // The Trust Engine and repository query now handle filtering/moderation.
     
-    var reviews []models.Review
-    var err error
-
-    // 3. Service Call Logic (FIX: Call the single, trusted method)
-    // This single call retrieves the final set of reviews for display.
-    reviews, err = h.reviewService.GetReviewsByVendor(c.Request.Context(), vendorIDParam)
-    
-    // Original obsolete logic that was removed:
-    /*
-    if isAdmin {
-        reviews, err = h.reviewService.GetReviewsByVendor(c.Request.Context(), vendorIDParam)
-    } else {
-        reviews, err = h.reviewService.GetApprovedReviewsByVendor(c.Request.Context(), vendorIDParam)
-    }
-    */
+    // 3. Keyset pagination: ?cursor= from the previous page's next_cursor
+    limit := utils.ParsePageLimit(c.Query("limit"), models.DefaultPageLimit, models.MaxPageLimit)
+    reviews, page, err := h.reviewService.GetReviewsByVendor(c.Request.Context(), vendorIDParam, c.Query("cursor"), limit)
 
     if err != nil {
+        if appErr, ok := err.(*utils.AppError); ok {
+            c.JSON(appErr.HTTPStatus(), gin.H{"message": appErr.Message})
+            return
+        }
         log.Error().Err(err).Str("vendor_id", vendorIDParam).Msg("Failed to fetch reviews")
         c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to fetch reviews"})
         return
     }
 
+    if reviews == nil {
+        reviews = []models.Review{}
+    }
+
     // 4. Solid Response Structure
     c.JSON(http.StatusOK, gin.H{
-        "vendor_id": vendorIDParam,
-        "count": len(reviews),
-        "reviews": reviews,
+        "vendor_id":   vendorIDParam,
+        "count":       len(reviews),
+        "total":       page.Total,
+        "next_cursor": page.NextCursor,
+        "reviews":     reviews,
     })
 }
 
@@ -0,0 +1,47 @@
+// backend/pkg/handlers/tracking/tracking.go
+
+package tracking
+
+import (
+	"net/http"
+
+	"github.com/eventify/backend/pkg/models"
+	servicetracking "github.com/eventify/backend/pkg/services/tracking"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TrackingHandler struct {
+	trackingService servicetracking.TrackingService
+}
+
+func NewTrackingHandler(trackingService servicetracking.TrackingService) *TrackingHandler {
+	return &TrackingHandler{trackingService: trackingService}
+}
+
+// TrackEvent records a funnel step only the browser can observe, such as
+// opening the ticket selector. It answers 202 without waiting for the write.
+// POST /api/v1/track
+func (h *TrackingHandler) TrackEvent(c *gin.Context) {
+	var req models.TrackEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "eventId and type are required"})
+		return
+	}
+	if !req.Type.IsValid() || !req.Type.IsClientReported() {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Unsupported tracking event type"})
+		return
+	}
+
+	ev := models.TrackingEvent{EventID: req.EventID, Type: req.Type}
+	if idVal, exists := c.Get("user_id"); exists {
+		if id, ok := idVal.(uuid.UUID); ok {
+			ev.UserID = &id
+		}
+	}
+	ev.GuestID, _ = c.Cookie("guest_id")
+
+	h.trackingService.TrackAsync(ev)
+	c.Status(http.StatusAccepted)
+}
@@ -76,6 +76,7 @@ func AuthMiddleware(svc authService.AuthService) gin.HandlerFunc {
 		userUUID, _ := uuid.Parse(claims.UserID)
 		c.Set("user_id", userUUID)
 		c.Set("user_id_string", claims.UserID)
+		svc.RecordActivity(userUUID)
 
 		c.Next()
 	}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/eventify/backend/pkg/utils"
+	authService "github.com/eventify/backend/pkg/services/auth"
 	servicejwt "github.com/eventify/backend/pkg/services/jwt"
 
 	"github.com/gin-gonic/gin"
@@ -24,7 +25,8 @@ type AuthResult struct {
 
 // OptionalAuth checks for JWT but allows anonymous access if not found or invalid.
 // This middleware is designed to NEVER panic - it gracefully degrades to guest mode.
-func OptionalAuth(jwtService *servicejwt.JWTService) gin.HandlerFunc {
+// Signed-in callers count towards daily active users, as in AuthMiddleware.
+func OptionalAuth(jwtService *servicejwt.JWTService, svc authService.AuthService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		const service = "optional-auth"
 		const operation = "authenticate"
@@ -118,6 +120,7 @@ func OptionalAuth(jwtService *servicejwt.JWTService) gin.HandlerFunc {
 		c.Set("user_id_string", claims.UserID) // As string for convenience
 		c.Set("authenticated", true)         // Flag for handlers
 		c.Set("token_type", claims.TokenType) // Store token type
+		svc.RecordActivity(userUUID)
 
 		result.Success = true
 		result.Reason = "authenticated"
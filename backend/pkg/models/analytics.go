@@ -2,7 +2,11 @@
 
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // ============================================================================
 // MAIN RESPONSE STRUCTURE
@@ -20,6 +24,7 @@ type AnalyticsResponse struct {
 	Orders     OrdersData     `json:"orders"`
 	Customers  CustomersData  `json:"customers"`
 	Payments   PaymentsData   `json:"payments"`
+	Funnel     FunnelData     `json:"funnel"`
 	Timeline   []TimelineData `json:"timeline,omitempty"` // Optional
 }
 
@@ -35,7 +40,7 @@ type OverviewData struct {
 	TicketsSold     int     `json:"ticketsSold"`
 	TotalOrders     int     `json:"totalOrders"`
 	SellThroughRate float64 `json:"sellThroughRate"` // percentage (0-100)
-	ConversionRate  float64 `json:"conversionRate"`  // percentage (0-100)
+	ConversionRate  float64 `json:"conversionRate"`  // viewers who bought, percentage (0-100)
 	DaysUntilEvent  int     `json:"daysUntilEvent"`  // negative if past
 }
 
@@ -142,6 +147,27 @@ type PaymentChannelData struct {
 	SuccessRate    float64 `json:"successRate"`    // (successful/total) * 100
 }
 
+// ============================================================================
+// FUNNEL DATA (View → Checkout → Purchase)
+// ============================================================================
+
+// FunnelData walks visitors from the event page to a completed purchase
+// Frontend usage: analytics.funnel
+type FunnelData struct {
+	Stages         []FunnelStage `json:"stages"`
+	ConversionRate float64       `json:"conversionRate"` // purchasers / viewers * 100
+}
+
+// FunnelStage is one step of the funnel. Visitors are distinct users or
+// guests per day, summed across days.
+type FunnelStage struct {
+	Stage            string  `json:"stage"`            // "view", "ticket_selector_open", "checkout_start", "purchase"
+	Count            int     `json:"count"`            // every occurrence
+	Visitors         int     `json:"visitors"`         // distinct people
+	RateFromPrevious float64 `json:"rateFromPrevious"` // visitors vs the stage before, percentage
+	RateFromStart    float64 `json:"rateFromStart"`    // visitors vs viewers, percentage
+}
+
 // ============================================================================
 // TIMELINE DATA (Sales Over Time - Optional)
 // ============================================================================
@@ -156,6 +182,42 @@ type TimelineData struct {
 	CumulativeSold int    `json:"cumulativeSold"` // Running total of tickets sold
 }
 
+// SalesTimeline is the bucketed sales series for sell-through charts
+// Frontend usage: GET /api/events/:eventId/analytics/timeline
+type SalesTimeline struct {
+	EventID        string           `json:"eventId"`
+	GroupBy        string           `json:"groupBy"`  // "hour", "day" or "week"
+	Timezone       string           `json:"timezone"` // IANA name buckets are aligned to
+	From           *time.Time       `json:"from,omitempty"`
+	To             time.Time        `json:"to"`
+	TotalInventory int              `json:"totalInventory"`
+	Tiers          []string         `json:"tiers"`
+	Buckets        []TimelineBucket `json:"buckets"`
+}
+
+// TimelineBucket is one hour, day or week; empty periods are zero-filled
+type TimelineBucket struct {
+	Start             time.Time           `json:"start"`
+	Label             string              `json:"label"`
+	TicketsSold       int                 `json:"ticketsSold"`
+	Revenue           int                 `json:"revenue"` // in kobo
+	OrderCount        int                 `json:"orderCount"`
+	CumulativeSold    int                 `json:"cumulativeSold"`
+	CumulativeRevenue int                 `json:"cumulativeRevenue"`
+	SellThroughRate   float64             `json:"sellThroughRate"` // cumulative, percentage (0-100)
+	Tiers             []TierTimelinePoint `json:"tiers"`
+}
+
+// TierTimelinePoint is a single tier's share of a bucket
+type TierTimelinePoint struct {
+	TierName          string  `json:"tierName"`
+	TicketsSold       int     `json:"ticketsSold"`
+	Revenue           int     `json:"revenue"`
+	CumulativeSold    int     `json:"cumulativeSold"`
+	CumulativeRevenue int     `json:"cumulativeRevenue"`
+	SellThroughRate   float64 `json:"sellThroughRate"`
+}
+
 // ============================================================================
 // INTERNAL DATA TRANSFER OBJECTS (Repository → Service)
 // These are NOT returned to the client, only used internally
@@ -174,9 +236,10 @@ type EventBasicInfo struct {
 
 // TierInfo contains ticket tier details from event
 type TierInfo struct {
-	TierName string
-	Price    float64 // Price in Naira (will convert to kobo)
-	Quantity int
+	TierID   uuid.UUID `db:"tier_id"`
+	TierName string    `db:"tier_name"`
+	Price    float64   `db:"price"` // Price in Naira (will convert to kobo)
+	Quantity int       `db:"quantity"`
 }
 
 // OrderMetricsRaw contains raw order counts by status
@@ -202,17 +265,70 @@ type RevenueMetricsRaw struct {
 
 // CustomerMetricsRaw contains raw customer data
 type CustomerMetricsRaw struct {
-	UniqueEmails []string // Will count these for unique customers
-	TotalOrders  int
+	UniqueCustomers int // Distinct buyer emails
+	RepeatCustomers int // Buyers with more than one successful order
+}
+
+// EventFactsRaw is an event's totals summed from the analytics read model
+type EventFactsRaw struct {
+	OrdersTotal     int        `db:"orders_total"`
+	OrdersSuccess   int        `db:"orders_success"`
+	OrdersPending   int        `db:"orders_pending"`
+	OrdersFailed    int        `db:"orders_failed"`
+	OrdersFraud     int        `db:"orders_fraud"`
+	OrdersRefunded  int        `db:"orders_refunded"`
+	TicketsSold     int        `db:"tickets_sold"`
+	Gross           int        `db:"gross_kobo"`
+	Subtotal        int        `db:"subtotal_kobo"`
+	ServiceFees     int        `db:"service_fee_kobo"`
+	VAT             int        `db:"vat_kobo"`
+	Refunded        int        `db:"refunded_kobo"`
+	UniqueCustomers int        `db:"unique_customers"`
+	RepeatCustomers int        `db:"repeat_customers"`
+	RefreshedAt     *time.Time `db:"refreshed_at"` // nil until the event's facts are first built
+}
+
+// TierFactsRaw is a tier's successful sales from the analytics read model
+type TierFactsRaw struct {
+	TierID      uuid.UUID `db:"ticket_tier_id"`
+	TicketsSold int       `db:"tickets_sold"`
+	Revenue     int       `db:"revenue_kobo"`
+	OrderCount  int       `db:"orders"`
+}
+
+// FunnelCountsRaw is one funnel step's totals from the daily rollup
+type FunnelCountsRaw struct {
+	Count    int
+	Visitors int
+}
+
+// TimelineQuery selects the sales rows for a timeline. From and To are
+// optional; To is exclusive.
+type TimelineQuery struct {
+	GroupBy  string // "hour", "day" or "week"
+	Timezone string // IANA name, passed to Postgres for bucketing
+	From     *time.Time
+	To       *time.Time
+}
+
+// SalesTimelineRaw is one bucket's sales for a tier, or for the whole event
+// when IsTotal is set (orders spanning tiers are counted once there)
+type SalesTimelineRaw struct {
+	Bucket      time.Time
+	TierName    string
+	IsTotal     bool
+	TicketsSold int
+	Revenue     int
+	OrderCount  int
 }
 
 // PaymentChannelRaw contains raw payment channel data
 type PaymentChannelRaw struct {
-	Channel      string
-	OrderCount   int
-	Revenue      int
-	SuccessCount int
-	FailCount    int
+	Channel      string `db:"channel"`
+	OrderCount   int    `db:"orders"`
+	Revenue      int    `db:"revenue_kobo"`
+	SuccessCount int    `db:"success_count"`
+	FailCount    int    `db:"fail_count"`
 }
 
 // ============================================================================
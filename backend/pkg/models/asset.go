@@ -0,0 +1,112 @@
+// backend/pkg/models/asset.go
+
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetPurpose says what an uploaded image is for; it decides who may
+// upload it and which records may reference it
+type AssetPurpose string
+
+const (
+	AssetPurposeEvent    AssetPurpose = "event"
+	AssetPurposeVendor   AssetPurpose = "vendor"
+	AssetPurposeFeedback AssetPurpose = "feedback"
+)
+
+func (p AssetPurpose) IsValid() bool {
+	switch p {
+	case AssetPurposeEvent, AssetPurposeVendor, AssetPurposeFeedback:
+		return true
+	}
+	return false
+}
+
+// Image variant names
+const (
+	VariantThumbnail = "thumbnail"
+	VariantCard      = "card"
+	VariantHero      = "hero"
+)
+
+// AssetVariant is one resized rendition of an upload
+type AssetVariant struct {
+	Key         string `json:"key"`
+	URL         string `json:"url"`
+	ContentType string `json:"contentType"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	SizeBytes   int64  `json:"sizeBytes"`
+}
+
+// AssetVariants is stored as JSONB keyed by variant name
+type AssetVariants map[string]AssetVariant
+
+func (v AssetVariants) Value() (driver.Value, error) {
+	if v == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(v)
+}
+
+func (v *AssetVariants) Scan(src interface{}) error {
+	var data []byte
+	switch s := src.(type) {
+	case nil:
+		*v = AssetVariants{}
+		return nil
+	case []byte:
+		data = s
+	case string:
+		data = []byte(s)
+	default:
+		return errors.New("asset variants: unsupported scan type")
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Asset is an image uploaded through the media pipeline. Only the processed
+// variants are kept; the original upload (and its EXIF data) is discarded.
+type Asset struct {
+	ID          uuid.UUID           `json:"id" db:"id"`
+	OwnerID     sql.Null[uuid.UUID] `json:"-" db:"owner_id"`
+	GuestID     sql.NullString      `json:"-" db:"guest_id"`
+	Purpose     AssetPurpose        `json:"purpose" db:"purpose"`
+	ContentType string              `json:"contentType" db:"content_type"`
+	Width       int                 `json:"width" db:"width"`
+	Height      int                 `json:"height" db:"height"`
+	SizeBytes   int64               `json:"sizeBytes" db:"size_bytes"`
+	Variants    AssetVariants       `json:"variants" db:"variants"`
+	CreatedAt   time.Time           `json:"createdAt" db:"created_at"`
+}
+
+// URL returns the address of a variant, or "" if it wasn't produced
+func (a *Asset) URL(variant string) string {
+	if v, ok := a.Variants[variant]; ok {
+		return v.URL
+	}
+	return ""
+}
+
+// AssetOwner identifies who is uploading or referencing an asset. Guests
+// (feedback forms) are identified by their guest_id cookie.
+type AssetOwner struct {
+	UserID  *uuid.UUID
+	GuestID string
+}
+
+// Owns reports whether the asset was uploaded by this caller
+func (o AssetOwner) Owns(a *Asset) bool {
+	if a.OwnerID.Valid {
+		return o.UserID != nil && *o.UserID == a.OwnerID.V
+	}
+	return a.GuestID.Valid && o.GuestID != "" && a.GuestID.String == o.GuestID
+}
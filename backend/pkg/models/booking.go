@@ -0,0 +1,181 @@
+// backend/pkg/models/booking.go
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// VendorPackage is a priced service bundle shown on a vendor's profile.
+// PriceMaxKobo is nil for "from" pricing.
+type VendorPackage struct {
+	ID           uuid.UUID      `json:"id" db:"id"`
+	VendorID     uuid.UUID      `json:"vendorId" db:"vendor_id"`
+	Name         string         `json:"name" db:"name"`
+	Description  string         `json:"description" db:"description"`
+	PriceMinKobo int64          `json:"priceMinKobo" db:"price_min_kobo"`
+	PriceMaxKobo *int64         `json:"priceMaxKobo,omitempty" db:"price_max_kobo"`
+	Inclusions   pq.StringArray `json:"inclusions" db:"inclusions"`
+	CreatedAt    time.Time      `json:"createdAt" db:"created_at"`
+	UpdatedAt    time.Time      `json:"updatedAt" db:"updated_at"`
+}
+
+// VendorPackageInput is the body for creating or replacing a package
+type VendorPackageInput struct {
+	Name         string   `json:"name" binding:"required"`
+	Description  string   `json:"description"`
+	PriceMinKobo int64    `json:"priceMinKobo"`
+	PriceMaxKobo *int64   `json:"priceMaxKobo"`
+	Inclusions   []string `json:"inclusions"`
+}
+
+type QuoteStatus string
+
+const (
+	QuoteSent      QuoteStatus = "sent"
+	QuoteAccepted  QuoteStatus = "accepted"
+	QuoteDeclined  QuoteStatus = "declined"
+	QuoteWithdrawn QuoteStatus = "withdrawn"
+	// QuoteExpired is never stored; a sent quote reads as expired once
+	// valid_until has passed
+	QuoteExpired QuoteStatus = "expired"
+)
+
+// Quote is a vendor's priced offer in reply to an inquiry. Sending a new
+// quote for the same inquiry withdraws the previous open one.
+type Quote struct {
+	ID           uuid.UUID       `json:"id" db:"id"`
+	InquiryID    uuid.UUID       `json:"inquiryId" db:"inquiry_id"`
+	VendorID     uuid.UUID       `json:"vendorId" db:"vendor_id"`
+	PackageID    *uuid.UUID      `json:"packageId,omitempty" db:"package_id"`
+	Status       QuoteStatus     `json:"status" db:"status"`
+	EventDate    string          `json:"eventDate" db:"event_date"`
+	SubtotalKobo int64           `json:"subtotalKobo" db:"subtotal_kobo"`
+	DepositKobo  int64           `json:"depositKobo" db:"deposit_kobo"`
+	Notes        string          `json:"notes" db:"notes"`
+	ValidUntil   time.Time       `json:"validUntil" db:"valid_until"`
+	CreatedAt    time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt    time.Time       `json:"updatedAt" db:"updated_at"`
+	RespondedAt  *time.Time      `json:"respondedAt,omitempty" db:"responded_at"`
+	LineItems    []QuoteLineItem `json:"lineItems" db:"-"`
+}
+
+type QuoteLineItem struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	QuoteID       uuid.UUID `json:"-" db:"quote_id"`
+	Position      int       `json:"position" db:"position"`
+	Description   string    `json:"description" db:"description"`
+	Quantity      int       `json:"quantity" db:"quantity"`
+	UnitPriceKobo int64     `json:"unitPriceKobo" db:"unit_price_kobo"`
+	TotalKobo     int64     `json:"totalKobo" db:"total_kobo"`
+}
+
+// QuoteInput is the body a vendor sends to quote an inquiry
+type QuoteInput struct {
+	InquiryID   uuid.UUID            `json:"inquiryId" binding:"required"`
+	PackageID   *uuid.UUID           `json:"packageId"`
+	EventDate   string               `json:"eventDate" binding:"required"`
+	DepositKobo int64                `json:"depositKobo"`
+	ValidDays   int                  `json:"validDays"`
+	Notes       string               `json:"notes"`
+	LineItems   []QuoteLineItemInput `json:"lineItems" binding:"required"`
+}
+
+type QuoteLineItemInput struct {
+	Description   string `json:"description"`
+	Quantity      int    `json:"quantity"`
+	UnitPriceKobo int64  `json:"unitPriceKobo"`
+}
+
+// QuoteClient is who may accept or decline a quote: the inquiry's user if
+// they were signed in, otherwise the browser that sent it
+type QuoteClient struct {
+	UserID  *uuid.UUID
+	GuestID string
+}
+
+type DepositStatus string
+
+const (
+	DepositNotRequired DepositStatus = "not_required"
+	DepositPending     DepositStatus = "pending"
+	DepositPaid        DepositStatus = "paid"
+	DepositRefunded    DepositStatus = "refunded"
+)
+
+type BookingStatus string
+
+const (
+	BookingConfirmed BookingStatus = "confirmed"
+	BookingCancelled BookingStatus = "cancelled"
+	BookingCompleted BookingStatus = "completed"
+)
+
+// Booking is created when a client accepts a quote. It copies what it needs
+// from the quote and inquiry so it outlives either being deleted.
+type Booking struct {
+	ID            uuid.UUID     `json:"id" db:"id"`
+	QuoteID       *uuid.UUID    `json:"quoteId,omitempty" db:"quote_id"`
+	InquiryID     *uuid.UUID    `json:"inquiryId,omitempty" db:"inquiry_id"`
+	VendorID      uuid.UUID     `json:"vendorId" db:"vendor_id"`
+	UserID        *uuid.UUID    `json:"userId,omitempty" db:"user_id"`
+	GuestID       string        `json:"-" db:"guest_id"`
+	ClientName    string        `json:"clientName" db:"client_name"`
+	ClientEmail   string        `json:"clientEmail" db:"client_email"`
+	EventDate     string        `json:"eventDate" db:"event_date"`
+	TotalKobo     int64         `json:"totalKobo" db:"total_kobo"`
+	DepositKobo   int64         `json:"depositKobo" db:"deposit_kobo"`
+	DepositStatus DepositStatus `json:"depositStatus" db:"deposit_status"`
+	DepositPaidAt *time.Time    `json:"depositPaidAt,omitempty" db:"deposit_paid_at"`
+	Status        BookingStatus `json:"status" db:"status"`
+	CreatedAt     time.Time     `json:"createdAt" db:"created_at"`
+	UpdatedAt     time.Time     `json:"updatedAt" db:"updated_at"`
+	// Clash marks a booking that shares its day with another live booking,
+	// left over from before vendors were limited to one event per day.
+	// Only set when listing bookings.
+	Clash bool `json:"clash,omitempty" db:"clash"`
+}
+
+// BookingUpdate is the body a vendor sends to move a booking along; either
+// field may be left out
+type BookingUpdate struct {
+	Status        BookingStatus `json:"status"`
+	DepositStatus DepositStatus `json:"depositStatus"`
+}
+
+type AvailabilityStatus string
+
+const (
+	AvailabilityBooked  AvailabilityStatus = "booked"
+	AvailabilityBlocked AvailabilityStatus = "blocked"
+)
+
+// UnavailableDay is a date the vendor can't take a new booking. Reason and
+// BookingID are only shown to the vendor's owner.
+type UnavailableDay struct {
+	Date      string             `json:"date" db:"date"`
+	Status    AvailabilityStatus `json:"status" db:"status"`
+	Reason    string             `json:"reason,omitempty" db:"reason"`
+	BookingID *uuid.UUID         `json:"bookingId,omitempty" db:"booking_id"`
+}
+
+// VendorAvailability lists the unavailable days between From and To,
+// inclusive; every other day in the range is free
+type VendorAvailability struct {
+	VendorID    uuid.UUID        `json:"vendorId"`
+	From        string           `json:"from"`
+	To          string           `json:"to"`
+	Timezone    string           `json:"timezone"`
+	Unavailable []UnavailableDay `json:"unavailable"`
+}
+
+// DateBlockInput blocks (or unblocks) every day from From to To inclusive.
+// To defaults to From.
+type DateBlockInput struct {
+	From   string `json:"from" form:"from" binding:"required"`
+	To     string `json:"to" form:"to"`
+	Reason string `json:"reason"`
+}
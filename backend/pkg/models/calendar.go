@@ -0,0 +1,50 @@
+// backend/pkg/models/calendar.go
+
+package models
+
+import (
+	"strings"
+
+	"github.com/eventify/backend/pkg/utils"
+)
+
+// CalendarEvent maps an event onto the fields an .ics VEVENT needs.
+// Virtual events use the meeting link as their location.
+func (e *Event) CalendarEvent() utils.CalendarEvent {
+	ev := utils.CalendarEvent{
+		UID:          e.ID.String() + "@eventify",
+		Title:        e.EventTitle,
+		Description:  e.EventDescription,
+		Start:        e.StartDate,
+		End:          e.EndDate,
+		LastModified: e.UpdatedAt,
+		Cancelled:    e.CancelledAt != nil,
+	}
+
+	if e.EventType == TypeVirtual {
+		if e.MeetingLink != nil && *e.MeetingLink != "" {
+			ev.Location = *e.MeetingLink
+			ev.URL = *e.MeetingLink
+			ev.Description += "\n\nJoin: " + *e.MeetingLink
+		} else if e.VirtualPlatform != nil {
+			ev.Location = *e.VirtualPlatform
+		}
+		return ev
+	}
+
+	var parts []string
+	for _, p := range []*string{e.VenueName, e.VenueAddress, e.City, e.State, e.Country} {
+		if p != nil && strings.TrimSpace(*p) != "" {
+			parts = append(parts, strings.TrimSpace(*p))
+		}
+	}
+	ev.Location = strings.Join(parts, ", ")
+	return ev
+}
+
+// CalendarLinks are the add-to-calendar options for one event
+type CalendarLinks struct {
+	ICS     string `json:"ics"`
+	Google  string `json:"google"`
+	Outlook string `json:"outlook"`
+}
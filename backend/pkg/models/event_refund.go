@@ -0,0 +1,84 @@
+// backend/pkg/models/event_refund.go
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefundReason records why an order refund was created
+type RefundReason string
+
+const (
+	RefundReasonEventCancelled RefundReason = "event_cancelled" // Whole event cancelled by the organizer
+	RefundReasonEventPostponed RefundReason = "event_postponed" // Holder opted out of new dates
+)
+
+// RefundStatus tracks an order refund through the Paystack refund worker
+type RefundStatus string
+
+const (
+	RefundStatusPending    RefundStatus = "pending"
+	RefundStatusProcessing RefundStatus = "processing"
+	RefundStatusSubmitted  RefundStatus = "submitted" // Accepted by Paystack, awaiting its outcome
+	RefundStatusRefunded   RefundStatus = "refunded"  // Paystack paid it out (refund.processed)
+	RefundStatusFailed     RefundStatus = "failed"    // Gave up after max attempts, or Paystack failed it
+)
+
+// DefaultRefundWindowDays is how long holders of a postponed event may opt into a refund
+const DefaultRefundWindowDays = 14
+
+// OrderRefund is one refund of the part of an order that belongs to an event
+type OrderRefund struct {
+	ID               uuid.UUID    `json:"id" db:"id"`
+	BatchID          *uuid.UUID   `json:"batchId,omitempty" db:"batch_id"`
+	OrderID          uuid.UUID    `json:"orderId" db:"order_id"`
+	EventID          uuid.UUID    `json:"eventId" db:"event_id"`
+	OrderReference   string       `json:"orderReference" db:"order_reference"`
+	AmountKobo       int64        `json:"amountKobo" db:"amount_kobo"`
+	Reason           RefundReason `json:"reason" db:"reason"`
+	Status           RefundStatus `json:"status" db:"status"`
+	PaystackRefundID *string      `json:"paystackRefundId,omitempty" db:"paystack_refund_id"`
+	Attempts         int          `json:"attempts" db:"attempts"`
+	LastError        *string      `json:"lastError,omitempty" db:"last_error"`
+	CreatedAt        time.Time    `json:"createdAt" db:"created_at"`
+	UpdatedAt        time.Time    `json:"updatedAt" db:"updated_at"`
+}
+
+// RefundBatch groups the refunds created by one cancellation
+type RefundBatch struct {
+	ID          uuid.UUID    `json:"id" db:"id"`
+	EventID     uuid.UUID    `json:"eventId" db:"event_id"`
+	Reason      RefundReason `json:"reason" db:"reason"`
+	Status      string       `json:"status" db:"status"` // running | completed
+	CreatedAt   time.Time    `json:"createdAt" db:"created_at"`
+	CompletedAt *time.Time   `json:"completedAt,omitempty" db:"completed_at"`
+}
+
+// RefundProgress summarizes every refund for an event
+type RefundProgress struct {
+	EventID      uuid.UUID    `json:"eventId" db:"event_id"`
+	Total        int          `json:"total" db:"total"`
+	Pending      int          `json:"pending" db:"pending"`
+	Submitted    int          `json:"submitted" db:"submitted"`
+	Refunded     int          `json:"refunded" db:"refunded"`
+	Failed       int          `json:"failed" db:"failed"`
+	TotalKobo    int64        `json:"totalKobo" db:"total_kobo"`
+	RefundedKobo int64        `json:"refundedKobo" db:"refunded_kobo"`
+	Batch        *RefundBatch `json:"batch,omitempty" db:"-"`
+}
+
+// CancelEventRequest is sent by the organizer to cancel an event with sales
+type CancelEventRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// PostponeEventRequest moves an event to new dates
+type PostponeEventRequest struct {
+	StartDate        time.Time `json:"startDate" binding:"required"`
+	EndDate          time.Time `json:"endDate" binding:"required"`
+	Reason           string    `json:"reason" binding:"max=500"`
+	RefundWindowDays int       `json:"refundWindowDays" binding:"omitempty,min=1,max=60"`
+}
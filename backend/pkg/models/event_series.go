@@ -0,0 +1,59 @@
+// backend/pkg/models/event_series.go
+
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventSeries is a recurring event. Each occurrence is a real row in events
+// (with its own ticket stock) linked back through SeriesID.
+type EventSeries struct {
+	ID                uuid.UUID       `json:"id" db:"id"`
+	OrganizerID       uuid.UUID       `json:"organizerId" db:"organizer_id"`
+	RRule             string          `json:"rrule" db:"rrule"`
+	DTStart           time.Time       `json:"dtstart" db:"dtstart"`
+	DurationSeconds   int64           `json:"durationSeconds" db:"duration_seconds"`
+	OffsetSeconds     int64           `json:"offsetSeconds" db:"offset_seconds"` // Shift applied by "edit all future" date changes
+	Template          json.RawMessage `json:"-" db:"template"`
+	MaterializedUntil time.Time       `json:"materializedUntil" db:"materialized_until"`
+	CreatedAt         time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt         time.Time       `json:"updatedAt" db:"updated_at"`
+
+	Occurrences []SeriesOccurrence `json:"occurrences,omitempty" db:"-"`
+}
+
+// SeriesTemplate is what new occurrences are copied from.
+// Tier prices are kept in kobo because TicketTier hides PriceKobo from JSON.
+type SeriesTemplate struct {
+	Event Event                `json:"event"`
+	Tiers []SeriesTierTemplate `json:"tiers"`
+}
+
+type SeriesTierTemplate struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	PriceKobo   int64   `json:"priceKobo"`
+	Capacity    int32   `json:"capacity"`
+}
+
+// SeriesOccurrence is the lightweight listing of one materialized occurrence
+type SeriesOccurrence struct {
+	EventID      uuid.UUID  `json:"eventId" db:"id"`
+	RecurrenceID time.Time  `json:"recurrenceId" db:"recurrence_id"`
+	StartDate    time.Time  `json:"startDate" db:"start_date"`
+	EndDate      time.Time  `json:"endDate" db:"end_date"`
+	CancelledAt  *time.Time `json:"cancelledAt,omitempty" db:"cancelled_at"`
+	TicketsSold  int32      `json:"ticketsSold" db:"tickets_sold"`
+}
+
+// UpdateScope selects which occurrences of a series an edit applies to
+type UpdateScope string
+
+const (
+	UpdateScopeThis   UpdateScope = "this"
+	UpdateScopeFuture UpdateScope = "future"
+)
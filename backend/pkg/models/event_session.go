@@ -0,0 +1,74 @@
+// backend/pkg/models/event_session.go
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// EventSession is a scheduled slot inside a multi-day event or conference
+type EventSession struct {
+	ID          uuid.UUID      `json:"id" db:"id"`
+	EventID     uuid.UUID      `json:"eventId" db:"event_id"`
+	Title       string         `json:"title" db:"title"`
+	Description *string        `json:"description" db:"description"`
+	Track       *string        `json:"track" db:"track"`
+	Room        *string        `json:"room" db:"room"`
+	Speakers    pq.StringArray `json:"speakers" db:"speakers"`
+	StartTime   time.Time      `json:"startTime" db:"start_time"`
+	EndTime     time.Time      `json:"endTime" db:"end_time"`
+	SessionDay  time.Time      `json:"sessionDay" db:"session_day"` // Local calendar day, used by day passes
+	CreatedAt   time.Time      `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time      `json:"updatedAt" db:"updated_at"`
+}
+
+// EventSessionInput is the create/update payload for a session
+type EventSessionInput struct {
+	Title       string    `json:"title" binding:"required"`
+	Description *string   `json:"description"`
+	Track       *string   `json:"track"`
+	Room        *string   `json:"room"`
+	Speakers    []string  `json:"speakers"`
+	StartTime   time.Time `json:"startTime" binding:"required"`
+	EndTime     time.Time `json:"endTime" binding:"required"`
+}
+
+// TierAccessInput replaces the access rules of a ticket tier.
+// A tier with no rules is a full pass to every session.
+type TierAccessInput struct {
+	SessionIDs []uuid.UUID `json:"sessionIds"`
+	Days       []string    `json:"days"` // YYYY-MM-DD
+}
+
+// TierAccessRule grants a tier entry to one session or to every session on a day
+type TierAccessRule struct {
+	TierID     uuid.UUID  `json:"tierId" db:"tier_id"`
+	SessionID  *uuid.UUID `json:"sessionId,omitempty" db:"session_id"`
+	AccessDate *time.Time `json:"day,omitempty" db:"access_date"`
+}
+
+// TierDayAccess is what a tier allows at gates that scan without naming a
+// session, along with its event's dates for full passes
+type TierDayAccess struct {
+	TierID     uuid.UUID
+	Restricted bool        // the tier has access rules; otherwise it's a full pass
+	Days       []time.Time // whole days a restricted tier holds
+	EventStart time.Time
+	EventEnd   time.Time
+}
+
+// Grants reports whether the tier may enter on a local calendar day
+func (a TierDayAccess) Grants(day time.Time) bool {
+	if !a.Restricted {
+		return true
+	}
+	for _, d := range a.Days {
+		if d.Equal(day) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+// backend/pkg/models/event_status.go
+
+package models
+
+import "time"
+
+// EventStatus is where an event is in its publishing lifecycle.
+// Cancellation is tracked separately (CancelledAt) and applies to any state.
+type EventStatus string
+
+const (
+	EventStatusDraft     EventStatus = "draft"     // Only the organizer can see it
+	EventStatusScheduled EventStatus = "scheduled" // Goes live at PublishAt
+	EventStatusPublished EventStatus = "published" // Listed, searchable and on sale
+	EventStatusUnlisted  EventStatus = "unlisted"  // On sale, but only reachable with the share token
+	EventStatusArchived  EventStatus = "archived"  // Off sale and hidden; kept for records
+)
+
+// eventStatusTransitions lists the states each state may move to.
+// Leaving the public states for draft is further limited to events with no sales.
+var eventStatusTransitions = map[EventStatus][]EventStatus{
+	EventStatusDraft:     {EventStatusScheduled, EventStatusPublished, EventStatusUnlisted, EventStatusArchived},
+	EventStatusScheduled: {EventStatusDraft, EventStatusScheduled, EventStatusPublished, EventStatusUnlisted, EventStatusArchived},
+	EventStatusPublished: {EventStatusDraft, EventStatusUnlisted, EventStatusArchived},
+	EventStatusUnlisted:  {EventStatusDraft, EventStatusPublished, EventStatusArchived},
+	EventStatusArchived:  {EventStatusPublished, EventStatusUnlisted},
+}
+
+func (s EventStatus) IsValid() bool {
+	_, ok := eventStatusTransitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether an organizer may move an event from s to next
+func (s EventStatus) CanTransitionTo(next EventStatus) bool {
+	for _, allowed := range eventStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsListed reports whether events in this state appear in public listings and search
+func (s EventStatus) IsListed() bool {
+	return s == EventStatusPublished
+}
+
+// IsOnSale reports whether tickets can be bought in this state
+func (s EventStatus) IsOnSale() bool {
+	return s == EventStatusPublished || s == EventStatusUnlisted
+}
+
+// EventStatusRequest is the payload for PATCH /api/events/:eventId/status
+type EventStatusRequest struct {
+	Status    EventStatus `json:"status" binding:"required,oneof=draft scheduled published unlisted archived"`
+	PublishAt *time.Time  `json:"publishAt"`
+}
+
+// EventStatusResponse echoes the new state. ShareToken is only set for
+// unlisted events; append it to the event link as ?token=.
+type EventStatusResponse struct {
+	EventID    string      `json:"eventId"`
+	Status     EventStatus `json:"status"`
+	PublishAt  *time.Time  `json:"publishAt,omitempty"`
+	ShareToken string      `json:"shareToken,omitempty"`
+}
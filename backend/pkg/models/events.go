@@ -25,6 +25,7 @@ type Event struct {
 	Category               string         `json:"category" db:"category" binding:"required"`
 	EventType              EventType      `json:"eventType" db:"event_type" binding:"required,oneof=physical virtual"`
 	EventImageURL          string         `json:"eventImage" db:"event_image_url" binding:"required"`
+	ImageAssetID           *uuid.UUID     `json:"imageAssetId,omitempty" db:"image_asset_id"`
 	VenueName              *string        `json:"venueName" db:"venue_name"`
 	VenueAddress           *string        `json:"venueAddress" db:"venue_address"`
 	City                   *string        `json:"city" db:"city"`
@@ -32,22 +33,36 @@ type Event struct {
 	Country                *string        `json:"country" db:"country"`
 	VirtualPlatform        *string        `json:"virtualPlatform" db:"virtual_platform"`
 	MeetingLink            *string        `json:"meetingLink" db:"meeting_link"`
+	Latitude               *float64       `json:"latitude,omitempty" db:"latitude"`
+	Longitude              *float64       `json:"longitude,omitempty" db:"longitude"`
 	StartDate              time.Time      `json:"startDate" db:"start_date" binding:"required"`
 	EndDate                time.Time      `json:"endDate" db:"end_date" binding:"required"`
 	MaxAttendees           *int32         `json:"maxAttendees" db:"max_attendees"`
 	PaystackSubaccountCode *string        `json:"paystackSubaccountCode" db:"paystack_subaccount_code"`
 	Tags                   []string       `json:"tags" db:"tags"`
+	ReentryPolicy          ReentryPolicy  `json:"reentryPolicy" db:"reentry_policy"`
+	SeriesID               *uuid.UUID     `json:"seriesId,omitempty" db:"series_id"`
+	RecurrenceID           *time.Time     `json:"recurrenceId,omitempty" db:"recurrence_id"`
+	CancelledAt            *time.Time     `json:"cancelledAt,omitempty" db:"cancelled_at"`
+	CancellationReason     *string        `json:"cancellationReason,omitempty" db:"cancellation_reason"`
+	PostponedAt            *time.Time     `json:"postponedAt,omitempty" db:"postponed_at"`
+	RefundDeadline         *time.Time     `json:"refundDeadline,omitempty" db:"refund_deadline"`
+	Status                 EventStatus    `json:"status" db:"status"`
+	PublishAt              *time.Time     `json:"publishAt,omitempty" db:"publish_at"`
+	AccessToken            *string        `json:"accessToken,omitempty" db:"access_token"` // Share token for unlisted events; stripped from public responses
 	IsDeleted              bool           `json:"isDeleted" db:"is_deleted"`
 	DeletedAt              *time.Time     `json:"deletedAt" db:"deleted_at"`
 	CreatedAt              time.Time      `json:"createdAt" db:"created_at"`
 	UpdatedAt              time.Time      `json:"updatedAt" db:"updated_at"`
 	
 	// Relationships
-	TicketTiers []TicketTier `json:"tickets" db:"-"`
+	TicketTiers []TicketTier   `json:"tickets" db:"-"`
+	Sessions    []EventSession `json:"sessions,omitempty" db:"-"`
 	
 	// Computed fields for UI (not in DB)
-	LikesCount int  `json:"likesCount" db:"-"`
-	IsLiked    bool `json:"isLiked" db:"-"`
+	LikesCount int      `json:"likesCount" db:"-"`
+	IsLiked    bool     `json:"isLiked" db:"-"`
+	DistanceKm *float64 `json:"distanceKm,omitempty" db:"-"` // Set when searching near a point
 }
 
 type TicketTier struct {
@@ -63,6 +78,11 @@ type TicketTier struct {
 	Capacity    int32      `json:"quantity" db:"capacity" binding:"required"`
 	Sold        int32      `json:"soldCount" db:"sold"`
 	Available   int32      `json:"available" db:"available"`
+
+	// Session access (empty = full pass to every session)
+	SessionIDs  []uuid.UUID `json:"sessionIds,omitempty" db:"-"`
+	AccessDays  []string    `json:"accessDays,omitempty" db:"-"`
+
 	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
 	UpdatedAt   time.Time  `json:"updatedAt" db:"updated_at"`
 }
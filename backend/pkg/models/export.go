@@ -0,0 +1,100 @@
+// backend/pkg/models/export.go
+
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportKind is what an organizer is downloading for an event
+type ExportKind string
+
+const (
+	ExportKindAttendees ExportKind = "attendees"
+	ExportKindOrders    ExportKind = "orders"
+	ExportKindSummary   ExportKind = "summary"
+)
+
+func (k ExportKind) IsValid() bool {
+	switch k {
+	case ExportKindAttendees, ExportKindOrders, ExportKindSummary:
+		return true
+	}
+	return false
+}
+
+type ExportJobStatus string
+
+const (
+	ExportJobPending ExportJobStatus = "pending"
+	ExportJobRunning ExportJobStatus = "running"
+	ExportJobDone    ExportJobStatus = "done"
+	ExportJobFailed  ExportJobStatus = "failed"
+)
+
+// ExportJob is a large export built in the background and fetched later by
+// download token. The file itself lives in Content until the job expires.
+type ExportJob struct {
+	ID          uuid.UUID       `json:"id" db:"id"`
+	OrganizerID uuid.UUID       `json:"-" db:"organizer_id"`
+	EventID     uuid.UUID       `json:"eventId" db:"event_id"`
+	Kind        ExportKind      `json:"kind" db:"kind"`
+	Format      string          `json:"format" db:"format"`
+	Status      ExportJobStatus `json:"status" db:"status"`
+	Filename    string          `json:"filename" db:"filename"`
+	RowCount    int             `json:"rowCount" db:"row_count"`
+	Attempts    int             `json:"-" db:"attempts"`
+	LastError   sql.NullString  `json:"-" db:"last_error"`
+	Content     []byte          `json:"-" db:"content"`
+	CreatedAt   time.Time       `json:"createdAt" db:"created_at"`
+	StartedAt   *time.Time      `json:"startedAt,omitempty" db:"started_at"`
+	CompletedAt *time.Time      `json:"completedAt,omitempty" db:"completed_at"`
+	ExpiresAt   time.Time       `json:"expiresAt" db:"expires_at"`
+}
+
+// ExportRequest describes one event export
+type ExportRequest struct {
+	EventID     uuid.UUID
+	OrganizerID uuid.UUID
+	Kind        ExportKind
+	Format      string
+}
+
+// AttendeeExportRow is one issued ticket with its holder
+type AttendeeExportRow struct {
+	Code           string     `db:"code"`
+	FirstName      string     `db:"first_name"`
+	LastName       string     `db:"last_name"`
+	Email          string     `db:"email"`
+	Phone          string     `db:"phone"`
+	TierName       string     `db:"tier_name"`
+	Status         string     `db:"status"`
+	CheckedIn      bool       `db:"checked_in"`
+	CheckedInAt    *time.Time `db:"checked_in_at"`
+	OrderReference string     `db:"order_reference"`
+	PurchasedAt    *time.Time `db:"purchased_at"`
+}
+
+// OrderLedgerRow is one order touching the event. Ticket and subtotal
+// figures cover only this event's items; the fee columns are the whole order.
+type OrderLedgerRow struct {
+	Reference      string     `db:"reference"`
+	Status         string     `db:"status"`
+	FirstName      string     `db:"first_name"`
+	LastName       string     `db:"last_name"`
+	Email          string     `db:"email"`
+	Phone          string     `db:"phone"`
+	Tickets        int        `db:"tickets"`
+	EventSubtotal  int64      `db:"event_subtotal"`
+	ServiceFee     int64      `db:"service_fee"`
+	VATAmount      int64      `db:"vat_amount"`
+	FinalTotal     int64      `db:"final_total"`
+	AmountPaid     int64      `db:"amount_paid"`
+	PaymentChannel string     `db:"payment_channel"`
+	RefundStatus   string     `db:"refund_status"`
+	CreatedAt      time.Time  `db:"created_at"`
+	PaidAt         *time.Time `db:"paid_at"`
+}
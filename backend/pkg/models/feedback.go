@@ -59,39 +59,42 @@ func (ns NullableString) MarshalJSON() ([]byte, error) {
 }
 
 type Feedback struct {
-	ID        uuid.UUID           `json:"id" db:"id"`
-	UserID    sql.Null[uuid.UUID] `json:"userId,omitempty" db:"user_id"`
-	GuestID   string              `json:"guestId" db:"guest_id"`
-	Type      FeedbackType        `json:"type" db:"type"`
-	Message   string              `json:"message" db:"message"`
-	ImageURL  sql.NullString      `json:"imageUrl,omitempty" db:"image_url"`
-	Name      string              `json:"name" db:"name"`
-	Email     string              `json:"email" db:"email"`
-	CreatedAt time.Time           `json:"createdAt" db:"created_at"`
-	UpdatedAt time.Time           `json:"updatedAt" db:"updated_at"`
+	ID           uuid.UUID           `json:"id" db:"id"`
+	UserID       sql.Null[uuid.UUID] `json:"userId,omitempty" db:"user_id"`
+	GuestID      string              `json:"guestId" db:"guest_id"`
+	Type         FeedbackType        `json:"type" db:"type"`
+	Message      string              `json:"message" db:"message"`
+	ImageURL     sql.NullString      `json:"imageUrl,omitempty" db:"image_url"`
+	ImageAssetID sql.Null[uuid.UUID] `json:"imageAssetId,omitempty" db:"image_asset_id"`
+	Name         string              `json:"name" db:"name"`
+	Email        string              `json:"email" db:"email"`
+	CreatedAt    time.Time           `json:"createdAt" db:"created_at"`
+	UpdatedAt    time.Time           `json:"updatedAt" db:"updated_at"`
 }
 
 // CreateFeedbackRequest is the request payload for creating feedback
 type CreateFeedbackRequest struct {
-	Type     FeedbackType   `json:"type" binding:"required,oneof=suggestion complaint feedback"`
-	Message  string         `json:"message" binding:"required,min=1,max=2000"`
-	ImageURL NullableString `json:"imageUrl"` // Custom type handles null/empty gracefully
-	Name     string         `json:"name" binding:"required,min=1,max=100"`
-	Email    string         `json:"email" binding:"required,email"`
+	Type         FeedbackType   `json:"type" binding:"required,oneof=suggestion complaint feedback"`
+	Message      string         `json:"message" binding:"required,min=1,max=2000"`
+	ImageURL     NullableString `json:"imageUrl"`     // Custom type handles null/empty gracefully
+	ImageAssetID *uuid.UUID     `json:"imageAssetId"` // From POST /api/v1/media/images (purpose=feedback)
+	Name         string         `json:"name" binding:"required,min=1,max=100"`
+	Email        string         `json:"email" binding:"required,email"`
 }
 
 // FeedbackResponse is the response payload for feedback
 type FeedbackResponse struct {
-	ID        string       `json:"id"`
-	UserID    *string      `json:"userId,omitempty"`
-	GuestID   string       `json:"guestId"`
-	Type      FeedbackType `json:"type"`
-	Message   string       `json:"message"`
-	ImageURL  *string      `json:"imageUrl,omitempty"`
-	Name      string       `json:"name"`
-	Email     string       `json:"email"`
-	CreatedAt time.Time    `json:"createdAt"`
-	UpdatedAt time.Time    `json:"updatedAt"`
+	ID           string       `json:"id"`
+	UserID       *string      `json:"userId,omitempty"`
+	GuestID      string       `json:"guestId"`
+	Type         FeedbackType `json:"type"`
+	Message      string       `json:"message"`
+	ImageURL     *string      `json:"imageUrl,omitempty"`
+	ImageAssetID *string      `json:"imageAssetId,omitempty"`
+	Name         string       `json:"name"`
+	Email        string       `json:"email"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	UpdatedAt    time.Time    `json:"updatedAt"`
 }
 
 // ToResponse converts a Feedback model to FeedbackResponse
@@ -117,6 +120,10 @@ func (f *Feedback) ToResponse() FeedbackResponse {
 	if f.ImageURL.Valid && f.ImageURL.String != "" {
 		response.ImageURL = &f.ImageURL.String
 	}
+	if f.ImageAssetID.Valid {
+		assetIDStr := f.ImageAssetID.V.String()
+		response.ImageAssetID = &assetIDStr
+	}
 
 	return response
 }
@@ -0,0 +1,119 @@
+// backend/pkg/models/gate.go
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================================
+// OFFLINE GATE MANIFEST
+// ============================================================================
+
+// Compact status codes used in the manifest to keep the download small
+// for scanner devices on slow networks.
+const (
+	ManifestStatusActive   = "a"
+	ManifestStatusUsed     = "u"
+	ManifestStatusCanceled = "x"
+)
+
+// GateManifestEntry is a single ticket in the offline manifest.
+// Short JSON keys are intentional: manifests for large events can hold
+// tens of thousands of entries.
+type GateManifestEntry struct {
+	Code   string `json:"c" db:"code"`
+	Status string `json:"s" db:"status"`
+}
+
+// GateManifest is the signed snapshot of all ticket codes for an event.
+// Signature is an HMAC over "<eventId>\n<generatedAt unix>\n<code>:<status>..."
+// which devices rebuild and verify before trusting the entries.
+type GateManifest struct {
+	EventID     uuid.UUID           `json:"eventId"`
+	GeneratedAt time.Time           `json:"generatedAt"`
+	Count       int                 `json:"count"`
+	Entries     []GateManifestEntry `json:"entries"`
+	Signature   string              `json:"signature,omitempty"`
+}
+
+// ManifestStatusFromTicket maps a ticket status to its compact manifest code
+func ManifestStatusFromTicket(status TicketStatus) string {
+	switch status {
+	case TicketStatusUsed:
+		return ManifestStatusUsed
+	case TicketStatusCanceled:
+		return ManifestStatusCanceled
+	default:
+		return ManifestStatusActive
+	}
+}
+
+// ============================================================================
+// OFFLINE SCAN SYNC
+// ============================================================================
+
+type GateScanOutcome string
+
+const (
+	GateScanAccepted  GateScanOutcome = "accepted"
+	GateScanDuplicate GateScanOutcome = "duplicate"
+	GateScanRejected  GateScanOutcome = "rejected"
+)
+
+// GateScan is one entry from a device's offline scan log
+type GateScan struct {
+	Code      string    `json:"code" binding:"required"`
+	GateID    string    `json:"gateId"`
+	DeviceID  string    `json:"deviceId"`
+	ScannedAt time.Time `json:"scannedAt" binding:"required"`
+}
+
+// GateSyncRequest is the payload for POST /api/v1/gate/sync
+type GateSyncRequest struct {
+	EventID  uuid.UUID  `json:"eventId" binding:"required"`
+	DeviceID string     `json:"deviceId" binding:"required"`
+	Scans    []GateScan `json:"scans" binding:"required,min=1,max=5000,dive"`
+}
+
+// GateScanResult reports how a single uploaded scan was resolved
+type GateScanResult struct {
+	Code      string          `json:"code"`
+	GateID    string          `json:"gateId,omitempty"`
+	DeviceID  string          `json:"deviceId"`
+	ScannedAt time.Time       `json:"scannedAt"`
+	Outcome   GateScanOutcome `json:"outcome"`
+	Reason    string          `json:"reason,omitempty"`
+
+	// Set on duplicates so staff can see which gate admitted the ticket first
+	AdmittedAt     *time.Time `json:"admittedAt,omitempty"`
+	AdmittedDevice string     `json:"admittedDevice,omitempty"`
+	AdmittedGate   string     `json:"admittedGate,omitempty"`
+}
+
+// GateSyncResponse summarises a batch sync
+type GateSyncResponse struct {
+	EventID    uuid.UUID        `json:"eventId"`
+	Accepted   int              `json:"accepted"`
+	Duplicates int              `json:"duplicates"`
+	Rejected   int              `json:"rejected"`
+	Results    []GateScanResult `json:"results"`
+}
+
+// GateTicketState is the server-side view of a ticket used during sync
+// and by the online check-in path, which also needs the event's re-entry policy.
+type GateTicketState struct {
+	ID              uuid.UUID     `db:"id"`
+	Code            string        `db:"code"`
+	EventID         uuid.UUID     `db:"event_id"`
+	TicketTierID    uuid.UUID     `db:"ticket_tier_id"`
+	Status          TicketStatus  `db:"status"`
+	IsUsed          bool          `db:"is_used"`
+	UsedAt          *time.Time    `db:"used_at"`
+	CheckedInDevice *string       `db:"checked_in_device"`
+	CheckedInGate   *string       `db:"checked_in_gate"`
+	IsInside        bool          `db:"is_inside"`
+	ReentryPolicy   ReentryPolicy `db:"reentry_policy"`
+}
@@ -0,0 +1,22 @@
+// backend/pkg/models/geo.go
+
+package models
+
+import "strings"
+
+// GeoPoint is a WGS84 coordinate
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// VenueQuery is the free-text address sent to a geocoder
+func (e *Event) VenueQuery() string {
+	var parts []string
+	for _, p := range []*string{e.VenueName, e.VenueAddress, e.City, e.State, e.Country} {
+		if p != nil && strings.TrimSpace(*p) != "" {
+			parts = append(parts, strings.TrimSpace(*p))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
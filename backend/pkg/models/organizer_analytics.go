@@ -0,0 +1,139 @@
+// backend/pkg/models/organizer_analytics.go
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================================
+// ORGANIZER DASHBOARD (Cross-Event Analytics)
+// ============================================================================
+
+// OrganizerDashboard rolls every event an organizer runs into one view
+// Frontend usage: GET /api/events/analytics/dashboard
+type OrganizerDashboard struct {
+	OrganizerID  string                   `json:"organizerId"`
+	GeneratedAt  time.Time                `json:"generatedAt"`
+	Days         int                      `json:"days"` // length of the timeline window
+	Totals       OrganizerTotals          `json:"totals"`
+	Timeline     []OrganizerTimelinePoint `json:"timeline"`
+	Events       []EventComparison        `json:"events"`
+	TopTiers     []TopTier                `json:"topTiers"`
+	RepeatBuyers RepeatBuyerStats         `json:"repeatBuyers"`
+	AtRisk       []AtRiskEvent            `json:"atRisk"`
+}
+
+// OrganizerTotals are all-time totals across the organizer's events
+type OrganizerTotals struct {
+	Revenue         int     `json:"revenue"` // ticket subtotal in kobo, fees excluded
+	TicketsSold     int     `json:"ticketsSold"`
+	Orders          int     `json:"orders"`
+	Events          int     `json:"events"`
+	UpcomingEvents  int     `json:"upcomingEvents"`
+	SellThroughRate float64 `json:"sellThroughRate"` // sold vs capacity over all events, percentage
+}
+
+// OrganizerTimelinePoint is one day of sales across every event
+type OrganizerTimelinePoint struct {
+	Date              string `json:"date"` // "2025-01-15", Africa/Lagos
+	TicketsSold       int    `json:"ticketsSold"`
+	Revenue           int    `json:"revenue"`
+	OrderCount        int    `json:"orderCount"`
+	CumulativeSold    int    `json:"cumulativeSold"`    // within the window
+	CumulativeRevenue int    `json:"cumulativeRevenue"` // within the window
+}
+
+// EventComparison lines events up side by side, newest start date first
+type EventComparison struct {
+	EventID         string    `json:"eventId"`
+	Title           string    `json:"title"`
+	StartDate       time.Time `json:"startDate"`
+	Status          string    `json:"status"`
+	TicketsSold     int       `json:"ticketsSold"`
+	Capacity        int       `json:"capacity"`
+	Revenue         int       `json:"revenue"`
+	OrderCount      int       `json:"orderCount"`
+	SellThroughRate float64   `json:"sellThroughRate"`
+	AvgOrderValue   float64   `json:"avgOrderValue"`
+	RevenueShare    float64   `json:"revenueShare"` // of the organizer's total, percentage
+}
+
+// TopTier is one of the organizer's best-earning tiers
+type TopTier struct {
+	EventID         string  `json:"eventId"`
+	EventTitle      string  `json:"eventTitle"`
+	TierName        string  `json:"tierName"`
+	TicketsSold     int     `json:"ticketsSold"`
+	Revenue         int     `json:"revenue"`
+	SellThroughRate float64 `json:"sellThroughRate"`
+}
+
+// RepeatBuyerStats counts buyers who came back for more than one event.
+// Buyers are matched by account, or by email for guest checkouts.
+type RepeatBuyerStats struct {
+	UniqueBuyers      int     `json:"uniqueBuyers"`
+	RepeatBuyers      int     `json:"repeatBuyers"`
+	RepeatRate        float64 `json:"repeatRate"`        // percentage of buyers
+	RepeatRevenue     int     `json:"repeatRevenue"`     // spent by repeat buyers, kobo
+	RepeatRevenueRate float64 `json:"repeatRevenueRate"` // percentage of revenue
+	AvgEventsPerBuyer float64 `json:"avgEventsPerBuyer"`
+}
+
+// AtRiskEvent is an upcoming event selling slowly close to its start date
+type AtRiskEvent struct {
+	EventID         string    `json:"eventId"`
+	Title           string    `json:"title"`
+	StartDate       time.Time `json:"startDate"`
+	DaysUntilStart  int       `json:"daysUntilStart"`
+	TicketsSold     int       `json:"ticketsSold"`
+	Capacity        int       `json:"capacity"`
+	SellThroughRate float64   `json:"sellThroughRate"`
+	Risk            string    `json:"risk"` // "high" or "medium"
+}
+
+// ============================================================================
+// INTERNAL DATA TRANSFER OBJECTS (Repository → Service)
+// ============================================================================
+
+// OrganizerEventRaw is one event with its successful sales and capacity
+type OrganizerEventRaw struct {
+	EventID     uuid.UUID `db:"event_id"`
+	Title       string    `db:"title"`
+	StartDate   time.Time `db:"start_date"`
+	Status      string    `db:"status"`
+	Cancelled   bool      `db:"cancelled"`
+	Capacity    int       `db:"capacity"`
+	TicketsSold int       `db:"tickets_sold"`
+	Revenue     int       `db:"revenue"`
+	OrderCount  int       `db:"order_count"`
+}
+
+// DailySalesRaw is one day of successful sales
+type DailySalesRaw struct {
+	Day         time.Time `db:"day"`
+	TicketsSold int       `db:"tickets_sold"`
+	Revenue     int       `db:"revenue"`
+	OrderCount  int       `db:"order_count"`
+}
+
+// TierSalesRaw is a tier's successful sales against its capacity
+type TierSalesRaw struct {
+	EventID     uuid.UUID `db:"event_id"`
+	EventTitle  string    `db:"event_title"`
+	TierName    string    `db:"tier_name"`
+	Capacity    int       `db:"capacity"`
+	TicketsSold int       `db:"tickets_sold"`
+	Revenue     int       `db:"revenue"`
+}
+
+// RepeatBuyersRaw summarises buyers across the organizer's events
+type RepeatBuyersRaw struct {
+	UniqueBuyers     int `db:"unique_buyers"`
+	RepeatBuyers     int `db:"repeat_buyers"`
+	RepeatRevenue    int `db:"repeat_revenue"`
+	TotalRevenue     int `db:"total_revenue"`
+	EventAttendances int `db:"event_attendances"`
+}
@@ -0,0 +1,17 @@
+// backend/pkg/models/pagination.go
+
+package models
+
+// Page size bounds shared by the public listings
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// PageInfo accompanies a keyset-paginated listing. NextCursor is empty on
+// the last page; pass it back as ?cursor= to fetch the next one.
+type PageInfo struct {
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      int64  `json:"total"`
+	Limit      int    `json:"limit"`
+}
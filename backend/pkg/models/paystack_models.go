@@ -32,6 +32,8 @@ type PaystackData struct {
 	// Transaction IDs
 	ID        int64   `json:"id"`
 	Reference string  `json:"reference"`
+	// Refund events name the refunded transaction here instead
+	TransactionReference string `json:"transaction_reference,omitempty"`
 	Domain    string  `json:"domain"`
 	OrderID   *string `json:"order_id"`
 	
@@ -0,0 +1,120 @@
+// backend/pkg/models/platform_analytics.go
+
+package models
+
+import "time"
+
+// ============================================================================
+// PLATFORM ANALYTICS (GET /admin/analytics)
+// ============================================================================
+
+// PlatformAnalyticsQuery is an inclusive range of YYYY-MM-DD days; either
+// end may be empty for the default range
+type PlatformAnalyticsQuery struct {
+	From string
+	To   string
+}
+
+// PlatformAnalytics is the marketplace-wide view for admins
+type PlatformAnalytics struct {
+	From     string                `json:"from"` // YYYY-MM-DD, inclusive
+	To       string                `json:"to"`   // YYYY-MM-DD, inclusive
+	Timezone string                `json:"timezone"`
+	Sales    PlatformSales         `json:"sales"`
+	Orders   PlatformOrders        `json:"orders"`
+	Vendors  PlatformVendors       `json:"vendors"`
+	Feedback PlatformFeedback      `json:"feedback"`
+	Users    PlatformUsers         `json:"users"`
+	Daily    []PlatformDailyMetric `json:"daily"`
+}
+
+// PlatformSales covers successful orders paid in the range. Money is in kobo.
+type PlatformSales struct {
+	GMV               int64   `json:"gmv"`          // Sum of final totals
+	PlatformFees      int64   `json:"platformFees"` // Sum of AppProfit
+	SuccessfulOrders  int     `json:"successfulOrders"`
+	AverageOrderValue float64 `json:"averageOrderValue"`
+	TakeRate          float64 `json:"takeRate"` // PlatformFees / GMV * 100
+}
+
+// PlatformOrders counts orders created in the range by status; every
+// status is present, including fraud and expired
+type PlatformOrders struct {
+	Total    int            `json:"total"`
+	ByStatus map[string]int `json:"byStatus"`
+}
+
+// PlatformVendors is vendor growth in the range plus the current
+// verification funnel across all vendors
+type PlatformVendors struct {
+	NewInRange int                   `json:"newInRange"`
+	Funnel     PlatformVendorFunnel  `json:"funnel"`
+	Stages     []PlatformFunnelStage `json:"stages"`
+}
+
+type PlatformVendorFunnel struct {
+	Total              int `json:"total" db:"total"`
+	Active             int `json:"active" db:"active"`
+	Suspended          int `json:"suspended" db:"suspended"`
+	IdentityVerified   int `json:"identityVerified" db:"identity_verified"`
+	BusinessRegistered int `json:"businessRegistered" db:"business_registered"`
+	BusinessVerified   int `json:"businessVerified" db:"business_verified"`
+}
+
+// PlatformFunnelStage is one step of registered → identity → business verified
+type PlatformFunnelStage struct {
+	Stage            string  `json:"stage"`
+	Count            int     `json:"count"`
+	RateFromPrevious float64 `json:"rateFromPrevious"` // %
+	RateFromTotal    float64 `json:"rateFromTotal"`    // %
+}
+
+// PlatformFeedback counts feedback submitted in the range by FeedbackType
+type PlatformFeedback struct {
+	Total  int            `json:"total"`
+	ByType map[string]int `json:"byType"`
+}
+
+// PlatformUsers covers sign-ups and daily active users (users who made an
+// authenticated request that day)
+type PlatformUsers struct {
+	NewInRange    int     `json:"newInRange"`
+	ActiveInRange int     `json:"activeInRange"` // Distinct users active on any day
+	AverageDAU    float64 `json:"averageDau"`
+	PeakDAU       int     `json:"peakDau"`
+	PeakDay       string  `json:"peakDay"` // Empty when nobody was active
+}
+
+// PlatformDailyMetric is one day; days with no activity are zero-filled
+type PlatformDailyMetric struct {
+	Day              string `json:"day"` // YYYY-MM-DD
+	GMV              int64  `json:"gmv"`
+	PlatformFees     int64  `json:"platformFees"`
+	SuccessfulOrders int    `json:"successfulOrders"`
+	NewVendors       int    `json:"newVendors"`
+	NewUsers         int    `json:"newUsers"`
+	ActiveUsers      int    `json:"activeUsers"`
+	Feedback         int    `json:"feedback"`
+}
+
+// ============================================================================
+// INTERNAL (Repository → Service)
+// ============================================================================
+
+// PlatformDailyRaw is one day's platform totals
+type PlatformDailyRaw struct {
+	Day              time.Time `db:"day"`
+	GMV              int64     `db:"gmv"`
+	PlatformFees     int64     `db:"platform_fees"`
+	SuccessfulOrders int       `db:"successful_orders"`
+	NewVendors       int       `db:"new_vendors"`
+	NewUsers         int       `db:"new_users"`
+	ActiveUsers      int       `db:"active_users"`
+	Feedback         int       `db:"feedback"`
+}
+
+// StatusCountRaw is a count grouped by a status or type column
+type StatusCountRaw struct {
+	Status string `db:"status"`
+	Count  int    `db:"count"`
+}
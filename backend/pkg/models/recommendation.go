@@ -0,0 +1,106 @@
+// backend/pkg/models/recommendation.go
+
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PopularSubjectKey stores the fallback list served to people with no history
+const PopularSubjectKey = "_popular"
+
+const guestSubjectPrefix = "guest:"
+
+// RecommendationSubject is who recommendations are for: a signed-in user or
+// a guest identified by their guest_id cookie
+type RecommendationSubject struct {
+	UserID  *uuid.UUID
+	GuestID string
+}
+
+// Key is the stored form of the subject. It matches the expression the
+// repository builds from likes/orders rows, so co-interaction queries can
+// exclude the subject themselves.
+func (s RecommendationSubject) Key() string {
+	if s.UserID != nil {
+		return s.UserID.String()
+	}
+	if s.GuestID != "" {
+		return guestSubjectPrefix + s.GuestID
+	}
+	return ""
+}
+
+func (s RecommendationSubject) IsZero() bool {
+	return s.Key() == ""
+}
+
+// ParseRecommendationSubject reverses Key
+func ParseRecommendationSubject(key string) (RecommendationSubject, bool) {
+	if guestID, ok := strings.CutPrefix(key, guestSubjectPrefix); ok && guestID != "" {
+		return RecommendationSubject{GuestID: guestID}, true
+	}
+	if id, err := uuid.Parse(key); err == nil {
+		return RecommendationSubject{UserID: &id}, true
+	}
+	return RecommendationSubject{}, false
+}
+
+// EventFeatures is what the recommender knows about an event
+type EventFeatures struct {
+	EventID    uuid.UUID
+	Category   string
+	Tags       []string
+	City       string
+	Popularity float64 // recent likes + tickets sold
+}
+
+// EventInteraction is an event the subject liked or bought tickets for.
+// Purchases weigh more than likes.
+type EventInteraction struct {
+	EventFeatures
+	Weight float64
+}
+
+// CoInteractions counts how many people liked or bought both events of a
+// pair, keyed seed event first and candidate event second
+type CoInteractions map[uuid.UUID]map[uuid.UUID]int
+
+// Recommendation reasons, strongest first in RecommendedEvent.Reasons
+const (
+	ReasonCategory = "category"
+	ReasonTags     = "tags"
+	ReasonCity     = "city"
+	ReasonSimilar  = "similar_attendees"
+	ReasonPopular  = "popular"
+)
+
+// ScoredEvent is a recommender result before it's joined back to events
+type ScoredEvent struct {
+	EventID uuid.UUID
+	Score   float64
+	Reasons []string
+}
+
+// RecommendedEvent is a compact event card with why it was recommended
+type RecommendedEvent struct {
+	EventID   uuid.UUID `json:"eventId" db:"event_id"`
+	Title     string    `json:"eventTitle" db:"event_title"`
+	Slug      *string   `json:"eventSlug,omitempty" db:"event_slug"`
+	Category  string    `json:"category" db:"category"`
+	City      *string   `json:"city,omitempty" db:"city"`
+	VenueName *string   `json:"venueName,omitempty" db:"venue_name"`
+	StartDate time.Time `json:"startDate" db:"start_date"`
+	ImageURL  string    `json:"eventImage" db:"event_image_url"`
+	Score     float64   `json:"score" db:"score"`
+	Reasons   []string  `json:"reasons" db:"-"`
+}
+
+type RecommendationsResponse struct {
+	Recommendations []RecommendedEvent `json:"recommendations"`
+	Personalized    bool               `json:"personalized"`
+	ComputedAt      *time.Time         `json:"computedAt,omitempty"`
+}
@@ -27,6 +27,7 @@ type Ticket struct {
 	Status       TicketStatus        `json:"status" db:"status"`
 	IsUsed       bool                `json:"isUsed" db:"is_used"`
 	UsedAt       sql.NullTime        `json:"usedAt,omitempty" db:"used_at"`
+	IsInside     bool                `json:"isInside" db:"is_inside"`
 	CreatedAt    time.Time           `json:"createdAt" db:"created_at"`
 	UpdatedAt    time.Time           `json:"updatedAt" db:"updated_at"`
 }
\ No newline at end of file
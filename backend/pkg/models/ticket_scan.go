@@ -0,0 +1,89 @@
+// backend/pkg/models/ticket_scan.go
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReentryPolicy controls whether a ticket can leave and come back in
+type ReentryPolicy string
+
+const (
+	ReentrySingle ReentryPolicy = "single" // One admission per ticket (default)
+	ReentryInOut  ReentryPolicy = "in_out" // Scan out at exit, scan back in later
+)
+
+// IsValid reports whether the policy is one the gate understands
+func (p ReentryPolicy) IsValid() bool {
+	return p == ReentrySingle || p == ReentryInOut
+}
+
+type ScanDirection string
+
+const (
+	ScanIn   ScanDirection = "in"
+	ScanOut  ScanDirection = "out"
+	ScanUndo ScanDirection = "undo" // Organizer reversed a check-in
+)
+
+// MaxScanFieldLength is the width of the ticket_code, device_id and gate_id
+// columns. Longer values are cut down before they are logged.
+const MaxScanFieldLength = 64
+
+type ScanResult string
+
+const (
+	ScanGranted ScanResult = "granted"
+	ScanDenied  ScanResult = "denied"
+)
+
+// TicketScan is one row of the check-in audit log.
+// Denied scans are stored too, including forged codes that match no ticket.
+type TicketScan struct {
+	ID           uuid.UUID     `json:"id" db:"id"`
+	TicketID     *uuid.UUID    `json:"ticketId,omitempty" db:"ticket_id"`
+	TicketCode   string        `json:"ticketCode" db:"ticket_code"`
+	EventID      *uuid.UUID    `json:"eventId,omitempty" db:"event_id"`
+	SessionID    *uuid.UUID    `json:"sessionId,omitempty" db:"session_id"`
+	Direction    ScanDirection `json:"direction" db:"direction"`
+	Result       ScanResult    `json:"result" db:"result"`
+	DenialReason *string       `json:"denialReason,omitempty" db:"denial_reason"`
+	UndoReason   *string       `json:"undoReason,omitempty" db:"undo_reason"`
+	DeviceID     *string       `json:"deviceId,omitempty" db:"device_id"`
+	GateID       *string       `json:"gateId,omitempty" db:"gate_id"`
+	StaffID      *uuid.UUID    `json:"staffId,omitempty" db:"staff_id"`
+	ScannedAt    time.Time     `json:"scannedAt" db:"scanned_at"`
+	CreatedAt    time.Time     `json:"createdAt" db:"created_at"`
+}
+
+// CheckInRequest is the payload for POST /api/v1/gate/check-in
+type CheckInRequest struct {
+	Code      string        `json:"code" binding:"required"`
+	Direction ScanDirection `json:"direction" binding:"omitempty,oneof=in out"`
+	DeviceID  string        `json:"deviceId"`
+	GateID    string        `json:"gateId"`
+	SessionID *uuid.UUID    `json:"sessionId"` // Optional: validate against a specific session
+	StaffID   *uuid.UUID    `json:"-"`         // Set from JWT
+}
+
+// GateCheckInCount is the number of admissions through a single gate
+type GateCheckInCount struct {
+	GateID   string `json:"gateId" db:"gate_id"`
+	Admitted int    `json:"admitted" db:"admitted"`
+}
+
+// CheckInStats powers the live counter on the organizer dashboard
+type CheckInStats struct {
+	EventID         uuid.UUID          `json:"eventId"`
+	TotalTickets    int                `json:"totalTickets" db:"total_tickets"`
+	CheckedIn       int                `json:"checkedIn" db:"checked_in"`
+	CurrentlyInside int                `json:"currentlyInside" db:"currently_inside"`
+	NotArrived      int                `json:"notArrived" db:"-"`
+	DeniedScans     int                `json:"deniedScans" db:"-"`
+	LastScanAt      *time.Time         `json:"lastScanAt,omitempty" db:"-"`
+	ByGate          []GateCheckInCount `json:"byGate" db:"-"`
+	RecentScans     []TicketScan       `json:"recentScans" db:"-"`
+}
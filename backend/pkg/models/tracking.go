@@ -0,0 +1,54 @@
+// backend/pkg/models/tracking.go
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrackingEventType is one step of the event page → purchase funnel
+type TrackingEventType string
+
+const (
+	TrackView               TrackingEventType = "view"
+	TrackTicketSelectorOpen TrackingEventType = "ticket_selector_open"
+	TrackCheckoutStart      TrackingEventType = "checkout_start"
+	TrackPurchase           TrackingEventType = "purchase"
+)
+
+// FunnelSteps lists the funnel in order
+var FunnelSteps = []TrackingEventType{TrackView, TrackTicketSelectorOpen, TrackCheckoutStart, TrackPurchase}
+
+func (t TrackingEventType) IsValid() bool {
+	switch t {
+	case TrackView, TrackTicketSelectorOpen, TrackCheckoutStart, TrackPurchase:
+		return true
+	}
+	return false
+}
+
+// IsClientReported is true for steps only the browser can see. Views,
+// checkout starts and purchases are recorded server-side so they can't be
+// inflated from outside.
+func (t TrackingEventType) IsClientReported() bool {
+	return t == TrackTicketSelectorOpen
+}
+
+// TrackingEvent is one row of the append-only event_tracking table
+type TrackingEvent struct {
+	ID         uuid.UUID         `db:"id"`
+	EventID    uuid.UUID         `db:"event_id"`
+	Type       TrackingEventType `db:"event_type"`
+	UserID     *uuid.UUID        `db:"user_id"`
+	GuestID    string            `db:"guest_id"`
+	OrderID    *uuid.UUID        `db:"order_id"`
+	OccurredAt time.Time         `db:"occurred_at"`
+}
+
+// TrackEventRequest is what the frontend posts for client-side steps
+type TrackEventRequest struct {
+	EventID uuid.UUID         `json:"eventId" binding:"required"`
+	Type    TrackingEventType `json:"type" binding:"required"`
+}
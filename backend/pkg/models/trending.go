@@ -0,0 +1,16 @@
+// backend/pkg/models/trending.go
+
+package models
+
+import "github.com/google/uuid"
+
+// TrendingScore is one event's row in the materialized trending ranking.
+// The components are kept alongside the blended score so the ranking can be
+// explained and tuned.
+type TrendingScore struct {
+	EventID        uuid.UUID `json:"eventId" db:"event_id"`
+	Score          float64   `json:"score" db:"score"`
+	LikeScore      float64   `json:"likeScore" db:"like_score"`
+	TicketVelocity float64   `json:"ticketVelocity" db:"ticket_velocity"`
+	ViewScore      float64   `json:"viewScore" db:"view_score"`
+}
@@ -79,6 +79,7 @@ type Vendor struct {
 	Name                 string         `json:"name" db:"name"`
 	Category             string         `json:"category" db:"category"`
 	ImageURL             sql.NullString `json:"imageURL" db:"image_url"` // Fixed
+	ImageAssetID         *uuid.UUID     `json:"imageAssetId,omitempty" db:"image_asset_id"`
 	Status               VendorStatus   `json:"status" db:"status"`
 	IsIdentityVerified   bool           `json:"isIdentityVerified" db:"is_identity_verified"`
 	IsBusinessRegistered bool           `json:"isBusinessRegistered" db:"is_business_registered"`
@@ -19,6 +19,7 @@ type VendorAnalyticsResponse struct {
 	Reviews     VendorReviews       `json:"reviews"`
 	Trends      VendorTrends        `json:"trends"`
 	Performance VendorPerformance   `json:"performance"`
+	Visibility  VendorVisibility    `json:"visibility"`
 	Insights    []ActionableInsight `json:"insights"` // Key recommendations
 }
 
@@ -123,6 +124,29 @@ type PeriodMetrics struct {
 //	BookingsCompleted int     `json:"bookingsCompleted"` // If tracked separately
 }
 
+// ============================================================================
+// VISIBILITY DATA (Is the listing being seen?)
+// ============================================================================
+
+// VendorVisibility shows how often the listing is seen and acted on. Counts
+// are distinct visitors per day (by guest_id), summed over the period.
+type VendorVisibility struct {
+	Last7Days  VisibilityMetrics `json:"last7Days"`
+	Last30Days VisibilityMetrics `json:"last30Days"`
+}
+
+// VisibilityMetrics represents visibility for a specific time period
+type VisibilityMetrics struct {
+	ListImpressions       int     `json:"listImpressions"` // Appearances in search/browse results
+	ProfileViews          int     `json:"profileViews"`
+	PhoneReveals          int     `json:"phoneReveals"`
+	EmailReveals          int     `json:"emailReveals"`
+	Inquiries             int     `json:"inquiries"`
+	ClickThroughRate      float64 `json:"clickThroughRate"`      // views / impressions * 100
+	ContactRevealRate     float64 `json:"contactRevealRate"`     // (phone + email) / views * 100
+	InquiryConversionRate float64 `json:"inquiryConversionRate"` // inquiries / views * 100
+}
+
 // ============================================================================
 // PERFORMANCE INDICATORS (Account Health)
 // ============================================================================
@@ -136,7 +160,73 @@ type VendorPerformance struct {
     LastProfileUpdate    time.Time `json:"lastProfileUpdate"`
     AccountStatus        string    `json:"accountStatus"`
     ProfileCompleteness  float64   `json:"profileCompleteness"`
-    PVSScoreTrend        string    `json:"pvsScoreTrend"`
+    PVSScoreTrend        string    `json:"pvsScoreTrend"`  // "improving", "stable" or "declining" over 30 days
+    PVSScoreChange       int       `json:"pvsScoreChange"` // points gained or lost over 30 days
+    PVSHistory           []PVSHistoryPoint `json:"pvsHistory"`
+}
+
+// PVSHistoryPoint is the vendor's PVS as of the end of a day
+type PVSHistoryPoint struct {
+	Day      time.Time `json:"day" db:"day"`
+	PVSScore int       `json:"pvsScore" db:"pvs_score"`
+}
+
+// ============================================================================
+// DETAILED TRENDS (GET /vendors/:id/analytics/trends)
+// ============================================================================
+
+// VendorTrendSeries is one metric bucketed over a period, compared with the
+// period just before it
+type VendorTrendSeries struct {
+	VendorID      string              `json:"vendorId"`
+	Metric        string              `json:"metric"`   // "inquiries", "reviews", "rating" or "views"
+	Period        string              `json:"period"`   // "7d", "30d", "90d" or "12m"
+	GroupBy       string              `json:"groupBy"`  // "day", "week" or "month"
+	Timezone      string              `json:"timezone"` // IANA name buckets are aligned to
+	From          time.Time           `json:"from"`
+	To            time.Time           `json:"to"`
+	Current       float64             `json:"current"`       // Period total (review-weighted average for rating)
+	Previous      float64             `json:"previous"`      // Same for the previous period
+	Change        float64             `json:"change"`        // Current - Previous
+	ChangePercent *float64            `json:"changePercent"` // nil when the previous period had nothing
+	Trend         string              `json:"trend"`         // "increasing", "stable" or "decreasing"
+	Buckets       []VendorTrendBucket `json:"buckets"`
+}
+
+// VendorTrendBucket is one day, week or month; empty buckets are zero-filled
+type VendorTrendBucket struct {
+	Start         time.Time `json:"start"`
+	Label         string    `json:"label"`
+	Value         float64   `json:"value"`
+	Count         int       `json:"count"`         // Rows behind the value (reviews for rating)
+	PreviousValue float64   `json:"previousValue"` // Bucket at the same position last period
+	Change        float64   `json:"change"`
+}
+
+// ============================================================================
+// CATEGORY BENCHMARKS (GET /vendors/:id/analytics/benchmarks)
+// ============================================================================
+
+// VendorBenchmarks ranks a vendor against active vendors in its category
+type VendorBenchmarks struct {
+	VendorID   string            `json:"vendorId"`
+	Category   string            `json:"category"`
+	State      string            `json:"state"`
+	MinPeers   int               `json:"minPeers"`   // Smallest cohort a rank is shown for
+	ComputedAt *time.Time        `json:"computedAt"` // nil until the benchmark job has run
+	Metrics    []VendorBenchmark `json:"metrics"`
+}
+
+// VendorBenchmark is the vendor's standing on one metric
+type VendorBenchmark struct {
+	Metric        string   `json:"metric"` // "response_rate", "rating", "review_count", "pvs" or "response_time"
+	LowerIsBetter bool     `json:"lowerIsBetter"`
+	Status        string   `json:"status"`     // "ranked", "insufficient_peers" or "no_data"
+	Scope         string   `json:"scope"`      // "state" or "category"; empty unless ranked
+	Value         *float64 `json:"value"`      // The vendor's own value
+	Percentile    *float64 `json:"percentile"` // Share of peers the vendor beats, 0-100
+	PeerCount     int      `json:"peerCount"`  // Cohort size, including the vendor
+	PeerMedian    *float64 `json:"peerMedian"`
 }
 
 // ============================================================================
@@ -198,6 +288,34 @@ type PeriodInquiryData struct {
 	RespondedCount int
 }
 
+// VisibilityMetricsRaw sums a vendor's daily visibility counters
+type VisibilityMetricsRaw struct {
+	Impressions int `db:"impressions"`
+	Views       int `db:"views"`
+	PhoneClicks int `db:"phone_clicks"`
+	EmailClicks int `db:"email_clicks"`
+}
+
+// VendorTrendPointRaw is one bucket of a vendor metric
+type VendorTrendPointRaw struct {
+	Bucket time.Time `db:"bucket"`
+	Value  float64   `db:"value"`
+	Count  int       `db:"count"`
+}
+
+// VendorBenchmarkRaw is one stored benchmark row
+type VendorBenchmarkRaw struct {
+	Metric     string    `db:"metric"`
+	Category   string    `db:"category"`
+	State      string    `db:"state"`
+	Scope      *string   `db:"scope"`
+	Value      float64   `db:"value"`
+	Percentile *float64  `db:"percentile"`
+	PeerCount  *int      `db:"peer_count"`
+	PeerMedian *float64  `db:"peer_median"`
+	ComputedAt time.Time `db:"computed_at"`
+}
+
 // PeriodReviewData contains review data for a time period
 type PeriodReviewData struct {
 	NewReviews    int
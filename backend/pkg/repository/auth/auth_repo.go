@@ -28,6 +28,7 @@ type AuthRepository interface {
 	RecordLoginAttempt(ctx context.Context, email string, success bool) error
 	ClearFailedLoginAttempts(ctx context.Context, email string) error
 	UpdateLastLogin(ctx context.Context, userID uuid.UUID) error
+	RecordUserActivity(ctx context.Context, userID uuid.UUID, day string) error
 
 	BlacklistToken(ctx context.Context, token string, expiry time.Time) error
     IsTokenBlacklisted(ctx context.Context, token string) (bool, error)
@@ -88,4 +88,11 @@ func (r *PostgresAuthRepository) UpdateLastLogin(ctx context.Context, userID uui
 	query := `UPDATE users SET last_login = $1, updated_at = $2 WHERE id = $3`
 	_, err := r.DB.ExecContext(ctx, query, time.Now(), time.Now(), userID)
 	return err
+}
+
+// RecordUserActivity marks the user active on day (YYYY-MM-DD).
+func (r *PostgresAuthRepository) RecordUserActivity(ctx context.Context, userID uuid.UUID, day string) error {
+	query := `INSERT INTO user_activity_daily (day, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	_, err := r.DB.ExecContext(ctx, query, day, userID)
+	return err
 }
\ No newline at end of file
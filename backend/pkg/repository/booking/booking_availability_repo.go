@@ -0,0 +1,124 @@
+// backend/pkg/repository/booking/booking_availability_repo.go
+
+package booking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// UnavailableOnSQL is true when the vendor row aliased "vendors" has a live
+// booking or a blocked day on the date in the given placeholder. The vendor
+// listing's available_on filter uses it too.
+func UnavailableOnSQL(placeholder string) string {
+	return `(
+		EXISTS (
+			SELECT 1 FROM bookings b
+			WHERE b.vendor_id = vendors.id AND b.event_date = ` + placeholder + `::date AND b.status <> 'cancelled'
+		) OR EXISTS (
+			SELECT 1 FROM vendor_blocked_dates bd
+			WHERE bd.vendor_id = vendors.id AND bd.day = ` + placeholder + `::date
+		)
+	)`
+}
+
+// lockCalendar serialises changes to one vendor's calendar for the rest of
+// the transaction
+func lockCalendar(ctx context.Context, tx *sqlx.Tx, vendorID uuid.UUID) error {
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('vendor_calendar:' || $1::text))`, vendorID); err != nil {
+		return fmt.Errorf("failed to lock vendor calendar: %w", err)
+	}
+	return nil
+}
+
+func isDateAvailable(ctx context.Context, q sqlx.QueryerContext, vendorID uuid.UUID, date string) (bool, error) {
+	var unavailable bool
+	query := `SELECT ` + UnavailableOnSQL("$2") + ` FROM vendors WHERE vendors.id = $1`
+	if err := sqlx.GetContext(ctx, q, &unavailable, query, vendorID, date); err != nil {
+		return false, fmt.Errorf("failed to check vendor availability: %w", err)
+	}
+	return !unavailable, nil
+}
+
+// IsDateAvailable reports whether the vendor is free on the date. It doesn't
+// lock; AcceptQuote re-checks under the calendar lock.
+func (r *postgresBookingRepository) IsDateAvailable(ctx context.Context, vendorID uuid.UUID, date string) (bool, error) {
+	return isDateAvailable(ctx, r.db, vendorID, date)
+}
+
+// GetUnavailableDays returns the vendor's booked and blocked days in [from, to],
+// by date. A day that is both booked and blocked appears twice.
+func (r *postgresBookingRepository) GetUnavailableDays(ctx context.Context, vendorID uuid.UUID, from, to string) ([]models.UnavailableDay, error) {
+	query := `
+		SELECT
+			to_char(event_date, 'YYYY-MM-DD') as date,
+			'booked' as status,
+			'' as reason,
+			id as booking_id
+		FROM bookings
+		WHERE vendor_id = $1
+			AND event_date BETWEEN $2::date AND $3::date
+			AND status <> 'cancelled'
+		UNION ALL
+		SELECT
+			to_char(day, 'YYYY-MM-DD'),
+			'blocked',
+			reason,
+			NULL
+		FROM vendor_blocked_dates
+		WHERE vendor_id = $1
+			AND day BETWEEN $2::date AND $3::date
+		ORDER BY date, status
+	`
+
+	var days []models.UnavailableDay
+	if err := r.db.SelectContext(ctx, &days, query, vendorID, from, to); err != nil {
+		return nil, fmt.Errorf("failed to get vendor calendar: %w", err)
+	}
+	return days, nil
+}
+
+// BlockDates marks every day in [from, to] unavailable, updating the reason
+// on days already blocked. It returns how many days were written.
+func (r *postgresBookingRepository) BlockDates(ctx context.Context, vendorID uuid.UUID, from, to, reason string) (int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockCalendar(ctx, tx, vendorID); err != nil {
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO vendor_blocked_dates (vendor_id, day, reason)
+		SELECT $1, d::date, $4
+		FROM generate_series($2::date, $3::date, INTERVAL '1 day') d
+		ON CONFLICT (vendor_id, day) DO UPDATE SET reason = EXCLUDED.reason
+	`, vendorID, from, to, reason)
+	if err != nil {
+		return 0, fmt.Errorf("failed to block dates: %w", err)
+	}
+	blocked, _ := result.RowsAffected()
+
+	return blocked, tx.Commit()
+}
+
+// UnblockDates frees every blocked day in [from, to]. Booked days stay
+// unavailable until the booking is cancelled.
+func (r *postgresBookingRepository) UnblockDates(ctx context.Context, vendorID uuid.UUID, from, to string) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM vendor_blocked_dates
+		WHERE vendor_id = $1 AND day BETWEEN $2::date AND $3::date
+	`, vendorID, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unblock dates: %w", err)
+	}
+	unblocked, _ := result.RowsAffected()
+	return unblocked, nil
+}
@@ -0,0 +1,305 @@
+// backend/pkg/repository/booking/booking_quote_repo.go
+
+package booking
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// quoteColumns reports a sent quote past its validity as expired
+const quoteColumns = `
+	id, inquiry_id, vendor_id, package_id,
+	CASE WHEN status = 'sent' AND valid_until <= NOW() THEN 'expired' ELSE status END as status,
+	to_char(event_date, 'YYYY-MM-DD') as event_date,
+	subtotal_kobo, deposit_kobo, notes, valid_until, created_at, updated_at, responded_at`
+
+const bookingColumns = `
+	id, quote_id, inquiry_id, vendor_id, user_id, guest_id, client_name, client_email,
+	to_char(event_date, 'YYYY-MM-DD') as event_date,
+	total_kobo, deposit_kobo, deposit_status, deposit_paid_at, status, created_at, updated_at`
+
+// ============================================================================
+// QUOTES
+// ============================================================================
+
+// CreateQuote withdraws the inquiry's open quote, if any, and saves the new
+// one with its line items. The inquiry counts as responded to from the
+// first quote.
+func (r *postgresBookingRepository) CreateQuote(ctx context.Context, quote *models.Quote) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Serialise quoting of the same inquiry so two sends can't both pass
+	// the accepted check
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM inquiries WHERE id = $1 FOR UPDATE`, quote.InquiryID); err != nil {
+		return fmt.Errorf("failed to lock inquiry: %w", err)
+	}
+
+	var accepted bool
+	err = tx.GetContext(ctx, &accepted,
+		`SELECT EXISTS (SELECT 1 FROM quotes WHERE inquiry_id = $1 AND status = 'accepted')`, quote.InquiryID)
+	if err != nil {
+		return fmt.Errorf("failed to check accepted quotes: %w", err)
+	}
+	if accepted {
+		return ErrInquiryBooked
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE quotes SET status = 'withdrawn', updated_at = NOW()
+		WHERE inquiry_id = $1 AND status = 'sent'
+	`, quote.InquiryID)
+	if err != nil {
+		return fmt.Errorf("failed to withdraw previous quote: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO quotes (
+			id, inquiry_id, vendor_id, package_id, status, event_date,
+			subtotal_kobo, deposit_kobo, notes, valid_until, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6::date, $7, $8, $9, $10, $11, $12)
+	`,
+		quote.ID, quote.InquiryID, quote.VendorID, quote.PackageID, quote.Status, quote.EventDate,
+		quote.SubtotalKobo, quote.DepositKobo, quote.Notes, quote.ValidUntil, quote.CreatedAt, quote.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create quote: %w", err)
+	}
+
+	for _, item := range quote.LineItems {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO quote_line_items (
+				id, quote_id, position, description, quantity, unit_price_kobo, total_kobo
+			) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`, item.ID, quote.ID, item.Position, item.Description, item.Quantity, item.UnitPriceKobo, item.TotalKobo)
+		if err != nil {
+			return fmt.Errorf("failed to create quote line item: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE inquiries SET responded_at = NOW() WHERE id = $1 AND responded_at IS NULL`, quote.InquiryID)
+	if err != nil {
+		return fmt.Errorf("failed to mark inquiry responded: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *postgresBookingRepository) GetQuote(ctx context.Context, quoteID uuid.UUID) (*models.Quote, error) {
+	var quote models.Quote
+	err := r.db.GetContext(ctx, &quote, `SELECT `+quoteColumns+` FROM quotes WHERE id = $1`, quoteID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrQuoteNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote: %w", err)
+	}
+
+	quotes := []models.Quote{quote}
+	if err := r.attachLineItems(ctx, quotes); err != nil {
+		return nil, err
+	}
+	return &quotes[0], nil
+}
+
+// ListQuotesByInquiry returns every quote sent for an inquiry, newest first
+func (r *postgresBookingRepository) ListQuotesByInquiry(ctx context.Context, inquiryID uuid.UUID) ([]models.Quote, error) {
+	query := `SELECT ` + quoteColumns + `
+		FROM quotes
+		WHERE inquiry_id = $1
+		ORDER BY created_at DESC`
+
+	var quotes []models.Quote
+	if err := r.db.SelectContext(ctx, &quotes, query, inquiryID); err != nil {
+		return nil, fmt.Errorf("failed to list quotes: %w", err)
+	}
+	if err := r.attachLineItems(ctx, quotes); err != nil {
+		return nil, err
+	}
+	return quotes, nil
+}
+
+// attachLineItems loads the line items for all the quotes in one query
+func (r *postgresBookingRepository) attachLineItems(ctx context.Context, quotes []models.Quote) error {
+	if len(quotes) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(quotes))
+	byQuote := make(map[uuid.UUID]int, len(quotes))
+	for i, q := range quotes {
+		ids[i] = q.ID
+		byQuote[q.ID] = i
+		quotes[i].LineItems = []models.QuoteLineItem{}
+	}
+
+	var items []models.QuoteLineItem
+	err := r.db.SelectContext(ctx, &items, `
+		SELECT id, quote_id, position, description, quantity, unit_price_kobo, total_kobo
+		FROM quote_line_items
+		WHERE quote_id = ANY($1)
+		ORDER BY quote_id, position
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to get quote line items: %w", err)
+	}
+
+	for _, item := range items {
+		i := byQuote[item.QuoteID]
+		quotes[i].LineItems = append(quotes[i].LineItems, item)
+	}
+	return nil
+}
+
+// DeclineQuote closes an open quote on the client's behalf
+func (r *postgresBookingRepository) DeclineQuote(ctx context.Context, quoteID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE quotes SET status = 'declined', responded_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = 'sent' AND valid_until > NOW()
+	`, quoteID)
+	if err != nil {
+		return fmt.Errorf("failed to decline quote: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrQuoteNotOpen
+	}
+	return nil
+}
+
+// AcceptQuote marks the quote accepted and records the booking in one
+// transaction. The quote row is locked so it can't be accepted twice or
+// withdrawn mid-way, and the vendor's calendar so two quotes for the same
+// day can't both be accepted.
+func (r *postgresBookingRepository) AcceptQuote(ctx context.Context, quoteID uuid.UUID, booking *models.Booking) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var open bool
+	err = tx.GetContext(ctx, &open, `
+		SELECT status = 'sent' AND valid_until > NOW()
+		FROM quotes
+		WHERE id = $1
+		FOR UPDATE
+	`, quoteID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrQuoteNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock quote: %w", err)
+	}
+	if !open {
+		return ErrQuoteNotOpen
+	}
+
+	if err := lockCalendar(ctx, tx, booking.VendorID); err != nil {
+		return err
+	}
+	available, err := isDateAvailable(ctx, tx, booking.VendorID, booking.EventDate)
+	if err != nil {
+		return err
+	}
+	if !available {
+		return ErrDateUnavailable
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE quotes SET status = 'accepted', responded_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`, quoteID)
+	if err != nil {
+		return fmt.Errorf("failed to accept quote: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO bookings (
+			id, quote_id, inquiry_id, vendor_id, user_id, guest_id, client_name, client_email,
+			event_date, total_kobo, deposit_kobo, deposit_status, status, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::date, $10, $11, $12, $13, $14, $15)
+	`,
+		booking.ID, booking.QuoteID, booking.InquiryID, booking.VendorID, booking.UserID, booking.GuestID,
+		booking.ClientName, booking.ClientEmail, booking.EventDate, booking.TotalKobo, booking.DepositKobo,
+		booking.DepositStatus, booking.Status, booking.CreatedAt, booking.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create booking: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// ============================================================================
+// BOOKINGS
+// ============================================================================
+
+// ListBookings returns a vendor's bookings with event dates in [from, to],
+// soonest first. Either bound may be empty. Live bookings sharing a day are
+// flagged so the vendor can settle the clash.
+func (r *postgresBookingRepository) ListBookings(ctx context.Context, vendorID uuid.UUID, from, to string) ([]models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `,
+			status <> 'cancelled' AND EXISTS (
+				SELECT 1 FROM bookings o
+				WHERE o.vendor_id = b.vendor_id AND o.event_date = b.event_date
+				  AND o.id <> b.id AND o.status <> 'cancelled'
+			) AS clash
+		FROM bookings b
+		WHERE vendor_id = $1
+			AND ($2::text = '' OR event_date >= $2::text::date)
+			AND ($3::text = '' OR event_date <= $3::text::date)
+		ORDER BY event_date ASC, created_at ASC`
+
+	var bookings []models.Booking
+	if err := r.db.SelectContext(ctx, &bookings, query, vendorID, from, to); err != nil {
+		return nil, fmt.Errorf("failed to list bookings: %w", err)
+	}
+	return bookings, nil
+}
+
+func (r *postgresBookingRepository) GetBooking(ctx context.Context, vendorID, bookingID uuid.UUID) (*models.Booking, error) {
+	var booking models.Booking
+	err := r.db.GetContext(ctx, &booking,
+		`SELECT `+bookingColumns+` FROM bookings WHERE id = $1 AND vendor_id = $2`, bookingID, vendorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBookingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking: %w", err)
+	}
+	return &booking, nil
+}
+
+// UpdateBooking saves the booking's status fields, failing with
+// ErrBookingChanged if it was updated since it was read
+func (r *postgresBookingRepository) UpdateBooking(ctx context.Context, booking *models.Booking) error {
+	var updatedAt sql.NullTime
+	err := r.db.GetContext(ctx, &updatedAt, `
+		UPDATE bookings SET
+			status = $3,
+			deposit_status = $4,
+			deposit_paid_at = $5,
+			updated_at = NOW()
+		WHERE id = $1 AND updated_at = $2
+		RETURNING updated_at
+	`, booking.ID, booking.UpdatedAt, booking.Status, booking.DepositStatus, booking.DepositPaidAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrBookingChanged
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update booking: %w", err)
+	}
+	booking.UpdatedAt = updatedAt.Time
+	return nil
+}
@@ -0,0 +1,155 @@
+// backend/pkg/repository/booking/booking_repo.go
+
+package booking
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrPackageNotFound = errors.New("package not found")
+	ErrQuoteNotFound   = errors.New("quote not found")
+	ErrQuoteNotOpen    = errors.New("quote is no longer open")
+	ErrInquiryBooked   = errors.New("inquiry already has an accepted quote")
+	ErrBookingNotFound = errors.New("booking not found")
+	ErrBookingChanged  = errors.New("booking was changed by another request")
+	ErrDateUnavailable = errors.New("vendor is not available on that date")
+)
+
+type BookingRepository interface {
+	GetVendorOwnerID(ctx context.Context, vendorID uuid.UUID) (uuid.UUID, error)
+
+	ListPackages(ctx context.Context, vendorID uuid.UUID) ([]models.VendorPackage, error)
+	GetPackage(ctx context.Context, vendorID, packageID uuid.UUID) (*models.VendorPackage, error)
+	CreatePackage(ctx context.Context, pkg *models.VendorPackage) error
+	UpdatePackage(ctx context.Context, pkg *models.VendorPackage) error
+	DeletePackage(ctx context.Context, vendorID, packageID uuid.UUID) error
+
+	CreateQuote(ctx context.Context, quote *models.Quote) error
+	GetQuote(ctx context.Context, quoteID uuid.UUID) (*models.Quote, error)
+	ListQuotesByInquiry(ctx context.Context, inquiryID uuid.UUID) ([]models.Quote, error)
+	DeclineQuote(ctx context.Context, quoteID uuid.UUID) error
+	AcceptQuote(ctx context.Context, quoteID uuid.UUID, booking *models.Booking) error
+
+	ListBookings(ctx context.Context, vendorID uuid.UUID, from, to string) ([]models.Booking, error)
+	GetBooking(ctx context.Context, vendorID, bookingID uuid.UUID) (*models.Booking, error)
+	UpdateBooking(ctx context.Context, booking *models.Booking) error
+
+	GetUnavailableDays(ctx context.Context, vendorID uuid.UUID, from, to string) ([]models.UnavailableDay, error)
+	IsDateAvailable(ctx context.Context, vendorID uuid.UUID, date string) (bool, error)
+	BlockDates(ctx context.Context, vendorID uuid.UUID, from, to, reason string) (int64, error)
+	UnblockDates(ctx context.Context, vendorID uuid.UUID, from, to string) (int64, error)
+}
+
+type postgresBookingRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresBookingRepository(db *sqlx.DB) BookingRepository {
+	return &postgresBookingRepository{db: db}
+}
+
+// GetVendorOwnerID returns the user who owns the vendor profile
+func (r *postgresBookingRepository) GetVendorOwnerID(ctx context.Context, vendorID uuid.UUID) (uuid.UUID, error) {
+	var ownerID uuid.UUID
+	err := r.db.GetContext(ctx, &ownerID, `SELECT owner_id FROM vendors WHERE id = $1`, vendorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to fetch vendor owner: %w", err)
+	}
+	return ownerID, nil
+}
+
+// ============================================================================
+// PACKAGES
+// ============================================================================
+
+const packageColumns = `
+	id, vendor_id, name, description, price_min_kobo, price_max_kobo,
+	inclusions, created_at, updated_at`
+
+// ListPackages returns a vendor's packages, cheapest first
+func (r *postgresBookingRepository) ListPackages(ctx context.Context, vendorID uuid.UUID) ([]models.VendorPackage, error) {
+	query := `SELECT ` + packageColumns + `
+		FROM vendor_packages
+		WHERE vendor_id = $1
+		ORDER BY price_min_kobo ASC, created_at ASC`
+
+	var packages []models.VendorPackage
+	if err := r.db.SelectContext(ctx, &packages, query, vendorID); err != nil {
+		return nil, fmt.Errorf("failed to list vendor packages: %w", err)
+	}
+	return packages, nil
+}
+
+func (r *postgresBookingRepository) GetPackage(ctx context.Context, vendorID, packageID uuid.UUID) (*models.VendorPackage, error) {
+	query := `SELECT ` + packageColumns + ` FROM vendor_packages WHERE id = $1 AND vendor_id = $2`
+
+	var pkg models.VendorPackage
+	err := r.db.GetContext(ctx, &pkg, query, packageID, vendorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPackageNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor package: %w", err)
+	}
+	return &pkg, nil
+}
+
+func (r *postgresBookingRepository) CreatePackage(ctx context.Context, pkg *models.VendorPackage) error {
+	query := `
+		INSERT INTO vendor_packages (
+			id, vendor_id, name, description, price_min_kobo, price_max_kobo,
+			inclusions, created_at, updated_at
+		) VALUES (
+			:id, :vendor_id, :name, :description, :price_min_kobo, :price_max_kobo,
+			:inclusions, :created_at, :updated_at
+		)
+	`
+	if _, err := r.db.NamedExecContext(ctx, query, pkg); err != nil {
+		return fmt.Errorf("failed to create vendor package: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresBookingRepository) UpdatePackage(ctx context.Context, pkg *models.VendorPackage) error {
+	query := `
+		UPDATE vendor_packages SET
+			name = :name,
+			description = :description,
+			price_min_kobo = :price_min_kobo,
+			price_max_kobo = :price_max_kobo,
+			inclusions = :inclusions,
+			updated_at = :updated_at
+		WHERE id = :id AND vendor_id = :vendor_id
+	`
+	result, err := r.db.NamedExecContext(ctx, query, pkg)
+	if err != nil {
+		return fmt.Errorf("failed to update vendor package: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrPackageNotFound
+	}
+	return nil
+}
+
+func (r *postgresBookingRepository) DeletePackage(ctx context.Context, vendorID, packageID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM vendor_packages WHERE id = $1 AND vendor_id = $2`, packageID, vendorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete vendor package: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrPackageNotFound
+	}
+	return nil
+}
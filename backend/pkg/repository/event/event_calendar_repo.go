@@ -0,0 +1,35 @@
+// backend/pkg/repository/event/event_calendar_repo.go
+
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+// GetTicketHolderEvents lists every event a user holds a live ticket for.
+// Cancelled events stay in the list so calendar apps can mark them cancelled.
+func (r *postgresEventRepository) GetTicketHolderEvents(ctx context.Context, userID uuid.UUID) ([]models.Event, error) {
+	query := `
+		SELECT
+			e.id, e.event_title, e.event_description, e.event_slug, e.event_type,
+			e.venue_name, e.venue_address, e.city, e.state, e.country,
+			e.virtual_platform, e.meeting_link, e.start_date, e.end_date,
+			e.cancelled_at, e.postponed_at, e.updated_at
+		FROM events e
+		WHERE e.is_deleted = false
+		  AND EXISTS (
+			SELECT 1 FROM tickets t
+			WHERE t.event_id = e.id AND t.user_id = $1 AND t.status <> 'canceled'
+		  )
+		ORDER BY e.start_date ASC
+	`
+	events := []models.Event{}
+	if err := r.db.SelectContext(ctx, &events, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to fetch ticket holder events: %w", err)
+	}
+	return events, nil
+}
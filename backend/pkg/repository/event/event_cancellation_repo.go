@@ -0,0 +1,177 @@
+// backend/pkg/repository/event/event_cancellation_repo.go
+
+package event
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ============================================================================
+// CANCELLATION & POSTPONEMENT
+// ============================================================================
+
+// ErrEventAlreadyCancelled is returned when cancelling an event twice
+var ErrEventAlreadyCancelled = errors.New("event is already cancelled")
+
+// CancelEventTx marks an event as cancelled. The row stays so holders can still see it.
+func (r *postgresEventRepository) CancelEventTx(ctx context.Context, tx *sqlx.Tx, eventID uuid.UUID, reason string) error {
+	query := `
+		UPDATE events
+		SET cancelled_at = NOW(), cancellation_reason = $2, updated_at = NOW()
+		WHERE id = $1 AND cancelled_at IS NULL AND is_deleted = false
+	`
+	result, err := tx.ExecContext(ctx, query, eventID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to cancel event: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrEventAlreadyCancelled
+	}
+	return nil
+}
+
+// PostponeEventTx moves an event and opens the refund window for existing holders
+func (r *postgresEventRepository) PostponeEventTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	eventID uuid.UUID,
+	startDate, endDate, refundDeadline time.Time,
+) error {
+	query := `
+		UPDATE events
+		SET start_date = $2, end_date = $3, postponed_at = NOW(), refund_deadline = $4, updated_at = NOW()
+		WHERE id = $1 AND cancelled_at IS NULL AND is_deleted = false
+	`
+	result, err := tx.ExecContext(ctx, query, eventID, startDate, endDate, refundDeadline)
+	if err != nil {
+		return fmt.Errorf("failed to postpone event: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrEventAlreadyCancelled
+	}
+	return nil
+}
+
+// CreateRefundBatchTx queues a refund for every paid order that includes the event.
+// Orders that only contain this event are refunded in full, fees included;
+// mixed orders get back the ticket subtotal for this event.
+func (r *postgresEventRepository) CreateRefundBatchTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	eventID uuid.UUID,
+	reason models.RefundReason,
+) (*models.RefundBatch, error) {
+	batch := &models.RefundBatch{
+		ID:        uuid.New(),
+		EventID:   eventID,
+		Reason:    reason,
+		Status:    "running",
+		CreatedAt: time.Now(),
+	}
+
+	if _, err := tx.NamedExecContext(ctx, `
+		INSERT INTO refund_batches (id, event_id, reason, status, created_at)
+		VALUES (:id, :event_id, :reason, :status, :created_at)`, batch); err != nil {
+		return nil, fmt.Errorf("failed to create refund batch: %w", err)
+	}
+
+	query := `
+		INSERT INTO order_refunds (id, batch_id, order_id, event_id, amount_kobo, reason, status)
+		SELECT
+			gen_random_uuid(), $1, o.id, $2,
+			CASE
+				WHEN NOT EXISTS (SELECT 1 FROM order_items x WHERE x.order_id = o.id AND x.event_id <> $2)
+				THEN o.amount_paid
+				ELSE SUM(oi.subtotal)
+			END,
+			$3, 'pending'
+		FROM orders o
+		JOIN order_items oi ON oi.order_id = o.id AND oi.event_id = $2
+		WHERE o.status = 'success'
+		GROUP BY o.id
+		ON CONFLICT (order_id, event_id) DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, query, batch.ID, eventID, reason); err != nil {
+		return nil, fmt.Errorf("failed to queue refunds: %w", err)
+	}
+	return batch, nil
+}
+
+// QueueHolderEmailsTx puts one email per ticket-holding customer into the outbox.
+// Each payload is the shared payload plus the customer's name and order reference.
+func (r *postgresEventRepository) QueueHolderEmailsTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	eventID uuid.UUID,
+	templateType, subject string,
+	payload map[string]interface{},
+) (int64, error) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode email payload: %w", err)
+	}
+
+	query := `
+		INSERT INTO email_outbox (recipient_email, subject, template_type, payload, status)
+		SELECT DISTINCT ON (o.customer_email)
+			o.customer_email, $2, $3,
+			$4::jsonb || jsonb_build_object('user_name', o.customer_first_name, 'order_ref', o.reference),
+			'pending'
+		FROM orders o
+		WHERE o.status = 'success'
+		  AND EXISTS (SELECT 1 FROM order_items oi WHERE oi.order_id = o.id AND oi.event_id = $1)
+		ORDER BY o.customer_email, o.created_at DESC
+	`
+	result, err := tx.ExecContext(ctx, query, eventID, subject, templateType, string(payloadJSON))
+	if err != nil {
+		return 0, fmt.Errorf("failed to queue holder emails: %w", err)
+	}
+	queued, _ := result.RowsAffected()
+	return queued, nil
+}
+
+// GetRefundProgress summarizes the refunds for an event, with its latest batch
+func (r *postgresEventRepository) GetRefundProgress(ctx context.Context, eventID uuid.UUID) (*models.RefundProgress, error) {
+	query := `
+		SELECT
+			$1::uuid AS event_id,
+			COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE status IN ('pending', 'processing')) AS pending,
+			COUNT(*) FILTER (WHERE status = 'submitted') AS submitted,
+			COUNT(*) FILTER (WHERE status = 'refunded') AS refunded,
+			COUNT(*) FILTER (WHERE status = 'failed') AS failed,
+			COALESCE(SUM(amount_kobo), 0) AS total_kobo,
+			COALESCE(SUM(amount_kobo) FILTER (WHERE status = 'refunded'), 0) AS refunded_kobo
+		FROM order_refunds
+		WHERE event_id = $1
+	`
+	var progress models.RefundProgress
+	if err := r.db.GetContext(ctx, &progress, query, eventID); err != nil {
+		return nil, fmt.Errorf("failed to fetch refund progress: %w", err)
+	}
+
+	var batch models.RefundBatch
+	err := r.db.GetContext(ctx, &batch, `
+		SELECT id, event_id, reason, status, created_at, completed_at
+		FROM refund_batches
+		WHERE event_id = $1
+		ORDER BY created_at DESC
+		LIMIT 1`, eventID)
+	switch {
+	case err == nil:
+		progress.Batch = &batch
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("failed to fetch refund batch: %w", err)
+	}
+
+	return &progress, nil
+}
@@ -0,0 +1,103 @@
+// backend/pkg/repository/event/event_gate_repo.go
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ============================================================================
+// OFFLINE GATE OPERATIONS
+// ============================================================================
+
+// GetGateManifestEntries returns every ticket code issued for an event with its raw status.
+// Status is mapped to the compact manifest code by the service layer.
+func (r *postgresEventRepository) GetGateManifestEntries(ctx context.Context, eventID uuid.UUID) ([]models.GateManifestEntry, error) {
+	query := `
+		SELECT code, status
+		FROM tickets
+		WHERE event_id = $1
+		ORDER BY code ASC
+	`
+
+	var entries []models.GateManifestEntry
+	if err := r.db.SelectContext(ctx, &entries, query, eventID); err != nil {
+		return nil, fmt.Errorf("failed to load gate manifest: %w", err)
+	}
+	return entries, nil
+}
+
+// GetTicketStatesForUpdateTx locks the given tickets for the duration of a sync batch
+// so two devices syncing at once cannot both admit the same ticket.
+func (r *postgresEventRepository) GetTicketStatesForUpdateTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	codes []string,
+) (map[string]models.GateTicketState, error) {
+	result := make(map[string]models.GateTicketState)
+	if len(codes) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT
+			t.id, t.code, t.event_id, t.ticket_tier_id, t.status, t.is_used, t.used_at,
+			t.checked_in_device, t.checked_in_gate, t.is_inside, e.reentry_policy
+		FROM tickets t
+		JOIN events e ON e.id = t.event_id
+		WHERE t.code = ANY($1)
+		ORDER BY t.code
+		FOR UPDATE OF t
+	`
+
+	var states []models.GateTicketState
+	if err := tx.SelectContext(ctx, &states, query, pq.Array(codes)); err != nil {
+		return nil, fmt.Errorf("failed to lock tickets for sync: %w", err)
+	}
+
+	for _, st := range states {
+		result[st.Code] = st
+	}
+	return result, nil
+}
+
+// MarkTicketAsUsedAtTx records an offline admission with the device's scan time.
+// An admission already on the ticket is kept; the scan only puts the holder
+// back inside, which is how an offline re-entry lands.
+func (r *postgresEventRepository) MarkTicketAsUsedAtTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	code string,
+	usedAt time.Time,
+	deviceID, gateID string,
+) error {
+	query := `
+		UPDATE tickets
+		SET is_used = true,
+			status = 'used',
+			used_at = COALESCE(used_at, $2),
+			checked_in_device = COALESCE(checked_in_device, $3),
+			checked_in_gate = COALESCE(checked_in_gate, NULLIF($4, '')),
+			is_inside = true,
+			updated_at = NOW()
+		WHERE code = $1
+		  AND status IN ('active', 'used')
+	`
+	result, err := tx.ExecContext(ctx, query, code, usedAt, deviceID, gateID)
+	if err != nil {
+		return fmt.Errorf("failed to record offline check-in: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("ticket cannot be used: not active")
+	}
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
@@ -25,10 +27,10 @@ func (r *postgresEventRepository) GetEventByID(
 	query := `
 		SELECT 
 			e.id, e.organizer_id, e.event_title, e.event_description, e.event_slug,
-			e.category, e.event_type, e.event_image_url, e.venue_name, e.venue_address,
-			e.city, e.state, e.country, e.virtual_platform, e.meeting_link,
+			e.category, e.event_type, e.event_image_url, e.image_asset_id, e.venue_name, e.venue_address,
+			e.city, e.state, e.country, e.virtual_platform, e.meeting_link, e.latitude, e.longitude,
 			e.start_date, e.end_date, e.max_attendees, e.paystack_subaccount_code,
-			e.tags, e.is_deleted, e.deleted_at, e.created_at, e.updated_at,
+			e.tags, e.reentry_policy, e.series_id, e.recurrence_id, e.cancelled_at, e.cancellation_reason, e.postponed_at, e.refund_deadline, e.status, e.publish_at, e.access_token, e.is_deleted, e.deleted_at, e.created_at, e.updated_at,
 			COALESCE(
 				json_agg(
 					json_build_object(
@@ -57,11 +59,13 @@ func (r *postgresEventRepository) GetEventByID(
 	
 	err := r.db.QueryRowContext(ctx, query, eventID).Scan(
 		&event.ID, &event.OrganizerID, &event.EventTitle, &event.EventDescription,
-		&event.EventSlug, &event.Category, &event.EventType, &event.EventImageURL,
+		&event.EventSlug, &event.Category, &event.EventType, &event.EventImageURL, &event.ImageAssetID,
 		&event.VenueName, &event.VenueAddress, &event.City, &event.State,
-		&event.Country, &event.VirtualPlatform, &event.MeetingLink,
+		&event.Country, &event.VirtualPlatform, &event.MeetingLink, &event.Latitude, &event.Longitude,
 		&event.StartDate, &event.EndDate, &event.MaxAttendees,
-		&event.PaystackSubaccountCode, &tags, &event.IsDeleted,
+		&event.PaystackSubaccountCode, &tags, &event.ReentryPolicy,
+		&event.SeriesID, &event.RecurrenceID, &event.CancelledAt, &event.CancellationReason,
+		&event.PostponedAt, &event.RefundDeadline, &event.Status, &event.PublishAt, &event.AccessToken, &event.IsDeleted,
 		&event.DeletedAt, &event.CreatedAt, &event.UpdatedAt,
 		&ticketTiersJSON,
 	)
@@ -152,13 +156,86 @@ func (r *postgresEventRepository) GetEvents(
 	ctx context.Context,
 	filters EventFilters,
 ) ([]*models.Event, error) {
+	events, _, err := r.queryEvents(ctx, filters)
+	return events, err
+}
+
+// GetEventsPage returns one keyset page and the cursor for the next one.
+// Unlike OFFSET, a cursor doesn't skip or repeat rows when events are added.
+func (r *postgresEventRepository) GetEventsPage(
+	ctx context.Context,
+	filters EventFilters,
+) ([]*models.Event, string, error) {
+	limit := filters.Limit
+	if limit <= 0 {
+		limit = models.DefaultPageLimit
+	}
+	filters.Limit = limit + 1 // one extra row tells us whether there's a next page
+	filters.Offset = 0
+
+	events, keys, err := r.queryEvents(ctx, filters)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(events) <= limit {
+		return events, "", nil
+	}
+
+	events = events[:limit]
+	return events, utils.EncodeCursor(newEventWhere(filters).sortKey(filters), keys[limit-1]...), nil
+}
+
+// CountEvents counts every event matching the filters, ignoring pagination
+func (r *postgresEventRepository) CountEvents(ctx context.Context, filters EventFilters) (int64, error) {
+	w := newEventWhere(filters)
+
+	var total int64
+	query := "SELECT COUNT(*) FROM events e WHERE " + w.clause
+	if err := r.db.QueryRowContext(ctx, query, w.args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to count events: %w", err)
+	}
+	return total, nil
+}
+
+// queryEvents runs the listing query and also returns each row's sort keys
+// so GetEventsPage can build a cursor from the last one
+func (r *postgresEventRepository) queryEvents(
+	ctx context.Context,
+	filters EventFilters,
+) ([]*models.Event, [][]interface{}, error) {
+	w := newEventWhere(filters)
+	sortKey := w.sortKey(filters)
+
+	var sortColumn string
+	switch sortKey {
+	case SortRelevance:
+		sortColumn = w.rankExpr
+	case SortDistance:
+		sortColumn = w.distanceExpr
+	case SortTrending:
+		sortColumn = trendingScoreExpr
+	default:
+		sortColumn = "e.start_date"
+	}
+
+	var extraColumns string
+	if w.distanceExpr != "" {
+		extraColumns += ",\n\t\t\t" + w.distanceExpr + " AS distance_km"
+	}
+	if w.rankExpr != "" {
+		extraColumns += ",\n\t\t\t" + w.rankExpr + " AS rank"
+	}
+	if sortKey == SortTrending {
+		extraColumns += ",\n\t\t\t" + trendingScoreExpr + " AS trending_score"
+	}
+
 	query := `
 		SELECT 
 			e.id, e.organizer_id, e.event_title, e.event_description, e.event_slug,
-			e.category, e.event_type, e.event_image_url, e.venue_name, e.venue_address,
-			e.city, e.state, e.country, e.virtual_platform, e.meeting_link,
+			e.category, e.event_type, e.event_image_url, e.image_asset_id, e.venue_name, e.venue_address,
+			e.city, e.state, e.country, e.virtual_platform, e.meeting_link, e.latitude, e.longitude,
 			e.start_date, e.end_date, e.max_attendees, e.paystack_subaccount_code,
-			e.tags, e.is_deleted, e.deleted_at, e.created_at, e.updated_at,
+			e.tags, e.reentry_policy, e.series_id, e.recurrence_id, e.cancelled_at, e.cancellation_reason, e.postponed_at, e.refund_deadline, e.status, e.publish_at, e.access_token, e.is_deleted, e.deleted_at, e.created_at, e.updated_at,
 			COALESCE(
 				json_agg(
 					json_build_object(
@@ -172,58 +249,36 @@ func (r *postgresEventRepository) GetEvents(
 					) ORDER BY tt.price_kobo ASC
 				) FILTER (WHERE tt.id IS NOT NULL),
 				'[]'
-			) as ticket_tiers
+			) as ticket_tiers` + extraColumns + `
 		FROM events e
 		LEFT JOIN ticket_tiers tt ON e.id = tt.event_id
-		WHERE e.is_deleted = $1
-	`
+		WHERE ` + w.clause
 
-	args := []interface{}{filters.IsDeleted}
-	paramIndex := 2
+	args := w.args
+	paramIndex := len(args) + 1
 
-	// Apply filters
-	if filters.OrganizerID != nil {
-		query += fmt.Sprintf(" AND e.organizer_id = $%d", paramIndex)
-		args = append(args, *filters.OrganizerID)
-		paramIndex++
-	}
-	if filters.Category != nil {
-		query += fmt.Sprintf(" AND e.category = $%d", paramIndex)
-		args = append(args, *filters.Category)
-		paramIndex++
-	}
-	if filters.City != nil {
-		query += fmt.Sprintf(" AND e.city = $%d", paramIndex)
-		args = append(args, *filters.City)
-		paramIndex++
-	}
-	if filters.State != nil {
-		query += fmt.Sprintf(" AND e.state = $%d", paramIndex)
-		args = append(args, *filters.State)
-		paramIndex++
-	}
-	if filters.Country != nil {
-		query += fmt.Sprintf(" AND e.country = $%d", paramIndex)
-		args = append(args, *filters.Country)
-		paramIndex++
+	// Distance ascends; start date and relevance descend. The ID breaks ties
+	// so the row-value comparison below never skips or repeats a row.
+	direction, comparison := "DESC", "<"
+	if sortKey == SortDistance {
+		direction, comparison = "ASC", ">"
 	}
-	if filters.EventType != nil {
-		query += fmt.Sprintf(" AND e.event_type = $%d", paramIndex)
-		args = append(args, *filters.EventType)
-		paramIndex++
-	}
-	if filters.StartDate != nil {
-		query += fmt.Sprintf(" AND e.start_date >= $%d", paramIndex)
-		args = append(args, *filters.StartDate)
-		paramIndex++
-	}
-	if filters.EndDate != nil {
-		query += fmt.Sprintf(" AND e.end_date <= $%d", paramIndex)
-		args = append(args, *filters.EndDate)
-		paramIndex++
+
+	if filters.Cursor != "" {
+		first := utils.CursorNumber
+		if sortKey == sortStartDate {
+			first = utils.CursorTime
+		}
+		after, err := utils.DecodeCursor(filters.Cursor, sortKey, first, utils.CursorUUID)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += fmt.Sprintf(" AND (%s, e.id) %s ($%d, $%d)", sortColumn, comparison, paramIndex, paramIndex+1)
+		args = append(args, after...)
+		paramIndex += 2
 	}
 
-	query += " GROUP BY e.id ORDER BY e.start_date DESC"
+	query += fmt.Sprintf(" GROUP BY e.id ORDER BY %[1]s %[2]s, e.id %[2]s", sortColumn, direction)
 
 	// Apply pagination
 	if filters.Limit > 0 {
@@ -231,49 +286,195 @@ func (r *postgresEventRepository) GetEvents(
 		args = append(args, filters.Limit)
 		paramIndex++
 	}
-	if filters.Offset > 0 {
+	if filters.Offset > 0 && filters.Cursor == "" {
 		query += fmt.Sprintf(" OFFSET $%d", paramIndex)
 		args = append(args, filters.Offset)
 	}
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query events: %w", err)
+		return nil, nil, fmt.Errorf("failed to query events: %w", err)
 	}
 	defer rows.Close()
 
 	var events []*models.Event
+	var keys [][]interface{}
 	for rows.Next() {
 		var event models.Event
 		var ticketTiersJSON []byte
 		var tags pq.StringArray
+		var rank, trendingScore float64
 
-		err := rows.Scan(
+		dest := []interface{}{
 			&event.ID, &event.OrganizerID, &event.EventTitle, &event.EventDescription,
-			&event.EventSlug, &event.Category, &event.EventType, &event.EventImageURL,
+			&event.EventSlug, &event.Category, &event.EventType, &event.EventImageURL, &event.ImageAssetID,
 			&event.VenueName, &event.VenueAddress, &event.City, &event.State,
-			&event.Country, &event.VirtualPlatform, &event.MeetingLink,
+			&event.Country, &event.VirtualPlatform, &event.MeetingLink, &event.Latitude, &event.Longitude,
 			&event.StartDate, &event.EndDate, &event.MaxAttendees,
-			&event.PaystackSubaccountCode, &tags, &event.IsDeleted,
+			&event.PaystackSubaccountCode, &tags, &event.ReentryPolicy,
+		&event.SeriesID, &event.RecurrenceID, &event.CancelledAt, &event.CancellationReason,
+		&event.PostponedAt, &event.RefundDeadline, &event.Status, &event.PublishAt, &event.AccessToken, &event.IsDeleted,
 			&event.DeletedAt, &event.CreatedAt, &event.UpdatedAt,
 			&ticketTiersJSON,
-		)
+		}
+		if w.distanceExpr != "" {
+			dest = append(dest, &event.DistanceKm)
+		}
+		if w.rankExpr != "" {
+			dest = append(dest, &rank)
+		}
+		if sortKey == SortTrending {
+			dest = append(dest, &trendingScore)
+		}
 
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan event: %w", err)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan event: %w", err)
 		}
 
 		if len(ticketTiersJSON) > 0 {
 			if err := json.Unmarshal(ticketTiersJSON, &event.TicketTiers); err != nil {
-				return nil, fmt.Errorf("failed to parse ticket tiers for event %s: %w", event.ID, err)
+				return nil, nil, fmt.Errorf("failed to parse ticket tiers for event %s: %w", event.ID, err)
 			}
 		}
 
 		event.Tags = []string(tags)
 		events = append(events, &event)
+
+		switch sortKey {
+		case SortRelevance:
+			keys = append(keys, []interface{}{rank, event.ID})
+		case SortDistance:
+			keys = append(keys, []interface{}{*event.DistanceKm, event.ID})
+		case SortTrending:
+			keys = append(keys, []interface{}{trendingScore, event.ID})
+		default:
+			keys = append(keys, []interface{}{event.StartDate, event.ID})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to iterate events: %w", err)
 	}
 
-	return events, nil
+	return events, keys, nil
+}
+
+// trendingScoreExpr reads the score the trending materializer last wrote;
+// events it didn't rank sort after those it did. A subquery keeps it valid
+// under GROUP BY e.id.
+const trendingScoreExpr = `COALESCE((SELECT ts.score FROM event_trending_scores ts WHERE ts.event_id = e.id), 0)::float8`
+
+// eventWhere is the filter clause shared by the listing and count queries
+type eventWhere struct {
+	clause       string
+	args         []interface{}
+	distanceExpr string
+	rankExpr     string
+}
+
+// newEventWhere builds the WHERE clause for filters. Cursor, limit and offset
+// are left to the caller.
+func newEventWhere(filters EventFilters) eventWhere {
+	w := eventWhere{
+		clause: "e.is_deleted = $1",
+		args:   []interface{}{filters.IsDeleted},
+	}
+	paramIndex := 2
+
+	// Distance is computed in plain SQL so PostGIS is not required.
+	// Cheap bounding-box prefilter (uses idx_events_lat_lng), then the exact radius.
+	if filters.Near != nil {
+		w.distanceExpr = haversineSQL(fmt.Sprintf("$%d", paramIndex), fmt.Sprintf("$%d", paramIndex+1))
+		minLat, maxLat, minLng, maxLng := utils.BoundingBox(filters.Near.Lat, filters.Near.Lng, filters.RadiusKm)
+		w.clause += fmt.Sprintf(
+			" AND e.latitude BETWEEN $%d AND $%d AND e.longitude BETWEEN $%d AND $%d AND %s <= $%d",
+			paramIndex+2, paramIndex+3, paramIndex+4, paramIndex+5, w.distanceExpr, paramIndex+6)
+		w.args = append(w.args, filters.Near.Lat, filters.Near.Lng, minLat, maxLat, minLng, maxLng, filters.RadiusKm)
+		paramIndex += 7
+	}
+
+	if !filters.IncludeCancelled {
+		w.clause += " AND e.cancelled_at IS NULL"
+	}
+	if filters.Status != nil {
+		w.clause += fmt.Sprintf(" AND e.status = $%d", paramIndex)
+		w.args = append(w.args, *filters.Status)
+		paramIndex++
+	}
+
+	// Apply filters
+	if filters.OrganizerID != nil {
+		w.clause += fmt.Sprintf(" AND e.organizer_id = $%d", paramIndex)
+		w.args = append(w.args, *filters.OrganizerID)
+		paramIndex++
+	}
+	if filters.Category != nil {
+		w.clause += fmt.Sprintf(" AND e.category = $%d", paramIndex)
+		w.args = append(w.args, *filters.Category)
+		paramIndex++
+	}
+	if filters.City != nil {
+		w.clause += fmt.Sprintf(" AND e.city = $%d", paramIndex)
+		w.args = append(w.args, *filters.City)
+		paramIndex++
+	}
+	if filters.State != nil {
+		w.clause += fmt.Sprintf(" AND e.state = $%d", paramIndex)
+		w.args = append(w.args, *filters.State)
+		paramIndex++
+	}
+	if filters.Country != nil {
+		w.clause += fmt.Sprintf(" AND e.country = $%d", paramIndex)
+		w.args = append(w.args, *filters.Country)
+		paramIndex++
+	}
+	if filters.EventType != nil {
+		w.clause += fmt.Sprintf(" AND e.event_type = $%d", paramIndex)
+		w.args = append(w.args, *filters.EventType)
+		paramIndex++
+	}
+	if filters.StartDate != nil {
+		w.clause += fmt.Sprintf(" AND e.start_date >= $%d", paramIndex)
+		w.args = append(w.args, *filters.StartDate)
+		paramIndex++
+	}
+	if filters.EndDate != nil {
+		w.clause += fmt.Sprintf(" AND e.end_date <= $%d", paramIndex)
+		w.args = append(w.args, *filters.EndDate)
+		paramIndex++
+	}
+
+	// Full-text match with a trigram fallback so typos in the title still match.
+	// The rank is float8 so it round-trips through a cursor exactly.
+	if filters.Query != nil && strings.TrimSpace(*filters.Query) != "" {
+		q := fmt.Sprintf("$%d", paramIndex)
+		w.clause += fmt.Sprintf(
+			" AND (e.search_vector @@ websearch_to_tsquery('english', %[1]s) OR %[1]s <%% e.event_title)", q)
+		w.rankExpr = fmt.Sprintf(
+			"(ts_rank_cd(e.search_vector, websearch_to_tsquery('english', %[1]s)) + word_similarity(%[1]s, e.event_title))::float8", q)
+		w.args = append(w.args, strings.TrimSpace(*filters.Query))
+		paramIndex++
+	}
+	if len(filters.Tags) > 0 {
+		w.clause += fmt.Sprintf(" AND ARRAY(SELECT lower(t) FROM unnest(e.tags) t) @> $%d", paramIndex)
+		w.args = append(w.args, pq.Array(filters.Tags))
+	}
+
+	return w
+}
+
+// sortKey resolves the effective ordering; relevance and distance fall back
+// to start date when there is no query or point to sort by
+func (w eventWhere) sortKey(filters EventFilters) string {
+	switch {
+	case filters.SortBy == SortTrending:
+		return SortTrending
+	case filters.SortBy == SortRelevance && w.rankExpr != "":
+		return SortRelevance
+	case filters.SortBy == SortDistance && w.distanceExpr != "":
+		return SortDistance
+	default:
+		return sortStartDate
+	}
 }
 
 // GetEventTicketTiers retrieves all ticket tiers for an event
@@ -322,6 +523,7 @@ func (r *postgresEventRepository) GetTierDetails(
 		WHERE e.id = $1 
 			AND tt.name = $2 
 			AND e.is_deleted = false
+			AND e.status IN ('published', 'unlisted')
 			AND e.end_date > NOW()
 	`
 
@@ -354,6 +556,8 @@ func (r *postgresEventRepository) GetTierDetailsByID(ctx context.Context, tierID
         JOIN ticket_tiers tt ON e.id = tt.event_id
         WHERE tt.id = $1 
           AND e.is_deleted = false
+          AND e.cancelled_at IS NULL
+          AND e.status IN ('published', 'unlisted')
           AND e.end_date > NOW()
     `
     
@@ -426,4 +630,11 @@ func (r *postgresEventRepository) GetEventWithStats(
 	}
 
 	return &stats, nil
-}
\ No newline at end of file
+}
+// haversineSQL is the great-circle distance in km from (lat, lng) to the event venue
+func haversineSQL(lat, lng string) string {
+	return fmt.Sprintf(
+		"(6371 * 2 * asin(sqrt(LEAST(1, power(sin(radians(e.latitude - %[1]s) / 2), 2) + "+
+			"cos(radians(%[1]s)) * cos(radians(e.latitude)) * power(sin(radians(e.longitude - %[2]s) / 2), 2)))))",
+		lat, lng)
+}
@@ -39,10 +39,36 @@ type EventFilters struct {
 	StartDate   *time.Time
 	EndDate     *time.Time
 	IsDeleted   bool
+	// Cancelled occurrences are hidden from public listings
+	IncludeCancelled bool
+	// Status limits results to one lifecycle state; nil means any
+	Status *models.EventStatus
+	// Keyword search over title, tags and description
+	Query *string
+	// Events must carry every one of these tags (case-insensitive)
+	Tags []string
+	// Near limits results to physical events within RadiusKm of a point
+	Near     *models.GeoPoint
+	RadiusKm float64
+	// SortBy is SortRelevance, SortDistance, SortTrending or empty for start_date DESC
+	SortBy string
+	// Cursor resumes after the last row of a previous page (GetEventsPage)
+	Cursor string
 	Limit       int
 	Offset      int
 }
 
+const (
+	// SortRelevance orders search results by match quality; it needs a Query
+	SortRelevance = "relevance"
+	// SortDistance orders by distance from Near; it needs a Near point
+	SortDistance = "distance"
+	// SortTrending orders by the materialized trending score
+	SortTrending = "trending"
+	// sortStartDate is the default, newest start date first
+	sortStartDate = "start_date"
+)
+
 // EventWithStats extends Event with analytics data
 type EventWithStats struct {
 	*models.Event
@@ -59,6 +85,8 @@ type EventRepository interface {
 	// Event CRUD Operations
 	GetEventByID(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID) (*models.Event, error)
 	GetEvents(ctx context.Context, filters EventFilters) ([]*models.Event, error)
+	GetEventsPage(ctx context.Context, filters EventFilters) ([]*models.Event, string, error)
+	CountEvents(ctx context.Context, filters EventFilters) (int64, error)
 	CreateEvent(ctx context.Context, tx *sqlx.Tx, event *models.Event) (uuid.UUID, error)
 	UpdateEvent(ctx context.Context, tx *sqlx.Tx, event *models.Event) error
 	SoftDeleteEvent(ctx context.Context, eventID uuid.UUID) error
@@ -73,6 +101,65 @@ type EventRepository interface {
 
 	MarkTicketAsUsed(ctx context.Context, code string) error
 
+	// Offline Gate Operations
+	GetGateManifestEntries(ctx context.Context, eventID uuid.UUID) ([]models.GateManifestEntry, error)
+	GetTicketStatesForUpdateTx(ctx context.Context, tx *sqlx.Tx, codes []string) (map[string]models.GateTicketState, error)
+	MarkTicketAsUsedAtTx(ctx context.Context, tx *sqlx.Tx, code string, usedAt time.Time, deviceID, gateID string) error
+
+	// Check-in Audit & Re-entry
+	GetTicketForScanTx(ctx context.Context, tx *sqlx.Tx, code string) (*models.GateTicketState, error)
+	AdmitTicketTx(ctx context.Context, tx *sqlx.Tx, ticketID uuid.UUID, deviceID, gateID string) error
+	ExitTicketTx(ctx context.Context, tx *sqlx.Tx, ticketID uuid.UUID) error
+	UndoCheckInTx(ctx context.Context, tx *sqlx.Tx, ticketID uuid.UUID) error
+	InsertTicketScanTx(ctx context.Context, tx *sqlx.Tx, scan *models.TicketScan) error
+	GetCheckInStats(ctx context.Context, eventID uuid.UUID, recentLimit int) (*models.CheckInStats, error)
+
+	// Sessions & Tier Access
+	GetEventSessions(ctx context.Context, eventID uuid.UUID) ([]models.EventSession, error)
+	GetSessionByID(ctx context.Context, sessionID uuid.UUID) (*models.EventSession, error)
+	CreateSession(ctx context.Context, session *models.EventSession) error
+	UpdateSession(ctx context.Context, session *models.EventSession) error
+	DeleteSession(ctx context.Context, eventID, sessionID uuid.UUID) error
+	GetTierAccessRules(ctx context.Context, eventID uuid.UUID) ([]models.TierAccessRule, error)
+	ReplaceTierAccessTx(ctx context.Context, tx *sqlx.Tx, tierID uuid.UUID, sessionIDs []uuid.UUID, days []time.Time) error
+	TierGrantsSessionTx(ctx context.Context, tx *sqlx.Tx, tierID uuid.UUID, session *models.EventSession) (bool, error)
+	GetTierDayAccessTx(ctx context.Context, tx *sqlx.Tx, tierIDs []uuid.UUID) (map[uuid.UUID]models.TierDayAccess, error)
+	GetGateAdmissionsTx(ctx context.Context, tx *sqlx.Tx, ticketIDs []uuid.UUID) (map[uuid.UUID][]models.TicketScan, error)
+	HasSessionAdmissionTx(ctx context.Context, tx *sqlx.Tx, ticketID, sessionID uuid.UUID) (bool, error)
+
+	// Recurring Series
+	CreateSeriesTx(ctx context.Context, tx *sqlx.Tx, series *models.EventSeries) error
+	GetSeriesByID(ctx context.Context, seriesID uuid.UUID) (*models.EventSeries, error)
+	UpdateSeriesTx(ctx context.Context, tx *sqlx.Tx, series *models.EventSeries) error
+	GetSeriesOccurrences(ctx context.Context, seriesID uuid.UUID) ([]models.SeriesOccurrence, error)
+	GetSeriesNeedingMaterialization(ctx context.Context, horizon time.Time) ([]models.EventSeries, error)
+	MarkSeriesCompleteTx(ctx context.Context, tx *sqlx.Tx, seriesID uuid.UUID) error
+
+	// Cancellation & Postponement
+	CancelEventTx(ctx context.Context, tx *sqlx.Tx, eventID uuid.UUID, reason string) error
+	PostponeEventTx(ctx context.Context, tx *sqlx.Tx, eventID uuid.UUID, startDate, endDate, refundDeadline time.Time) error
+	CreateRefundBatchTx(ctx context.Context, tx *sqlx.Tx, eventID uuid.UUID, reason models.RefundReason) (*models.RefundBatch, error)
+	QueueHolderEmailsTx(ctx context.Context, tx *sqlx.Tx, eventID uuid.UUID, templateType, subject string, payload map[string]interface{}) (int64, error)
+	GetRefundProgress(ctx context.Context, eventID uuid.UUID) (*models.RefundProgress, error)
+
+	// Lifecycle
+	UpdateEventStatus(ctx context.Context, eventID uuid.UUID, status models.EventStatus, publishAt *time.Time, accessToken *string) error
+	PublishDueEvents(ctx context.Context) (int64, error)
+
+	// Slugs
+	ReserveSlugTx(ctx context.Context, tx *sqlx.Tx, eventID uuid.UUID, base string) (string, error)
+	RetireSlugTx(ctx context.Context, tx *sqlx.Tx, eventID uuid.UUID, slug string) error
+	ResolveSlug(ctx context.Context, slug string) (uuid.UUID, string, error)
+
+	// Calendar
+	GetTicketHolderEvents(ctx context.Context, userID uuid.UUID) ([]models.Event, error)
+
+	// Trending
+	GetDecayedViewScores(ctx context.Context, halfLife time.Duration, since time.Time) (map[uuid.UUID]float64, error)
+	SnapshotTicketSales(ctx context.Context, retain time.Duration) (map[uuid.UUID]int64, error)
+	GetTicketVelocity(ctx context.Context, window time.Duration) (map[uuid.UUID]float64, error)
+	ReplaceTrendingScores(ctx context.Context, scores []models.TrendingScore) error
+
 	// Stock Management
 	CheckTicketAvailability(ctx context.Context, tierID uuid.UUID, quantity int32) (bool, error) 
     DecrementTicketStockTx(ctx context.Context, tx *sqlx.Tx, tierID uuid.UUID, qty int32) error
@@ -100,6 +187,8 @@ func (r *postgresEventRepository) MarkTicketAsUsed(ctx context.Context, code str
         UPDATE tickets 
         SET is_used = true, 
             status = 'used', 
+            used_at = COALESCE(used_at, NOW()),
+            is_inside = true,
             updated_at = NOW() 
         WHERE code = $1 
           AND is_used = false 
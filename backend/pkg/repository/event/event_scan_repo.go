@@ -0,0 +1,215 @@
+// backend/pkg/repository/event/event_scan_repo.go
+
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ============================================================================
+// CHECK-IN AUDIT & RE-ENTRY
+// ============================================================================
+
+// GetTicketForScanTx locks a single ticket together with its event's re-entry policy
+func (r *postgresEventRepository) GetTicketForScanTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	code string,
+) (*models.GateTicketState, error) {
+	query := `
+		SELECT
+			t.id, t.code, t.event_id, t.ticket_tier_id, t.status, t.is_used, t.used_at,
+			t.checked_in_device, t.checked_in_gate, t.is_inside, e.reentry_policy
+		FROM tickets t
+		JOIN events e ON e.id = t.event_id
+		WHERE t.code = $1
+		FOR UPDATE OF t
+	`
+
+	var state models.GateTicketState
+	if err := tx.GetContext(ctx, &state, query, code); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to load ticket for scan: %w", err)
+	}
+	return &state, nil
+}
+
+// AdmitTicketTx marks a ticket as inside the venue.
+// used_at keeps the time of the first admission so re-entries don't overwrite it.
+func (r *postgresEventRepository) AdmitTicketTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	ticketID uuid.UUID,
+	deviceID, gateID string,
+) error {
+	query := `
+		UPDATE tickets
+		SET is_used = true,
+			status = 'used',
+			is_inside = true,
+			used_at = COALESCE(used_at, NOW()),
+			checked_in_device = COALESCE(checked_in_device, NULLIF($2, '')),
+			checked_in_gate = COALESCE(checked_in_gate, NULLIF($3, '')),
+			updated_at = NOW()
+		WHERE id = $1 AND status <> 'canceled'
+	`
+	result, err := tx.ExecContext(ctx, query, ticketID, deviceID, gateID)
+	if err != nil {
+		return fmt.Errorf("failed to admit ticket: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("ticket cannot be admitted")
+	}
+	return nil
+}
+
+// ExitTicketTx records that a ticket holder left the venue
+func (r *postgresEventRepository) ExitTicketTx(ctx context.Context, tx *sqlx.Tx, ticketID uuid.UUID) error {
+	query := `
+		UPDATE tickets
+		SET is_inside = false,
+			updated_at = NOW()
+		WHERE id = $1 AND is_inside = true
+	`
+	result, err := tx.ExecContext(ctx, query, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to record exit: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("ticket is not checked in")
+	}
+	return nil
+}
+
+// UndoCheckInTx returns a used ticket to the active state
+func (r *postgresEventRepository) UndoCheckInTx(ctx context.Context, tx *sqlx.Tx, ticketID uuid.UUID) error {
+	query := `
+		UPDATE tickets
+		SET is_used = false,
+			status = 'active',
+			is_inside = false,
+			used_at = NULL,
+			checked_in_device = NULL,
+			checked_in_gate = NULL,
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'used'
+	`
+	result, err := tx.ExecContext(ctx, query, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to undo check-in: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("ticket is not checked in")
+	}
+	return nil
+}
+
+// InsertTicketScanTx appends a row to the check-in audit log
+func (r *postgresEventRepository) InsertTicketScanTx(ctx context.Context, tx *sqlx.Tx, scan *models.TicketScan) error {
+	if scan.ID == uuid.Nil {
+		scan.ID = uuid.New()
+	}
+	if scan.ScannedAt.IsZero() {
+		scan.ScannedAt = time.Now()
+	}
+	scan.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO ticket_scans (
+			id, ticket_id, ticket_code, event_id, session_id, direction, result,
+			denial_reason, undo_reason, device_id, gate_id, staff_id, scanned_at, created_at
+		) VALUES (
+			:id, :ticket_id, :ticket_code, :event_id, :session_id, :direction, :result,
+			:denial_reason, :undo_reason, :device_id, :gate_id, :staff_id, :scanned_at, :created_at
+		)
+	`
+	if _, err := tx.NamedExecContext(ctx, query, scan); err != nil {
+		return fmt.Errorf("failed to record ticket scan: %w", err)
+	}
+	return nil
+}
+
+// GetCheckInStats aggregates live admission counts for an event
+func (r *postgresEventRepository) GetCheckInStats(
+	ctx context.Context,
+	eventID uuid.UUID,
+	recentLimit int,
+) (*models.CheckInStats, error) {
+	stats := &models.CheckInStats{EventID: eventID}
+
+	// 1. Ticket state counts
+	ticketQuery := `
+		SELECT
+			COUNT(*) FILTER (WHERE status <> 'canceled') AS total_tickets,
+			COUNT(*) FILTER (WHERE is_used = true) AS checked_in,
+			COUNT(*) FILTER (WHERE is_inside = true) AS currently_inside
+		FROM tickets
+		WHERE event_id = $1
+	`
+	if err := r.db.GetContext(ctx, stats, ticketQuery, eventID); err != nil {
+		return nil, fmt.Errorf("failed to count check-ins: %w", err)
+	}
+	stats.NotArrived = stats.TotalTickets - stats.CheckedIn
+
+	// 2. Scan log summary
+	scanQuery := `
+		SELECT
+			COUNT(*) FILTER (WHERE result = 'denied'),
+			MAX(scanned_at)
+		FROM ticket_scans
+		WHERE event_id = $1
+	`
+	var lastScan sql.NullTime
+	if err := r.db.QueryRowContext(ctx, scanQuery, eventID).Scan(&stats.DeniedScans, &lastScan); err != nil {
+		return nil, fmt.Errorf("failed to summarise scans: %w", err)
+	}
+	if lastScan.Valid {
+		stats.LastScanAt = &lastScan.Time
+	}
+
+	// 3. Admissions per gate
+	gateQuery := `
+		SELECT COALESCE(gate_id, '') AS gate_id, COUNT(*) AS admitted
+		FROM ticket_scans
+		WHERE event_id = $1 AND direction = 'in' AND result = 'granted'
+		GROUP BY COALESCE(gate_id, '')
+		ORDER BY admitted DESC
+	`
+	if err := r.db.SelectContext(ctx, &stats.ByGate, gateQuery, eventID); err != nil {
+		return nil, fmt.Errorf("failed to count admissions by gate: %w", err)
+	}
+
+	// 4. Most recent activity for the live feed
+	recentQuery := `
+		SELECT
+			id, ticket_id, ticket_code, event_id, session_id, direction, result,
+			denial_reason, undo_reason, device_id, gate_id, staff_id, scanned_at, created_at
+		FROM ticket_scans
+		WHERE event_id = $1
+		ORDER BY scanned_at DESC
+		LIMIT $2
+	`
+	if err := r.db.SelectContext(ctx, &stats.RecentScans, recentQuery, eventID, recentLimit); err != nil {
+		return nil, fmt.Errorf("failed to load recent scans: %w", err)
+	}
+
+	if stats.ByGate == nil {
+		stats.ByGate = []models.GateCheckInCount{}
+	}
+	if stats.RecentScans == nil {
+		stats.RecentScans = []models.TicketScan{}
+	}
+
+	return stats, nil
+}
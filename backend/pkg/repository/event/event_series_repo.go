@@ -0,0 +1,116 @@
+// backend/pkg/repository/event/event_series_repo.go
+
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ============================================================================
+// RECURRING EVENT SERIES
+// ============================================================================
+
+const seriesColumns = `
+	id, organizer_id, rrule, dtstart, duration_seconds, offset_seconds,
+	template, materialized_until, created_at, updated_at
+`
+
+// CreateSeriesTx inserts the series definition
+func (r *postgresEventRepository) CreateSeriesTx(ctx context.Context, tx *sqlx.Tx, series *models.EventSeries) error {
+	query := `
+		INSERT INTO event_series (
+			id, organizer_id, rrule, dtstart, duration_seconds, offset_seconds,
+			template, materialized_until, created_at, updated_at
+		) VALUES (
+			:id, :organizer_id, :rrule, :dtstart, :duration_seconds, :offset_seconds,
+			:template, :materialized_until, :created_at, :updated_at
+		)
+	`
+	if _, err := tx.NamedExecContext(ctx, query, series); err != nil {
+		return fmt.Errorf("failed to create event series: %w", err)
+	}
+	return nil
+}
+
+// GetSeriesByID fetches a series definition
+func (r *postgresEventRepository) GetSeriesByID(ctx context.Context, seriesID uuid.UUID) (*models.EventSeries, error) {
+	query := `SELECT ` + seriesColumns + ` FROM event_series WHERE id = $1`
+
+	var series models.EventSeries
+	if err := r.db.GetContext(ctx, &series, query, seriesID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch event series: %w", err)
+	}
+	return &series, nil
+}
+
+// UpdateSeriesTx saves the template, timing and materialization watermark
+func (r *postgresEventRepository) UpdateSeriesTx(ctx context.Context, tx *sqlx.Tx, series *models.EventSeries) error {
+	query := `
+		UPDATE event_series SET
+			duration_seconds = :duration_seconds,
+			offset_seconds = :offset_seconds,
+			template = :template,
+			materialized_until = :materialized_until,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	if _, err := tx.NamedExecContext(ctx, query, series); err != nil {
+		return fmt.Errorf("failed to update event series: %w", err)
+	}
+	return nil
+}
+
+// GetSeriesOccurrences lists all materialized occurrences of a series
+func (r *postgresEventRepository) GetSeriesOccurrences(ctx context.Context, seriesID uuid.UUID) ([]models.SeriesOccurrence, error) {
+	query := `
+		SELECT
+			e.id, e.recurrence_id, e.start_date, e.end_date, e.cancelled_at,
+			COALESCE(SUM(tt.sold), 0) AS tickets_sold
+		FROM events e
+		LEFT JOIN ticket_tiers tt ON tt.event_id = e.id
+		WHERE e.series_id = $1 AND e.is_deleted = false
+		GROUP BY e.id
+		ORDER BY e.recurrence_id ASC
+	`
+	occurrences := []models.SeriesOccurrence{}
+	if err := r.db.SelectContext(ctx, &occurrences, query, seriesID); err != nil {
+		return nil, fmt.Errorf("failed to fetch series occurrences: %w", err)
+	}
+	return occurrences, nil
+}
+
+// GetSeriesNeedingMaterialization returns series whose watermark is behind the horizon
+func (r *postgresEventRepository) GetSeriesNeedingMaterialization(ctx context.Context, horizon time.Time) ([]models.EventSeries, error) {
+	query := `SELECT ` + seriesColumns + `
+		FROM event_series
+		WHERE materialized_until < $1 AND is_complete = false
+		ORDER BY materialized_until ASC
+		LIMIT 100`
+
+	series := []models.EventSeries{}
+	if err := r.db.SelectContext(ctx, &series, query, horizon); err != nil {
+		return nil, fmt.Errorf("failed to fetch series to materialize: %w", err)
+	}
+	return series, nil
+}
+
+// MarkSeriesCompleteTx stops the materializer from revisiting a finished series
+func (r *postgresEventRepository) MarkSeriesCompleteTx(ctx context.Context, tx *sqlx.Tx, seriesID uuid.UUID) error {
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE event_series SET is_complete = true, updated_at = NOW() WHERE id = $1`, seriesID); err != nil {
+		return fmt.Errorf("failed to mark series complete: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+// backend/pkg/repository/event/event_session_repo.go
+
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ErrSessionInUse is returned when deleting a session a ticket tier still grants
+var ErrSessionInUse = errors.New("session is still granted by a ticket tier")
+
+// ============================================================================
+// SESSIONS & TIER ACCESS
+// ============================================================================
+
+const sessionColumns = `
+	id, event_id, title, description, track, room, speakers,
+	start_time, end_time, session_day, created_at, updated_at
+`
+
+// GetEventSessions lists the schedule for an event in chronological order
+func (r *postgresEventRepository) GetEventSessions(ctx context.Context, eventID uuid.UUID) ([]models.EventSession, error) {
+	query := `SELECT ` + sessionColumns + `
+		FROM event_sessions
+		WHERE event_id = $1
+		ORDER BY start_time ASC, title ASC`
+
+	sessions := []models.EventSession{}
+	if err := r.db.SelectContext(ctx, &sessions, query, eventID); err != nil {
+		return nil, fmt.Errorf("failed to fetch event sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// GetSessionByID fetches a single session
+func (r *postgresEventRepository) GetSessionByID(ctx context.Context, sessionID uuid.UUID) (*models.EventSession, error) {
+	query := `SELECT ` + sessionColumns + ` FROM event_sessions WHERE id = $1`
+
+	var session models.EventSession
+	if err := r.db.GetContext(ctx, &session, query, sessionID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch session: %w", err)
+	}
+	return &session, nil
+}
+
+// CreateSession inserts a new session
+func (r *postgresEventRepository) CreateSession(ctx context.Context, session *models.EventSession) error {
+	if session.ID == uuid.Nil {
+		session.ID = uuid.New()
+	}
+
+	query := `
+		INSERT INTO event_sessions (
+			id, event_id, title, description, track, room, speakers,
+			start_time, end_time, session_day, created_at, updated_at
+		) VALUES (
+			:id, :event_id, :title, :description, :track, :room, :speakers,
+			:start_time, :end_time, :session_day, :created_at, :updated_at
+		)
+	`
+	if _, err := r.db.NamedExecContext(ctx, query, session); err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	return nil
+}
+
+// UpdateSession overwrites the editable fields of a session
+func (r *postgresEventRepository) UpdateSession(ctx context.Context, session *models.EventSession) error {
+	query := `
+		UPDATE event_sessions SET
+			title = :title,
+			description = :description,
+			track = :track,
+			room = :room,
+			speakers = :speakers,
+			start_time = :start_time,
+			end_time = :end_time,
+			session_day = :session_day,
+			updated_at = :updated_at
+		WHERE id = :id AND event_id = :event_id
+	`
+	result, err := r.db.NamedExecContext(ctx, query, session)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return utils.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteSession removes a session. It fails with ErrSessionInUse while any
+// tier access rule still points to it.
+func (r *postgresEventRepository) DeleteSession(ctx context.Context, eventID, sessionID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM event_sessions WHERE id = $1 AND event_id = $2`, sessionID, eventID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+			return ErrSessionInUse
+		}
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return utils.ErrNotFound
+	}
+	return nil
+}
+
+// GetTierAccessRules returns all access rules for the tiers of an event
+func (r *postgresEventRepository) GetTierAccessRules(ctx context.Context, eventID uuid.UUID) ([]models.TierAccessRule, error) {
+	query := `
+		SELECT a.tier_id, a.session_id, a.access_date
+		FROM ticket_tier_access a
+		JOIN ticket_tiers tt ON tt.id = a.tier_id
+		WHERE tt.event_id = $1
+		ORDER BY a.tier_id, a.access_date NULLS LAST
+	`
+	rules := []models.TierAccessRule{}
+	if err := r.db.SelectContext(ctx, &rules, query, eventID); err != nil {
+		return nil, fmt.Errorf("failed to fetch tier access rules: %w", err)
+	}
+	return rules, nil
+}
+
+// ReplaceTierAccessTx swaps the access rules of a tier in one go
+func (r *postgresEventRepository) ReplaceTierAccessTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	tierID uuid.UUID,
+	sessionIDs []uuid.UUID,
+	days []time.Time,
+) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM ticket_tier_access WHERE tier_id = $1`, tierID); err != nil {
+		return fmt.Errorf("failed to clear tier access: %w", err)
+	}
+
+	for _, sessionID := range sessionIDs {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO ticket_tier_access (tier_id, session_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+			tierID, sessionID); err != nil {
+			return fmt.Errorf("failed to grant session access: %w", err)
+		}
+	}
+
+	for _, day := range days {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO ticket_tier_access (tier_id, access_date) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+			tierID, day); err != nil {
+			return fmt.Errorf("failed to grant day access: %w", err)
+		}
+	}
+	return nil
+}
+
+// TierGrantsSessionTx reports whether a tier may enter a session.
+// Tiers without any rule are full passes.
+func (r *postgresEventRepository) TierGrantsSessionTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	tierID uuid.UUID,
+	session *models.EventSession,
+) (bool, error) {
+	query := `
+		SELECT
+			NOT EXISTS (SELECT 1 FROM ticket_tier_access WHERE tier_id = $1)
+			OR EXISTS (
+				SELECT 1 FROM ticket_tier_access
+				WHERE tier_id = $1 AND (session_id = $2 OR access_date = $3)
+			)
+	`
+	var granted bool
+	if err := tx.GetContext(ctx, &granted, query, tierID, session.ID, session.SessionDay); err != nil {
+		return false, fmt.Errorf("failed to check session access: %w", err)
+	}
+	return granted, nil
+}
+
+// GetTierDayAccessTx loads the day rules of each tier for gates that scan
+// without a session. Tiers without any rule are full passes.
+func (r *postgresEventRepository) GetTierDayAccessTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	tierIDs []uuid.UUID,
+) (map[uuid.UUID]models.TierDayAccess, error) {
+	access := make(map[uuid.UUID]models.TierDayAccess, len(tierIDs))
+	if len(tierIDs) == 0 {
+		return access, nil
+	}
+
+	var tiers []struct {
+		TierID    uuid.UUID `db:"tier_id"`
+		StartDate time.Time `db:"start_date"`
+		EndDate   time.Time `db:"end_date"`
+	}
+	tierQuery := `
+		SELECT tt.id AS tier_id, e.start_date, e.end_date
+		FROM ticket_tiers tt
+		JOIN events e ON e.id = tt.event_id
+		WHERE tt.id = ANY($1)
+	`
+	if err := tx.SelectContext(ctx, &tiers, tierQuery, pq.Array(tierIDs)); err != nil {
+		return nil, fmt.Errorf("failed to load tiers: %w", err)
+	}
+	for _, tier := range tiers {
+		access[tier.TierID] = models.TierDayAccess{
+			TierID:     tier.TierID,
+			EventStart: tier.StartDate,
+			EventEnd:   tier.EndDate,
+		}
+	}
+
+	var rules []models.TierAccessRule
+	ruleQuery := `
+		SELECT tier_id, session_id, access_date
+		FROM ticket_tier_access
+		WHERE tier_id = ANY($1)
+		ORDER BY tier_id, access_date NULLS LAST
+	`
+	if err := tx.SelectContext(ctx, &rules, ruleQuery, pq.Array(tierIDs)); err != nil {
+		return nil, fmt.Errorf("failed to load tier access rules: %w", err)
+	}
+	for _, rule := range rules {
+		tier := access[rule.TierID]
+		tier.Restricted = true
+		if rule.AccessDate != nil {
+			tier.Days = append(tier.Days, *rule.AccessDate)
+		}
+		access[rule.TierID] = tier
+	}
+	return access, nil
+}
+
+// GetGateAdmissionsTx returns each ticket's granted in/out scans made without
+// a session since its last undo, oldest first, so multi-day passes can be
+// judged one day at a time.
+func (r *postgresEventRepository) GetGateAdmissionsTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	ticketIDs []uuid.UUID,
+) (map[uuid.UUID][]models.TicketScan, error) {
+	admissions := make(map[uuid.UUID][]models.TicketScan, len(ticketIDs))
+	if len(ticketIDs) == 0 {
+		return admissions, nil
+	}
+
+	query := `
+		SELECT
+			s.id, s.ticket_id, s.ticket_code, s.event_id, s.session_id, s.direction, s.result,
+			s.denial_reason, s.undo_reason, s.device_id, s.gate_id, s.staff_id, s.scanned_at, s.created_at
+		FROM ticket_scans s
+		WHERE s.ticket_id = ANY($1)
+		  AND s.session_id IS NULL
+		  AND s.result = 'granted'
+		  AND s.direction IN ('in', 'out')
+		  AND s.scanned_at > COALESCE((
+			SELECT MAX(u.scanned_at) FROM ticket_scans u
+			WHERE u.ticket_id = s.ticket_id AND u.direction = 'undo'
+		  ), '-infinity'::timestamptz)
+		ORDER BY s.ticket_id, s.scanned_at
+	`
+	var scans []models.TicketScan
+	if err := tx.SelectContext(ctx, &scans, query, pq.Array(ticketIDs)); err != nil {
+		return nil, fmt.Errorf("failed to load gate admissions: %w", err)
+	}
+	for _, scan := range scans {
+		admissions[*scan.TicketID] = append(admissions[*scan.TicketID], scan)
+	}
+	return admissions, nil
+}
+
+// HasSessionAdmissionTx reports whether a ticket was already admitted to a session
+// since its last undo.
+func (r *postgresEventRepository) HasSessionAdmissionTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	ticketID, sessionID uuid.UUID,
+) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM ticket_scans s
+			WHERE s.ticket_id = $1
+			  AND s.session_id = $2
+			  AND s.direction = 'in'
+			  AND s.result = 'granted'
+			  AND s.scanned_at > COALESCE((
+				SELECT MAX(u.scanned_at) FROM ticket_scans u
+				WHERE u.ticket_id = $1 AND u.direction = 'undo'
+			  ), '-infinity'::timestamptz)
+		)
+	`
+	var exists bool
+	if err := tx.GetContext(ctx, &exists, query, ticketID, sessionID); err != nil {
+		return false, fmt.Errorf("failed to check session admission: %w", err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,96 @@
+// backend/pkg/repository/event/event_slug_repo.go
+
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ============================================================================
+// SLUGS & REDIRECT HISTORY
+// ============================================================================
+
+// ErrSlugNotFound is returned when a slug matches neither a live event nor its history
+var ErrSlugNotFound = errors.New("no event found for slug")
+
+// ReserveSlugTx picks the first free slug of base, base-2, base-3... for an event.
+// Slugs still held in another event's history are skipped so old links keep
+// pointing where they did. A slug in this event's own history is reclaimed.
+func (r *postgresEventRepository) ReserveSlugTx(ctx context.Context, tx *sqlx.Tx, eventID uuid.UUID, base string) (string, error) {
+	// Serialize reservations of the same base so two creates can't pick the same suffix
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('event_slug:' || $1))`, base); err != nil {
+		return "", fmt.Errorf("failed to lock slug: %w", err)
+	}
+
+	query := `
+		SELECT event_slug FROM events
+		WHERE id <> $2 AND (event_slug = $1 OR event_slug LIKE $1 || '-%')
+		UNION
+		SELECT slug FROM event_slug_history
+		WHERE event_id <> $2 AND (slug = $1 OR slug LIKE $1 || '-%')
+	`
+	var taken []string
+	if err := tx.SelectContext(ctx, &taken, query, base, eventID); err != nil {
+		return "", fmt.Errorf("failed to check slug availability: %w", err)
+	}
+
+	used := make(map[string]bool, len(taken))
+	for _, s := range taken {
+		used[s] = true
+	}
+
+	slug := base
+	for n := 2; used[slug]; n++ {
+		slug = base + "-" + strconv.Itoa(n)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM event_slug_history WHERE slug = $1 AND event_id = $2`, slug, eventID); err != nil {
+		return "", fmt.Errorf("failed to reclaim slug: %w", err)
+	}
+	return slug, nil
+}
+
+// RetireSlugTx keeps a replaced slug so links to it can redirect to the current one
+func (r *postgresEventRepository) RetireSlugTx(ctx context.Context, tx *sqlx.Tx, eventID uuid.UUID, slug string) error {
+	query := `
+		INSERT INTO event_slug_history (slug, event_id)
+		VALUES ($1, $2)
+		ON CONFLICT (slug) DO UPDATE SET event_id = EXCLUDED.event_id, created_at = NOW()
+	`
+	if _, err := tx.ExecContext(ctx, query, slug, eventID); err != nil {
+		return fmt.Errorf("failed to record slug history: %w", err)
+	}
+	return nil
+}
+
+// ResolveSlug maps a current or historical slug to the event and its current slug.
+// The two slugs differ when the caller followed an old link.
+func (r *postgresEventRepository) ResolveSlug(ctx context.Context, slug string) (uuid.UUID, string, error) {
+	query := `
+		SELECT id, event_slug FROM events
+		WHERE event_slug = $1 AND is_deleted = false
+		UNION ALL
+		SELECT e.id, e.event_slug
+		FROM event_slug_history h
+		JOIN events e ON e.id = h.event_id
+		WHERE h.slug = $1 AND e.is_deleted = false
+		LIMIT 1
+	`
+	var eventID uuid.UUID
+	var current sql.NullString
+	if err := r.db.QueryRowContext(ctx, query, slug).Scan(&eventID, &current); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, "", ErrSlugNotFound
+		}
+		return uuid.Nil, "", fmt.Errorf("failed to resolve slug: %w", err)
+	}
+	return eventID, current.String, nil
+}
@@ -0,0 +1,53 @@
+// backend/pkg/repository/event/event_status_repo.go
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+// ============================================================================
+// LIFECYCLE (draft / scheduled / published / unlisted / archived)
+// ============================================================================
+
+// UpdateEventStatus moves an event to a new lifecycle state
+func (r *postgresEventRepository) UpdateEventStatus(
+	ctx context.Context,
+	eventID uuid.UUID,
+	status models.EventStatus,
+	publishAt *time.Time,
+	accessToken *string,
+) error {
+	query := `
+		UPDATE events
+		SET status = $2, publish_at = $3, access_token = $4, updated_at = NOW()
+		WHERE id = $1 AND is_deleted = false
+	`
+	result, err := r.db.ExecContext(ctx, query, eventID, status, publishAt, accessToken)
+	if err != nil {
+		return fmt.Errorf("failed to update event status: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("event not found or already deleted")
+	}
+	return nil
+}
+
+// PublishDueEvents flips scheduled events whose publish time has passed
+func (r *postgresEventRepository) PublishDueEvents(ctx context.Context) (int64, error) {
+	query := `
+		UPDATE events
+		SET status = 'published', updated_at = NOW()
+		WHERE status = 'scheduled' AND publish_at <= NOW() AND is_deleted = false
+	`
+	result, err := r.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to publish scheduled events: %w", err)
+	}
+	return result.RowsAffected()
+}
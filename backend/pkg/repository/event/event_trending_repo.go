@@ -0,0 +1,158 @@
+// backend/pkg/repository/event/event_trending_repo.go
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+// trendingEligible matches the events the trending materializer ranks:
+// public, still on sale and not yet started
+const trendingEligible = `e.is_deleted = false AND e.status = 'published' AND e.cancelled_at IS NULL AND e.start_date > NOW()`
+
+// GetDecayedViewScores sums daily page views from the tracking rollup since
+// the cutoff, halving each day's count per halfLife of age
+func (r *postgresEventRepository) GetDecayedViewScores(ctx context.Context, halfLife time.Duration, since time.Time) (map[uuid.UUID]float64, error) {
+	query := `
+		SELECT event_id,
+		       SUM(total * POWER(0.5, EXTRACT(EPOCH FROM (NOW() - (day::timestamp AT TIME ZONE 'UTC'))) / $1))::float8 AS score
+		FROM event_tracking_daily
+		WHERE event_type = 'view' AND day >= $2::date
+		GROUP BY event_id`
+
+	return r.scoreMap(ctx, "decayed view scores", query, halfLife.Seconds(), since)
+}
+
+// SnapshotTicketSales records tickets sold so far for every eligible event,
+// drops snapshots older than retain, and returns the fresh totals
+func (r *postgresEventRepository) SnapshotTicketSales(ctx context.Context, retain time.Duration) (map[uuid.UUID]int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM event_sales_snapshots WHERE captured_at < NOW() - $1 * INTERVAL '1 second'`,
+		retain.Seconds(),
+	); err != nil {
+		return nil, fmt.Errorf("failed to prune sales snapshots: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		INSERT INTO event_sales_snapshots (event_id, sold, captured_at)
+		SELECT e.id, COALESCE(SUM(tt.sold), 0), NOW()
+		FROM events e
+		LEFT JOIN ticket_tiers tt ON tt.event_id = e.id
+		WHERE `+trendingEligible+`
+		GROUP BY e.id
+		RETURNING event_id, sold`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot ticket sales: %w", err)
+	}
+	defer rows.Close()
+
+	totals := make(map[uuid.UUID]int64)
+	for rows.Next() {
+		var eventID uuid.UUID
+		var sold int64
+		if err := rows.Scan(&eventID, &sold); err != nil {
+			return nil, fmt.Errorf("failed to scan sales snapshot: %w", err)
+		}
+		totals[eventID] = sold
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sales snapshots: %w", err)
+	}
+	rows.Close()
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit sales snapshot: %w", err)
+	}
+	return totals, nil
+}
+
+// GetTicketVelocity returns tickets sold per hour over the window, measured
+// between each event's oldest snapshot inside the window and its latest one.
+// Spans under an hour count as an hour so a single early sale isn't inflated.
+func (r *postgresEventRepository) GetTicketVelocity(ctx context.Context, window time.Duration) (map[uuid.UUID]float64, error) {
+	query := `
+		WITH first_in_window AS (
+			SELECT DISTINCT ON (event_id) event_id, sold, captured_at
+			FROM event_sales_snapshots
+			WHERE captured_at >= NOW() - $1 * INTERVAL '1 second'
+			ORDER BY event_id, captured_at ASC
+		),
+		latest AS (
+			SELECT DISTINCT ON (event_id) event_id, sold, captured_at
+			FROM event_sales_snapshots
+			ORDER BY event_id, captured_at DESC
+		)
+		SELECT l.event_id,
+		       (GREATEST(l.sold - f.sold, 0)
+		        / GREATEST(EXTRACT(EPOCH FROM (l.captured_at - f.captured_at)) / 3600, 1))::float8 AS velocity
+		FROM latest l
+		JOIN first_in_window f ON f.event_id = l.event_id
+		WHERE l.captured_at > f.captured_at`
+
+	return r.scoreMap(ctx, "ticket velocity", query, window.Seconds())
+}
+
+// ReplaceTrendingScores swaps in a freshly computed ranking in one transaction
+// so listings never see a half-written table
+func (r *postgresEventRepository) ReplaceTrendingScores(ctx context.Context, scores []models.TrendingScore) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM event_trending_scores`); err != nil {
+		return fmt.Errorf("failed to clear trending scores: %w", err)
+	}
+
+	now := time.Now()
+	for _, s := range scores {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO event_trending_scores (event_id, score, like_score, ticket_velocity, view_score, computed_at)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			s.EventID, s.Score, s.LikeScore, s.TicketVelocity, s.ViewScore, now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save trending score: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit trending scores: %w", err)
+	}
+	return nil
+}
+
+// scoreMap runs a query yielding (event_id, float8) rows
+func (r *postgresEventRepository) scoreMap(ctx context.Context, what, query string, args ...interface{}) (map[uuid.UUID]float64, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", what, err)
+	}
+	defer rows.Close()
+
+	result := make(map[uuid.UUID]float64)
+	for rows.Next() {
+		var eventID uuid.UUID
+		var v float64
+		if err := rows.Scan(&eventID, &v); err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", what, err)
+		}
+		result[eventID] = v
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate %s: %w", what, err)
+	}
+	return result, nil
+}
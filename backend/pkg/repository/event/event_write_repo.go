@@ -34,11 +34,14 @@ func (r *postgresEventRepository) CreateEvent(
 			event_type, event_image_url, venue_name, venue_address,
 			city, state, country, virtual_platform, meeting_link,
 			start_date, end_date, max_attendees, paystack_subaccount_code,
-			tags, is_deleted, created_at, updated_at
+			tags, reentry_policy, series_id, recurrence_id,
+			is_deleted, created_at, updated_at, latitude, longitude,
+			event_slug, status, publish_at, access_token, image_asset_id
 		) VALUES (
 			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
 			$11, $12, $13, $14, $15, $16, $17, $18, $19,
-			$20, $21, $22
+			$20, $21, $22, $23, $24, $25, $26, $27, $28,
+			$29, $30, $31, $32
 		)
 		RETURNING id
 	`
@@ -66,9 +69,19 @@ func (r *postgresEventRepository) CreateEvent(
 		event.MaxAttendees,
 		event.PaystackSubaccountCode,
 		pq.Array(event.Tags),
+		event.ReentryPolicy,
+		event.SeriesID,
+		event.RecurrenceID,
 		event.IsDeleted,
 		event.CreatedAt,
 		event.UpdatedAt,
+		event.Latitude,
+		event.Longitude,
+		event.EventSlug,
+		event.Status,
+		event.PublishAt,
+		event.AccessToken,
+		event.ImageAssetID,
 	)
 
 	// Scan the result from the query
@@ -246,8 +259,12 @@ func (r *postgresEventRepository) UpdateEvent(
 			max_attendees = $15,
 			tags = $16,
 			event_slug = $17,
-			updated_at = $18
-		WHERE id = $19 AND is_deleted = false
+			reentry_policy = $18,
+			updated_at = $19,
+			latitude = $21,
+			longitude = $22,
+			image_asset_id = $23
+		WHERE id = $20 AND is_deleted = false
 	`
 
 	result, err := tx.ExecContext(ctx, query,
@@ -268,8 +285,12 @@ func (r *postgresEventRepository) UpdateEvent(
 		event.MaxAttendees,
 		pq.Array(event.Tags),
 		event.EventSlug,
+		event.ReentryPolicy,
 		time.Now(),
 		event.ID,
+		event.Latitude,
+		event.Longitude,
+		event.ImageAssetID,
 	)
 
 	if err != nil {
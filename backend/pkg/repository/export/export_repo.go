@@ -0,0 +1,165 @@
+// backend/pkg/repository/export/export_repo.go
+
+package export
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+var ErrJobNotFound = errors.New("export job not found")
+
+type ExportRepository interface {
+	GetEventOrganizerID(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error)
+
+	CreateJob(ctx context.Context, job *models.ExportJob, token string) error
+	GetJob(ctx context.Context, id uuid.UUID) (*models.ExportJob, error)
+	GetJobByToken(ctx context.Context, token string) (*models.ExportJob, error)
+	ClaimJobs(ctx context.Context, limit int, staleBefore time.Time) ([]models.ExportJob, error)
+	CompleteJob(ctx context.Context, id uuid.UUID, content []byte, rowCount int, expiresAt time.Time) error
+	FailJob(ctx context.Context, id uuid.UUID, reason string, maxAttempts int) error
+	PurgeExpiredJobs(ctx context.Context, now time.Time) (int64, error)
+}
+
+type postgresExportRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresExportRepository(db *sqlx.DB) ExportRepository {
+	return &postgresExportRepository{db: db}
+}
+
+// hashToken keeps download tokens out of the table in usable form
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// jobColumns leaves out content so status checks don't drag the file along
+const jobColumns = `
+	id, organizer_id, event_id, kind, format, status, filename, row_count,
+	attempts, last_error, created_at, started_at, completed_at, expires_at`
+
+// GetEventOrganizerID returns the owner of a live event
+func (r *postgresExportRepository) GetEventOrganizerID(ctx context.Context, eventID uuid.UUID) (uuid.UUID, error) {
+	var organizerID uuid.UUID
+	err := r.db.GetContext(ctx, &organizerID,
+		`SELECT organizer_id FROM events WHERE id = $1 AND is_deleted = false`, eventID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, sql.ErrNoRows
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to fetch event owner: %w", err)
+	}
+	return organizerID, nil
+}
+
+func (r *postgresExportRepository) CreateJob(ctx context.Context, job *models.ExportJob, token string) error {
+	query := `
+		INSERT INTO export_jobs (
+			id, organizer_id, event_id, kind, format, status, token_hash,
+			filename, created_at, expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		job.ID, job.OrganizerID, job.EventID, job.Kind, job.Format, job.Status,
+		hashToken(token), job.Filename, job.CreatedAt, job.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create export job: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresExportRepository) GetJob(ctx context.Context, id uuid.UUID) (*models.ExportJob, error) {
+	var job models.ExportJob
+	err := r.db.GetContext(ctx, &job, `SELECT `+jobColumns+` FROM export_jobs WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch export job: %w", err)
+	}
+	return &job, nil
+}
+
+// GetJobByToken loads a job with its file for download
+func (r *postgresExportRepository) GetJobByToken(ctx context.Context, token string) (*models.ExportJob, error) {
+	var job models.ExportJob
+	err := r.db.GetContext(ctx, &job,
+		`SELECT `+jobColumns+`, content FROM export_jobs WHERE token_hash = $1`, hashToken(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch export job: %w", err)
+	}
+	return &job, nil
+}
+
+// ClaimJobs locks a batch of pending jobs for the export worker. Jobs left
+// running since before staleBefore (a worker died mid-export) are retried.
+func (r *postgresExportRepository) ClaimJobs(ctx context.Context, limit int, staleBefore time.Time) ([]models.ExportJob, error) {
+	query := `
+		UPDATE export_jobs
+		SET status = 'running', started_at = NOW(), attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM export_jobs
+			WHERE status = 'pending'
+			   OR (status = 'running' AND started_at < $2)
+			ORDER BY created_at ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + jobColumns
+	jobs := []models.ExportJob{}
+	if err := r.db.SelectContext(ctx, &jobs, query, limit, staleBefore); err != nil {
+		return nil, fmt.Errorf("failed to claim export jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+func (r *postgresExportRepository) CompleteJob(ctx context.Context, id uuid.UUID, content []byte, rowCount int, expiresAt time.Time) error {
+	query := `
+		UPDATE export_jobs
+		SET status = 'done', content = $2, row_count = $3, completed_at = NOW(),
+		    expires_at = $4, last_error = NULL
+		WHERE id = $1
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, content, rowCount, expiresAt); err != nil {
+		return fmt.Errorf("failed to complete export job: %w", err)
+	}
+	return nil
+}
+
+// FailJob puts the job back in the queue, or marks it failed once it has
+// used up its attempts
+func (r *postgresExportRepository) FailJob(ctx context.Context, id uuid.UUID, reason string, maxAttempts int) error {
+	query := `
+		UPDATE export_jobs
+		SET status = CASE WHEN attempts >= $3 THEN 'failed' ELSE 'pending' END,
+		    last_error = $2
+		WHERE id = $1
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, reason, maxAttempts); err != nil {
+		return fmt.Errorf("failed to record export failure: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresExportRepository) PurgeExpiredJobs(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM export_jobs WHERE expires_at < $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge export jobs: %w", err)
+	}
+	return result.RowsAffected()
+}
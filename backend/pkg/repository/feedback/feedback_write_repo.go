@@ -34,6 +34,7 @@ func (r *postgresFeedbackRepository) CreateFeedback(ctx context.Context, feedbac
 			type, 
 			message, 
 			image_url, 
+			image_asset_id, 
 			name, 
 			email, 
 			created_at, 
@@ -46,6 +47,7 @@ func (r *postgresFeedbackRepository) CreateFeedback(ctx context.Context, feedbac
 			:type, 
 			:message, 
 			:image_url, 
+			:image_asset_id, 
 			:name, 
 			:email, 
 			:created_at, 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
@@ -106,4 +107,77 @@ func (r *PostgresInquiryReadRepository) GetByVendorID(ctx context.Context, vendo
 	}
 
 	return inquiries, nil
-}
\ No newline at end of file
+}
+
+// GetPageByVendorID fetches one keyset page of a vendor's inquiries, newest first.
+func (r *PostgresInquiryReadRepository) GetPageByVendorID(ctx context.Context, vendorID uuid.UUID, cursor string, limit int) ([]models.Inquiry, string, error) {
+	query := `
+		SELECT 
+			id, vendor_id, user_id, guest_id, name, email, message, 
+			ip_address, created_at, updated_at, trust_weight
+		FROM inquiries
+		WHERE vendor_id = $1
+	`
+	args := []interface{}{vendorID}
+
+	if cursor != "" {
+		after, err := utils.DecodeCursor(cursor, inquirySortKey, utils.CursorTime, utils.CursorUUID)
+		if err != nil {
+			return nil, "", err
+		}
+		query += " AND (created_at, id) < ($2, $3)"
+		args = append(args, after...)
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args)+1)
+	args = append(args, limit+1)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get inquiries page: %w", err)
+	}
+	defer rows.Close()
+
+	var inquiries []models.Inquiry
+	for rows.Next() {
+		var inquiry models.Inquiry
+		err := rows.Scan(
+			&inquiry.ID,
+			&inquiry.VendorID,
+			&inquiry.UserID,
+			&inquiry.GuestID,
+			&inquiry.Name,
+			&inquiry.Email,
+			&inquiry.Message,
+			&inquiry.IPAddress,
+			&inquiry.CreatedAt,
+			&inquiry.UpdatedAt,
+			&inquiry.TrustWeight,
+		)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan inquiry row: %w", err)
+		}
+		inquiries = append(inquiries, inquiry)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating over inquiry rows: %w", err)
+	}
+
+	if len(inquiries) <= limit {
+		return inquiries, "", nil
+	}
+	inquiries = inquiries[:limit]
+	last := inquiries[limit-1]
+	return inquiries, utils.EncodeCursor(inquirySortKey, last.CreatedAt, last.ID), nil
+}
+
+// CountByVendorID counts all inquiries for a vendor.
+func (r *PostgresInquiryReadRepository) CountByVendorID(ctx context.Context, vendorID uuid.UUID) (int64, error) {
+	var total int64
+	if err := r.DB.GetContext(ctx, &total, "SELECT COUNT(*) FROM inquiries WHERE vendor_id = $1", vendorID); err != nil {
+		return 0, fmt.Errorf("failed to count inquiries: %w", err)
+	}
+	return total, nil
+}
+
+// inquirySortKey tags inquiry cursors so they can't be replayed against another listing.
+const inquirySortKey = "inquiry_created_at"
@@ -13,6 +13,8 @@ import (
 type InquiryReadRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*models.Inquiry, error)
 	GetByVendorID(ctx context.Context, vendorID uuid.UUID) ([]models.Inquiry, error)
+	GetPageByVendorID(ctx context.Context, vendorID uuid.UUID, cursor string, limit int) ([]models.Inquiry, string, error)
+	CountByVendorID(ctx context.Context, vendorID uuid.UUID) (int64, error)
 }
 
 
@@ -20,6 +22,7 @@ type InquiryWriteRepository interface {
 	Create(ctx context.Context, inquiry *models.Inquiry) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	UpdateFields(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error
+	MarkResponded(ctx context.Context, id uuid.UUID) error
 }
 
 type InquiryRepository struct {
@@ -121,4 +121,14 @@ func (r *PostgresInquiryWriteRepository) UpdateFields(ctx context.Context, id uu
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// MarkResponded stamps when the vendor first answered the inquiry. Later
+// replies keep the original time so response times aren't reset.
+func (r *PostgresInquiryWriteRepository) MarkResponded(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE inquiries SET responded_at = NOW() WHERE id = $1 AND responded_at IS NULL`
+	if _, err := r.DB.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark inquiry responded: %w", err)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -153,4 +154,37 @@ func (r *postgresLikeRepository) GetUserLikedEvents(ctx context.Context, eventID
 	}
 
 	return result, nil
-}
\ No newline at end of file
+}
+// GetDecayedLikeScores sums each event's likes since the cutoff, with every
+// like losing half its weight per halfLife of age
+func (r *postgresLikeRepository) GetDecayedLikeScores(ctx context.Context, halfLife time.Duration, since time.Time) (map[uuid.UUID]float64, error) {
+	query := `
+		SELECT event_id,
+		       SUM(POWER(0.5, EXTRACT(EPOCH FROM (NOW() - created_at)) / $1))::float8 AS score
+		FROM likes
+		WHERE created_at >= $2
+		GROUP BY event_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, halfLife.Seconds(), since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get decayed like scores: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[uuid.UUID]float64)
+	for rows.Next() {
+		var eventID uuid.UUID
+		var score float64
+		if err := rows.Scan(&eventID, &score); err != nil {
+			return nil, fmt.Errorf("failed to scan decayed like score: %w", err)
+		}
+		result[eventID] = score
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return result, nil
+}
@@ -4,6 +4,7 @@ package like
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -22,6 +23,9 @@ type LikeRepository interface {
 	// Batch operations
 	GetLikeCountsForEvents(ctx context.Context, eventIDs []uuid.UUID) (map[string]int, error)
 	GetUserLikedEvents(ctx context.Context, eventIDs []uuid.UUID, userID *uuid.UUID, guestID string) (map[string]bool, error)
+
+	// Ranking signals
+	GetDecayedLikeScores(ctx context.Context, halfLife time.Duration, since time.Time) (map[uuid.UUID]float64, error)
 }
 
 // ============================================================================
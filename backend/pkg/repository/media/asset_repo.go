@@ -0,0 +1,64 @@
+// backend/pkg/repository/media/asset_repo.go
+
+package media
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrAssetNotFound is returned when no asset has the given ID
+var ErrAssetNotFound = errors.New("asset not found")
+
+type AssetRepository interface {
+	CreateAsset(ctx context.Context, asset *models.Asset) error
+	GetAssetByID(ctx context.Context, id uuid.UUID) (*models.Asset, error)
+}
+
+type postgresAssetRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresAssetRepository(db *sqlx.DB) AssetRepository {
+	return &postgresAssetRepository{db: db}
+}
+
+func (r *postgresAssetRepository) CreateAsset(ctx context.Context, asset *models.Asset) error {
+	query := `
+		INSERT INTO assets (
+			id, owner_id, guest_id, purpose, content_type,
+			width, height, size_bytes, variants, created_at
+		) VALUES (
+			:id, :owner_id, :guest_id, :purpose, :content_type,
+			:width, :height, :size_bytes, :variants, :created_at
+		)`
+
+	if _, err := r.db.NamedExecContext(ctx, query, asset); err != nil {
+		return fmt.Errorf("failed to create asset: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresAssetRepository) GetAssetByID(ctx context.Context, id uuid.UUID) (*models.Asset, error) {
+	query := `
+		SELECT id, owner_id, guest_id, purpose, content_type,
+		       width, height, size_bytes, variants, created_at
+		FROM assets
+		WHERE id = $1`
+
+	var asset models.Asset
+	err := r.db.GetContext(ctx, &asset, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAssetNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get asset: %w", err)
+	}
+	return &asset, nil
+}
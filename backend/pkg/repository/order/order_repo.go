@@ -37,6 +37,23 @@ type OrderRepository interface {
 	InsertTicketsTx(ctx context.Context, tx *sqlx.Tx, order *models.Order, tickets []models.Ticket) error
 	QueueEmailTx(ctx context.Context, tx *sqlx.Tx, outbox *models.EmailOutbox) error
 	LoadOrderRelations(ctx context.Context, order *models.Order) error
+
+	// Refunds
+	CreateOptInRefund(ctx context.Context, orderID, eventID uuid.UUID) (*models.OrderRefund, error)
+	ClaimPendingRefunds(ctx context.Context, limit int) ([]models.OrderRefund, error)
+	MarkRefundSubmitted(ctx context.Context, refundID uuid.UUID, paystackRefundID string) error
+	RecordRefundOutcome(ctx context.Context, paystackRefundID, transactionRef string, amountKobo int64, status models.RefundStatus, reason string) (*models.OrderRefund, error)
+	GetUnreleasedRefunds(ctx context.Context, limit int) ([]models.OrderRefund, error)
+	ReleaseRefundTx(ctx context.Context, tx *sqlx.Tx, refund *models.OrderRefund) error
+	FailRefund(ctx context.Context, refundID uuid.UUID, reason string, maxAttempts int) error
+	ReclaimStaleRefunds(ctx context.Context, staleAfter time.Duration, maxAttempts int) (int64, error)
+	CompleteFinishedRefundBatches(ctx context.Context) error
+
+	// Exports
+	CountEventAttendees(ctx context.Context, eventID uuid.UUID) (int, error)
+	CountEventOrders(ctx context.Context, eventID uuid.UUID) (int, error)
+	StreamEventAttendees(ctx context.Context, eventID uuid.UUID, fn func(models.AttendeeExportRow) error) error
+	StreamEventOrders(ctx context.Context, eventID uuid.UUID, fn func(models.OrderLedgerRow) error) error
 }
 
 type PostgresOrderRepository struct {
@@ -0,0 +1,124 @@
+// backend/pkg/repository/order/order_repo_exports.go
+
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+// CountEventAttendees counts tickets issued for an event
+func (r *PostgresOrderRepository) CountEventAttendees(ctx context.Context, eventID uuid.UUID) (int, error) {
+	var count int
+	if err := r.DB.GetContext(ctx, &count, `SELECT COUNT(*) FROM tickets WHERE event_id = $1`, eventID); err != nil {
+		return 0, fmt.Errorf("failed to count attendees: %w", err)
+	}
+	return count, nil
+}
+
+// CountEventOrders counts orders with at least one item for the event
+func (r *PostgresOrderRepository) CountEventOrders(ctx context.Context, eventID uuid.UUID) (int, error) {
+	var count int
+	query := `SELECT COUNT(DISTINCT order_id) FROM order_items WHERE event_id = $1`
+	if err := r.DB.GetContext(ctx, &count, query, eventID); err != nil {
+		return 0, fmt.Errorf("failed to count orders: %w", err)
+	}
+	return count, nil
+}
+
+// StreamEventAttendees calls fn for every ticket issued for the event,
+// ordered by holder name. Rows are read one at a time so long lists don't
+// have to fit in memory.
+func (r *PostgresOrderRepository) StreamEventAttendees(
+	ctx context.Context,
+	eventID uuid.UUID,
+	fn func(models.AttendeeExportRow) error,
+) error {
+	query := `
+		SELECT t.code,
+		       o.customer_first_name AS first_name,
+		       o.customer_last_name AS last_name,
+		       o.customer_email AS email,
+		       COALESCE(o.customer_phone, '') AS phone,
+		       COALESCE(tt.name, '') AS tier_name,
+		       t.status,
+		       t.is_used AS checked_in,
+		       t.used_at AS checked_in_at,
+		       o.reference AS order_reference,
+		       o.paid_at AS purchased_at
+		FROM tickets t
+		JOIN orders o ON o.id = t.order_id
+		LEFT JOIN ticket_tiers tt ON tt.id = t.ticket_tier_id
+		WHERE t.event_id = $1
+		ORDER BY LOWER(o.customer_last_name), LOWER(o.customer_first_name), t.code
+	`
+
+	rows, err := r.DB.QueryxContext(ctx, query, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to query attendees: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row models.AttendeeExportRow
+		if err := rows.StructScan(&row); err != nil {
+			return fmt.Errorf("failed to scan attendee: %w", err)
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// StreamEventOrders calls fn for every order with items for the event,
+// oldest first
+func (r *PostgresOrderRepository) StreamEventOrders(
+	ctx context.Context,
+	eventID uuid.UUID,
+	fn func(models.OrderLedgerRow) error,
+) error {
+	query := `
+		SELECT o.reference,
+		       o.status,
+		       o.customer_first_name AS first_name,
+		       o.customer_last_name AS last_name,
+		       o.customer_email AS email,
+		       COALESCE(o.customer_phone, '') AS phone,
+		       SUM(oi.quantity) AS tickets,
+		       SUM(oi.subtotal) AS event_subtotal,
+		       o.service_fee,
+		       o.vat_amount,
+		       o.final_total,
+		       o.amount_paid,
+		       COALESCE(o.payment_channel, '') AS payment_channel,
+		       COALESCE(MAX(rf.status), '') AS refund_status,
+		       o.created_at,
+		       o.paid_at
+		FROM orders o
+		JOIN order_items oi ON oi.order_id = o.id AND oi.event_id = $1
+		LEFT JOIN order_refunds rf ON rf.order_id = o.id AND rf.event_id = $1
+		GROUP BY o.id
+		ORDER BY o.created_at ASC
+	`
+
+	rows, err := r.DB.QueryxContext(ctx, query, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to query orders: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row models.OrderLedgerRow
+		if err := rows.StructScan(&row); err != nil {
+			return fmt.Errorf("failed to scan order: %w", err)
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
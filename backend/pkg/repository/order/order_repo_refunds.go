@@ -0,0 +1,257 @@
+// backend/pkg/repository/order/order_repo_refunds.go
+
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrRefundExists is returned when an order already has a refund for the event
+var ErrRefundExists = errors.New("a refund has already been requested for this order")
+
+// CreateOptInRefund queues a refund a holder requested after a postponement
+func (r *PostgresOrderRepository) CreateOptInRefund(ctx context.Context, orderID, eventID uuid.UUID) (*models.OrderRefund, error) {
+	query := `
+		INSERT INTO order_refunds (id, order_id, event_id, amount_kobo, reason, status)
+		SELECT
+			gen_random_uuid(), o.id, $2,
+			CASE
+				WHEN NOT EXISTS (SELECT 1 FROM order_items x WHERE x.order_id = o.id AND x.event_id <> $2)
+				THEN o.amount_paid
+				ELSE SUM(oi.subtotal)
+			END,
+			'event_postponed', 'pending'
+		FROM orders o
+		JOIN order_items oi ON oi.order_id = o.id AND oi.event_id = $2
+		WHERE o.id = $1 AND o.status = 'success'
+		GROUP BY o.id
+		ON CONFLICT (order_id, event_id) DO NOTHING
+		RETURNING id, batch_id, order_id, event_id, amount_kobo, reason, status,
+			paystack_refund_id, attempts, last_error, created_at, updated_at
+	`
+	var refund models.OrderRefund
+	rows, err := r.DB.QueryxContext(ctx, query, orderID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create refund: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to create refund: %w", err)
+		}
+		return nil, ErrRefundExists
+	}
+	if err := rows.StructScan(&refund); err != nil {
+		return nil, fmt.Errorf("failed to read refund: %w", err)
+	}
+	return &refund, nil
+}
+
+// ClaimPendingRefunds locks a batch of pending refunds for the refund worker
+func (r *PostgresOrderRepository) ClaimPendingRefunds(ctx context.Context, limit int) ([]models.OrderRefund, error) {
+	query := `
+		UPDATE order_refunds r
+		SET status = 'processing', attempts = r.attempts + 1, updated_at = NOW()
+		FROM orders o
+		WHERE o.id = r.order_id
+		  AND r.id IN (
+			SELECT id FROM order_refunds
+			WHERE status = 'pending' AND paystack_refund_id IS NULL
+			ORDER BY created_at ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		  )
+		RETURNING r.id, r.batch_id, r.order_id, r.event_id, o.reference AS order_reference,
+			r.amount_kobo, r.reason, r.status, r.paystack_refund_id, r.attempts,
+			r.last_error, r.created_at, r.updated_at
+	`
+	refunds := []models.OrderRefund{}
+	if err := r.DB.SelectContext(ctx, &refunds, query, limit); err != nil {
+		return nil, fmt.Errorf("failed to claim refunds: %w", err)
+	}
+	return refunds, nil
+}
+
+// MarkRefundSubmitted saves Paystack's acceptance in its own write. Tickets
+// stay live until Paystack reports the refund processed.
+func (r *PostgresOrderRepository) MarkRefundSubmitted(ctx context.Context, refundID uuid.UUID, paystackRefundID string) error {
+	if _, err := r.DB.ExecContext(ctx, `
+		UPDATE order_refunds
+		SET status = 'submitted', paystack_refund_id = $2, last_error = NULL, updated_at = NOW()
+		WHERE id = $1`, refundID, paystackRefundID); err != nil {
+		return fmt.Errorf("failed to mark refund submitted: %w", err)
+	}
+	return nil
+}
+
+// RecordRefundOutcome applies Paystack's final word on a submitted refund,
+// status being refunded or failed. Refunds are matched on Paystack's refund
+// ID, or on the transaction and amount when the webhook carries no ID. It
+// returns the refund updated, or nil if none was waiting on this outcome.
+func (r *PostgresOrderRepository) RecordRefundOutcome(
+	ctx context.Context,
+	paystackRefundID, transactionRef string,
+	amountKobo int64,
+	status models.RefundStatus,
+	reason string,
+) (*models.OrderRefund, error) {
+	query := `
+		UPDATE order_refunds r
+		SET status = $4, last_error = NULLIF($5, ''), updated_at = NOW()
+		FROM orders o
+		WHERE o.id = r.order_id
+		  AND r.id = (
+			SELECT rf.id FROM order_refunds rf
+			JOIN orders ro ON ro.id = rf.order_id
+			WHERE rf.status = 'submitted'
+			  AND (rf.paystack_refund_id = $1
+			       OR ($1 = '' AND ro.reference = $2 AND rf.amount_kobo = $3))
+			ORDER BY rf.updated_at ASC
+			LIMIT 1
+			FOR UPDATE OF rf
+		  )
+		RETURNING r.id, r.batch_id, r.order_id, r.event_id, o.reference AS order_reference,
+			r.amount_kobo, r.reason, r.status, r.paystack_refund_id, r.attempts,
+			r.last_error, r.created_at, r.updated_at
+	`
+	var refund models.OrderRefund
+	err := r.DB.GetContext(ctx, &refund, query, paystackRefundID, transactionRef, amountKobo, status, reason)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to record refund outcome: %w", err)
+	}
+	return &refund, nil
+}
+
+// GetUnreleasedRefunds lists refunded refunds whose tickets are still live
+func (r *PostgresOrderRepository) GetUnreleasedRefunds(ctx context.Context, limit int) ([]models.OrderRefund, error) {
+	query := `
+		SELECT r.id, r.batch_id, r.order_id, r.event_id, o.reference AS order_reference,
+			r.amount_kobo, r.reason, r.status, r.paystack_refund_id, r.attempts,
+			r.last_error, r.created_at, r.updated_at
+		FROM order_refunds r
+		JOIN orders o ON o.id = r.order_id
+		WHERE r.status = 'refunded' AND r.tickets_released_at IS NULL
+		ORDER BY r.updated_at ASC
+		LIMIT $1
+	`
+	refunds := []models.OrderRefund{}
+	if err := r.DB.SelectContext(ctx, &refunds, query, limit); err != nil {
+		return nil, fmt.Errorf("failed to list unreleased refunds: %w", err)
+	}
+	return refunds, nil
+}
+
+// ReleaseRefundTx cancels a refunded refund's tickets and returns their
+// stock to the tiers. A refund already released is left alone.
+func (r *PostgresOrderRepository) ReleaseRefundTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	refund *models.OrderRefund,
+) error {
+	result, err := tx.ExecContext(ctx, `
+		UPDATE order_refunds
+		SET tickets_released_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = 'refunded' AND tickets_released_at IS NULL`, refund.ID)
+	if err != nil {
+		return fmt.Errorf("failed to mark refund released: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return nil
+	}
+
+	releaseQuery := `
+		WITH cancelled AS (
+			UPDATE tickets
+			SET status = 'canceled', is_inside = false, updated_at = NOW()
+			WHERE order_id = $1 AND event_id = $2 AND status <> 'canceled'
+			RETURNING ticket_tier_id
+		)
+		UPDATE ticket_tiers tt
+		SET sold = GREATEST(tt.sold - c.qty, 0), available = tt.available + c.qty, updated_at = NOW()
+		FROM (SELECT ticket_tier_id, COUNT(*)::int AS qty FROM cancelled GROUP BY ticket_tier_id) c
+		WHERE tt.id = c.ticket_tier_id
+	`
+	if _, err := tx.ExecContext(ctx, releaseQuery, refund.OrderID, refund.EventID); err != nil {
+		return fmt.Errorf("failed to cancel refunded tickets: %w", err)
+	}
+
+	// Only once every event's refund adds up to what was paid does the order
+	// itself count as refunded
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE orders SET status = 'refunded', updated_at = NOW()
+		WHERE id = $1
+		  AND amount_paid <= (
+			SELECT COALESCE(SUM(amount_kobo), 0) FROM order_refunds
+			WHERE order_id = $1 AND status = 'refunded'
+		  )`, refund.OrderID); err != nil {
+		return fmt.Errorf("failed to mark order refunded: %w", err)
+	}
+	return nil
+}
+
+// FailRefund puts a refund back in the queue, or gives up after maxAttempts.
+// A refund Paystack already accepted is never requeued.
+func (r *PostgresOrderRepository) FailRefund(ctx context.Context, refundID uuid.UUID, reason string, maxAttempts int) error {
+	query := `
+		UPDATE order_refunds
+		SET status = CASE WHEN attempts >= $3 THEN 'failed' ELSE 'pending' END,
+			last_error = $2, updated_at = NOW()
+		WHERE id = $1 AND status = 'processing' AND paystack_refund_id IS NULL
+	`
+	if _, err := r.DB.ExecContext(ctx, query, refundID, reason, maxAttempts); err != nil {
+		return fmt.Errorf("failed to record refund failure: %w", err)
+	}
+	return nil
+}
+
+// ReclaimStaleRefunds requeues refunds left in processing by a worker that
+// stopped mid-batch. The lost run counts as a failed attempt. Paystack may
+// have accepted the refund before the worker stopped, so the worker looks
+// for it there before sending a requeued refund again.
+func (r *PostgresOrderRepository) ReclaimStaleRefunds(ctx context.Context, staleAfter time.Duration, maxAttempts int) (int64, error) {
+	query := `
+		UPDATE order_refunds
+		SET status = CASE WHEN attempts >= $2 THEN 'failed' ELSE 'pending' END,
+			last_error = 'refund worker stopped before finishing', updated_at = NOW()
+		WHERE status = 'processing'
+		  AND paystack_refund_id IS NULL
+		  AND updated_at < NOW() - $1 * INTERVAL '1 second'
+	`
+	result, err := r.DB.ExecContext(ctx, query, staleAfter.Seconds(), maxAttempts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reclaim stale refunds: %w", err)
+	}
+	reclaimed, _ := result.RowsAffected()
+	return reclaimed, nil
+}
+
+// CompleteFinishedRefundBatches closes batches with nothing left in flight
+func (r *PostgresOrderRepository) CompleteFinishedRefundBatches(ctx context.Context) error {
+	query := `
+		UPDATE refund_batches b
+		SET status = 'completed', completed_at = NOW()
+		WHERE b.status = 'running'
+		  AND NOT EXISTS (
+			SELECT 1 FROM order_refunds r
+			WHERE r.batch_id = b.id
+			  AND (r.status IN ('pending', 'processing', 'submitted')
+			       OR (r.status = 'refunded' AND r.tickets_released_at IS NULL))
+		  )
+	`
+	if _, err := r.DB.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to complete refund batches: %w", err)
+	}
+	return nil
+}
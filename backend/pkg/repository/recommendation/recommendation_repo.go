@@ -0,0 +1,268 @@
+// backend/pkg/repository/recommendation/recommendation_repo.go
+
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type RecommendationRepository interface {
+	// Signals
+	GetInteractions(ctx context.Context, subject models.RecommendationSubject) ([]models.EventInteraction, error)
+	GetCandidateEvents(ctx context.Context, limit int) ([]models.EventFeatures, error)
+	GetCoInteractions(ctx context.Context, candidateEventIDs []uuid.UUID) (models.CoInteractions, error)
+	GetActiveSubjects(ctx context.Context, since time.Time, limit int) ([]string, error)
+
+	// Precomputed results
+	SaveRecommendations(ctx context.Context, subjectKey string, recs []models.ScoredEvent) error
+	GetRecommendations(ctx context.Context, subjectKey string, limit int) ([]models.RecommendedEvent, *time.Time, error)
+	PurgeStaleRecommendations(ctx context.Context, before time.Time) (int64, error)
+}
+
+type postgresRecommendationRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRecommendationRepository(db *sqlx.DB) RecommendationRepository {
+	return &postgresRecommendationRepository{db: db}
+}
+
+// subjectExpr builds the same key as models.RecommendationSubject.Key from a
+// likes or orders row
+const subjectExpr = `COALESCE(%[1]s.user_id::text, 'guest:' || %[1]s.guest_id)`
+
+// interactionsCTE unions likes with successful purchases, one row per
+// (event, subject) signal
+var interactionsCTE = fmt.Sprintf(`
+	interactions AS (
+		SELECT l.event_id, %s AS subject, l.created_at
+		FROM likes l
+		UNION ALL
+		SELECT oi.event_id, %s AS subject, o.created_at
+		FROM orders o
+		JOIN order_items oi ON oi.order_id = o.id
+		WHERE o.status = 'success'
+	)`, fmt.Sprintf(subjectExpr, "l"), fmt.Sprintf(subjectExpr, "o"))
+
+// listedUpcoming limits results to events people can still buy tickets for
+const listedUpcoming = `e.is_deleted = false AND e.status = 'published' AND e.cancelled_at IS NULL AND e.start_date > NOW()`
+
+type featuresRow struct {
+	EventID    uuid.UUID      `db:"event_id"`
+	Category   string         `db:"category"`
+	Tags       pq.StringArray `db:"tags"`
+	City       string         `db:"city"`
+	Popularity float64        `db:"popularity"`
+	Weight     float64        `db:"weight"`
+}
+
+func (r featuresRow) features() models.EventFeatures {
+	return models.EventFeatures{
+		EventID:    r.EventID,
+		Category:   r.Category,
+		Tags:       []string(r.Tags),
+		City:       r.City,
+		Popularity: r.Popularity,
+	}
+}
+
+// GetInteractions returns every event the subject liked (weight 1) or bought
+// tickets for (weight 3), summed per event
+func (r *postgresRecommendationRepository) GetInteractions(ctx context.Context, subject models.RecommendationSubject) ([]models.EventInteraction, error) {
+	likeFilter, orderFilter, arg := "l.user_id = $1", "o.user_id = $1", any(nil)
+	if subject.UserID != nil {
+		arg = *subject.UserID
+	} else {
+		likeFilter, orderFilter, arg = "l.guest_id = $1", "o.guest_id = $1 AND o.user_id IS NULL", subject.GuestID
+	}
+
+	query := fmt.Sprintf(`
+		WITH signals AS (
+			SELECT l.event_id, 1.0 AS weight FROM likes l WHERE %s
+			UNION ALL
+			SELECT oi.event_id, 3.0 AS weight
+			FROM orders o
+			JOIN order_items oi ON oi.order_id = o.id
+			WHERE o.status = 'success' AND %s
+		)
+		SELECT e.id AS event_id, e.category, e.tags, LOWER(COALESCE(e.city, '')) AS city,
+		       0 AS popularity, SUM(s.weight) AS weight
+		FROM signals s
+		JOIN events e ON e.id = s.event_id
+		GROUP BY e.id`, likeFilter, orderFilter)
+
+	var rows []featuresRow
+	if err := r.db.SelectContext(ctx, &rows, query, arg); err != nil {
+		return nil, fmt.Errorf("failed to fetch interactions: %w", err)
+	}
+
+	out := make([]models.EventInteraction, len(rows))
+	for i, row := range rows {
+		out[i] = models.EventInteraction{EventFeatures: row.features(), Weight: row.Weight}
+	}
+	return out, nil
+}
+
+// GetCandidateEvents returns the soonest upcoming public events with a
+// popularity signal (likes in the last 30 days plus tickets sold)
+func (r *postgresRecommendationRepository) GetCandidateEvents(ctx context.Context, limit int) ([]models.EventFeatures, error) {
+	query := `
+		SELECT e.id AS event_id, e.category, e.tags, LOWER(COALESCE(e.city, '')) AS city,
+		       (SELECT COUNT(*) FROM likes l
+		         WHERE l.event_id = e.id AND l.created_at > NOW() - INTERVAL '30 days')
+		       + COALESCE((SELECT SUM(tt.sold) FROM ticket_tiers tt WHERE tt.event_id = e.id), 0) AS popularity,
+		       0 AS weight
+		FROM events e
+		WHERE ` + listedUpcoming + `
+		ORDER BY e.start_date ASC
+		LIMIT $1`
+
+	var rows []featuresRow
+	if err := r.db.SelectContext(ctx, &rows, query, limit); err != nil {
+		return nil, fmt.Errorf("failed to fetch candidate events: %w", err)
+	}
+
+	out := make([]models.EventFeatures, len(rows))
+	for i, row := range rows {
+		out[i] = row.features()
+	}
+	return out, nil
+}
+
+// GetCoInteractions counts, for every event paired with a candidate, how
+// many people engaged with both. One grouped pass serves a whole recompute run.
+func (r *postgresRecommendationRepository) GetCoInteractions(ctx context.Context, candidateEventIDs []uuid.UUID) (models.CoInteractions, error) {
+	pairs := make(models.CoInteractions)
+	if len(candidateEventIDs) == 0 {
+		return pairs, nil
+	}
+
+	query := `
+		WITH ` + interactionsCTE + `,
+		engaged AS (
+			SELECT DISTINCT event_id, subject FROM interactions WHERE subject IS NOT NULL
+		)
+		SELECT seed.event_id AS seed_id, other.event_id, COUNT(*) AS overlap
+		FROM engaged other
+		JOIN engaged seed ON seed.subject = other.subject AND seed.event_id <> other.event_id
+		WHERE other.event_id = ANY($1)
+		GROUP BY seed.event_id, other.event_id`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(candidateEventIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch co-interactions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var seedID, eventID uuid.UUID
+		var overlap int
+		if err := rows.Scan(&seedID, &eventID, &overlap); err != nil {
+			return nil, fmt.Errorf("failed to scan co-interaction: %w", err)
+		}
+		if pairs[seedID] == nil {
+			pairs[seedID] = make(map[uuid.UUID]int)
+		}
+		pairs[seedID][eventID] = overlap
+	}
+	return pairs, rows.Err()
+}
+
+// GetActiveSubjects lists subject keys with likes or purchases since the
+// cutoff, most recently active first
+func (r *postgresRecommendationRepository) GetActiveSubjects(ctx context.Context, since time.Time, limit int) ([]string, error) {
+	query := `
+		WITH ` + interactionsCTE + `
+		SELECT subject FROM interactions
+		WHERE created_at > $1 AND subject IS NOT NULL
+		GROUP BY subject
+		ORDER BY MAX(created_at) DESC
+		LIMIT $2`
+
+	var subjects []string
+	if err := r.db.SelectContext(ctx, &subjects, query, since, limit); err != nil {
+		return nil, fmt.Errorf("failed to fetch active subjects: %w", err)
+	}
+	return subjects, nil
+}
+
+// SaveRecommendations replaces a subject's stored list
+func (r *postgresRecommendationRepository) SaveRecommendations(ctx context.Context, subjectKey string, recs []models.ScoredEvent) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM event_recommendations WHERE subject_key = $1`, subjectKey); err != nil {
+		return fmt.Errorf("failed to clear recommendations: %w", err)
+	}
+
+	now := time.Now()
+	for _, rec := range recs {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO event_recommendations (subject_key, event_id, score, reasons, computed_at)
+			VALUES ($1, $2, $3, $4, $5)`,
+			subjectKey, rec.EventID, rec.Score, pq.Array(rec.Reasons), now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save recommendation: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetRecommendations reads a stored list, dropping events that are no longer
+// listed (cancelled, unpublished or already started)
+func (r *postgresRecommendationRepository) GetRecommendations(ctx context.Context, subjectKey string, limit int) ([]models.RecommendedEvent, *time.Time, error) {
+	query := `
+		SELECT r.event_id, e.event_title, e.event_slug, e.category, e.city, e.venue_name,
+		       e.start_date, e.event_image_url, r.score, r.reasons, r.computed_at
+		FROM event_recommendations r
+		JOIN events e ON e.id = r.event_id
+		WHERE r.subject_key = $1 AND ` + listedUpcoming + `
+		ORDER BY r.score DESC, e.start_date ASC
+		LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, subjectKey, limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch recommendations: %w", err)
+	}
+	defer rows.Close()
+
+	var recs []models.RecommendedEvent
+	var computedAt *time.Time
+	for rows.Next() {
+		var rec models.RecommendedEvent
+		var reasons pq.StringArray
+		var at time.Time
+		if err := rows.Scan(
+			&rec.EventID, &rec.Title, &rec.Slug, &rec.Category, &rec.City, &rec.VenueName,
+			&rec.StartDate, &rec.ImageURL, &rec.Score, &reasons, &at,
+		); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan recommendation: %w", err)
+		}
+		rec.Reasons = []string(reasons)
+		if computedAt == nil {
+			computedAt = &at
+		}
+		recs = append(recs, rec)
+	}
+	return recs, computedAt, rows.Err()
+}
+
+func (r *postgresRecommendationRepository) PurgeStaleRecommendations(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM event_recommendations WHERE computed_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge recommendations: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -7,6 +7,7 @@ import (
 	//"database/sql"
 	"fmt"
 	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
 	"github.com/google/uuid"
 )
 
@@ -16,6 +17,40 @@ func (r *PostgresReviewRepository) GetByVendorID(ctx context.Context, id uuid.UU
 	return reviews, err
 }
 
+// GetPageByVendorID returns one keyset page of a vendor's reviews, newest first
+func (r *PostgresReviewRepository) GetPageByVendorID(ctx context.Context, id uuid.UUID, cursor string, limit int) ([]models.Review, string, error) {
+	query := "SELECT * FROM reviews WHERE vendor_id = $1"
+	args := []interface{}{id}
+
+	if cursor != "" {
+		after, err := utils.DecodeCursor(cursor, reviewSortKey, utils.CursorTime, utils.CursorUUID)
+		if err != nil { return nil, "", err }
+		query += " AND (created_at, id) < ($2, $3)"
+		args = append(args, after...)
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args)+1)
+	args = append(args, limit+1)
+
+	var reviews []models.Review
+	if err := r.DB.SelectContext(ctx, &reviews, query, args...); err != nil {
+		return nil, "", fmt.Errorf("failed to get reviews page: %w", err)
+	}
+	if len(reviews) <= limit { return reviews, "", nil }
+
+	reviews = reviews[:limit]
+	last := reviews[limit-1]
+	return reviews, utils.EncodeCursor(reviewSortKey, last.CreatedAt, last.ID), nil
+}
+
+func (r *PostgresReviewRepository) CountByVendorID(ctx context.Context, id uuid.UUID) (int64, error) {
+	var total int64
+	err := r.DB.GetContext(ctx, &total, "SELECT COUNT(*) FROM reviews WHERE vendor_id = $1", id)
+	return total, err
+}
+
+// reviewSortKey tags review cursors so they can't be replayed against another listing
+const reviewSortKey = "review_created_at"
+
 func (r *PostgresReviewRepository) GetApprovedByVendorID(ctx context.Context, id uuid.UUID) ([]models.Review, error) {
 	var reviews []models.Review
 	err := r.DB.SelectContext(ctx, &reviews, "SELECT * FROM reviews WHERE vendor_id = $1 AND is_approved = TRUE ORDER BY created_at DESC", id)
@@ -19,6 +19,8 @@ type ReviewRepository interface {
 	// Read Operations
 	FindByID(ctx context.Context, id uuid.UUID) (*models.Review, error)
 	GetByVendorID(ctx context.Context, vendorID uuid.UUID) ([]models.Review, error)
+	GetPageByVendorID(ctx context.Context, vendorID uuid.UUID, cursor string, limit int) ([]models.Review, string, error)
+	CountByVendorID(ctx context.Context, vendorID uuid.UUID) (int64, error)
 	GetApprovedByVendorID(ctx context.Context, vendorID uuid.UUID) ([]models.Review, error)
 	GetAverageRating(ctx context.Context, vendorID uuid.UUID) (float64, int64, error)
 }
@@ -0,0 +1,75 @@
+// backend/pkg/repository/tracking/tracking_repo.go
+
+package tracking
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/jmoiron/sqlx"
+)
+
+type TrackingRepository interface {
+	InsertEvent(ctx context.Context, ev *models.TrackingEvent) error
+	// RollupSince rebuilds daily counts for every UTC day from since onwards
+	RollupSince(ctx context.Context, since time.Time) (int64, error)
+	PurgeBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type postgresTrackingRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresTrackingRepository(db *sqlx.DB) TrackingRepository {
+	return &postgresTrackingRepository{db: db}
+}
+
+func (r *postgresTrackingRepository) InsertEvent(ctx context.Context, ev *models.TrackingEvent) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO event_tracking (id, event_id, event_type, user_id, guest_id, order_id, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		ev.ID, ev.EventID, ev.Type, ev.UserID,
+		sql.NullString{String: ev.GuestID, Valid: ev.GuestID != ""},
+		ev.OrderID, ev.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert tracking event: %w", err)
+	}
+	return nil
+}
+
+// RollupSince recounts whole days, so it's safe to run repeatedly over the
+// current day as new rows arrive. Visitors are distinct users or guests.
+func (r *postgresTrackingRepository) RollupSince(ctx context.Context, since time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO event_tracking_daily (event_id, day, event_type, total, visitors, updated_at)
+		SELECT event_id,
+		       (occurred_at AT TIME ZONE 'UTC')::date AS day,
+		       event_type,
+		       COUNT(*),
+		       COUNT(DISTINCT COALESCE(user_id::text, 'guest:' || guest_id)),
+		       NOW()
+		FROM event_tracking
+		WHERE occurred_at >= $1
+		GROUP BY 1, 2, 3
+		ON CONFLICT (event_id, day, event_type) DO UPDATE
+		SET total = EXCLUDED.total, visitors = EXCLUDED.visitors, updated_at = NOW()`,
+		since.UTC().Truncate(24*time.Hour),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to roll up tracking events: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// PurgeBefore drops raw rows that have long since been rolled up
+func (r *postgresTrackingRepository) PurgeBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM event_tracking WHERE occurred_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge tracking events: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -7,9 +7,14 @@ import (
 
 	handleranalytics "github.com/eventify/backend/pkg/handlers/analytics"
 	handlerauth "github.com/eventify/backend/pkg/handlers/auth"
+	handlerbooking "github.com/eventify/backend/pkg/handlers/booking"
 	handlerevent "github.com/eventify/backend/pkg/handlers/event"
+	handlerexport "github.com/eventify/backend/pkg/handlers/export"
 	handlerfeedback "github.com/eventify/backend/pkg/handlers/feedback"
 	handlerinquiries "github.com/eventify/backend/pkg/handlers/inquiries"
+	handlermedia "github.com/eventify/backend/pkg/handlers/media"
+	handlerrecommendation "github.com/eventify/backend/pkg/handlers/recommendation"
+	handlertracking "github.com/eventify/backend/pkg/handlers/tracking"
 	handlerorder "github.com/eventify/backend/pkg/handlers/order"
 	handlerreview "github.com/eventify/backend/pkg/handlers/review"
 	handlervendor "github.com/eventify/backend/pkg/handlers/vendor"
@@ -18,6 +23,7 @@ import (
 
 	repoauth "github.com/eventify/backend/pkg/repository/auth"
 	servicejwt "github.com/eventify/backend/pkg/services/jwt"
+	"github.com/eventify/backend/pkg/services/storage"
 
 	"github.com/eventify/backend/pkg/middleware"
 	"github.com/eventify/backend/pkg/utils"
@@ -43,6 +49,12 @@ func ConfigureRouter(
 	vendorAnalyticsHandler *handlervendor.VendorAnalyticsHandler,
 	jwtService *servicejwt.JWTService,
 	authService auth.AuthService,
+	mediaHandler *handlermedia.MediaHandler,
+	blobStore storage.BlobStore,
+	recommendationHandler *handlerrecommendation.RecommendationHandler,
+	trackingHandler *handlertracking.TrackingHandler,
+	exportHandler *handlerexport.ExportHandler,
+	bookingHandler *handlerbooking.BookingHandler,
 ) *gin.Engine {
 
 	utils.LogInfo(serviceName, "configure", "Initializing router configuration")
@@ -99,16 +111,20 @@ func ConfigureRouter(
 	orderRoutes := router.Group("/api/orders")
 	orderRoutes.Use(middleware.RateLimit(utils.WriteLimiter))
 	{
-		orderRoutes.Use(middleware.OptionalAuth(jwtService))
+		orderRoutes.Use(middleware.OptionalAuth(jwtService, authService))
 		orderRoutes.POST("/initialize", orderHandler.InitializeOrder)
+		orderRoutes.POST("/:reference/refunds", orderHandler.RequestEventRefund)
 	}
 
 	router.POST("/api/webhooks/paystack", orderHandler.HandlePaystackWebhook)
 
 	vendorPublic := router.Group("/api/v1/vendors")
+	// guest_id or the signed-in user dedupes profile views, list impressions and contact reveals
+	vendorPublic.Use(middleware.OptionalAuth(jwtService, authService), middleware.GuestMiddleware())
 	{
 		vendorPublic.GET("", vendorHandler.ListVendors)
 		vendorPublic.GET("/:id", vendorHandler.GetVendorProfile)
+		vendorPublic.POST("/:id/contact-reveal", middleware.RateLimit(utils.WriteLimiter), vendorHandler.RecordContactReveal)
 	}
 
 	vendorProtected := router.Group("/api/v1/vendors")
@@ -122,33 +138,96 @@ func ConfigureRouter(
 	vendorAnalytics.Use(middleware.AuthMiddleware(authService))
 	{
 		vendorAnalytics.GET("/overview", vendorAnalyticsHandler.GetVendorAnalytics)
+		vendorAnalytics.GET("/export", vendorAnalyticsHandler.ExportVendorAnalytics)
+		vendorAnalytics.GET("/trends", vendorAnalyticsHandler.GetVendorTrendsDetailed)
+		vendorAnalytics.GET("/benchmarks", vendorAnalyticsHandler.GetVendorComparativeAnalytics)
 	}
 
-	RegisterReviewRoutes(router, reviewHandler, jwtService)
-	RegisterInquiryRoutes(router, inquiryHandler, jwtService)
+	RegisterReviewRoutes(router, reviewHandler, authService, jwtService)
+	RegisterInquiryRoutes(router, inquiryHandler, authService, jwtService)
+	RegisterBookingRoutes(router, bookingHandler, authService, jwtService)
 
 	router.POST("/api/v1/feedback", middleware.RateLimit(utils.WriteLimiter), feedbackHandler.CreateFeedback)
 
+	// Image uploads: organizers and vendors must be signed in; feedback images may come from guests
+	mediaRoutes := router.Group("/api/v1/media")
+	{
+		mediaRoutes.POST("/images",
+			middleware.RateLimit(utils.WriteLimiter),
+			middleware.OptionalAuth(jwtService, authService),
+			mediaHandler.UploadImage,
+		)
+		mediaRoutes.GET("/:assetId", mediaHandler.GetAsset)
+	}
+	if local, ok := blobStore.(*storage.FilesystemStore); ok {
+		router.Static(local.ServePath, local.Root)
+	}
+
+	// Personalized picks; guests are keyed by their guest_id cookie
+	router.GET("/api/v1/me/recommendations",
+		middleware.AuthMiddleware(authService),
+		recommendationHandler.GetMyRecommendations,
+	)
+	router.GET("/api/v1/recommendations",
+		middleware.OptionalAuth(jwtService, authService),
+		recommendationHandler.GetGuestRecommendations,
+	)
+
+	// Funnel steps only the browser sees; views, checkouts and purchases are tracked server-side
+	router.POST("/api/v1/track",
+		middleware.RateLimit(utils.PublicLimiter),
+		middleware.OptionalAuth(jwtService, authService),
+		trackingHandler.TrackEvent,
+	)
+
+	// Background exports: owners poll the job; the download token is the credential
+	router.GET("/api/v1/exports/:jobId", middleware.AuthMiddleware(authService), exportHandler.GetExportJob)
+	router.GET("/api/v1/exports/download/:token", middleware.RateLimit(utils.PublicLimiter), exportHandler.DownloadExport)
+
 	publicEvents := router.Group("/events")
 	{
 		publicEvents.GET("", eventHandler.GetAllEvents)
+		publicEvents.GET("/by-slug/:slug", eventHandler.GetPublicEventBySlug)
 		publicEvents.GET("/:eventId", eventHandler.GetPublicEventByID)
+		publicEvents.GET("/:eventId/sessions", eventHandler.GetEventSessions)
+		publicEvents.GET("/:eventId/calendar.ics", eventHandler.GetEventCalendar)
+		publicEvents.GET("/:eventId/calendar-links", eventHandler.GetCalendarLinks)
 		publicEvents.POST("/:eventId/like",
 			middleware.RateLimit(utils.WriteLimiter),
-			middleware.OptionalAuth(jwtService),
+			middleware.OptionalAuth(jwtService, authService),
 			eventHandler.ToggleLike,
 		)
 	}
+	router.GET("/calendar/feed/:token", eventHandler.GetCalendarFeedByToken)
+
 //router.PUT("/api/events/:eventId", eventHandler.UpdateEvent)
 	protectedEvents := router.Group("/api/events")
 	protectedEvents.Use(middleware.AuthMiddleware(authService))
 	{
 		protectedEvents.POST("/create", middleware.RateLimit(utils.WriteLimiter), eventHandler.CreateEvent)
 		protectedEvents.GET("/my-events", eventHandler.GetUserEvents)
+		protectedEvents.GET("/my-tickets/calendar.ics", eventHandler.GetMyCalendarFeed)
+		protectedEvents.GET("/my-tickets/calendar-url", eventHandler.GetMyCalendarFeedURL)
+		protectedEvents.POST("/series", middleware.RateLimit(utils.WriteLimiter), eventHandler.CreateEventSeries)
+		protectedEvents.GET("/series/:seriesId", eventHandler.GetEventSeries)
+		protectedEvents.GET("/analytics/dashboard", analyticsHandler.FetchOrganizerDashboard)
 		protectedEvents.GET("/:eventId", eventHandler.GetEventByID)
 		protectedEvents.PUT("/:eventId", middleware.RateLimit(utils.WriteLimiter), eventHandler.UpdateEvent)
 		protectedEvents.DELETE("/:eventId", eventHandler.DeleteEvent)
+		protectedEvents.PATCH("/:eventId/status", middleware.RateLimit(utils.WriteLimiter), eventHandler.ChangeEventStatus)
+		protectedEvents.POST("/:eventId/cancel-occurrence", eventHandler.CancelOccurrence)
+		protectedEvents.POST("/:eventId/cancel", middleware.RateLimit(utils.WriteLimiter), eventHandler.CancelEvent)
+		protectedEvents.POST("/:eventId/postpone", middleware.RateLimit(utils.WriteLimiter), eventHandler.PostponeEvent)
+		protectedEvents.GET("/:eventId/refunds", eventHandler.GetRefundProgress)
 		protectedEvents.GET("/:eventId/analytics", analyticsHandler.FetchEventAnalytics)
+		protectedEvents.GET("/:eventId/analytics/timeline", analyticsHandler.FetchEventTimeline)
+		protectedEvents.GET("/:eventId/analytics/export", analyticsHandler.ExportEventAnalytics)
+		protectedEvents.GET("/:eventId/check-ins/stats", eventHandler.GetCheckInStats)
+		protectedEvents.POST("/:eventId/check-ins/:code/undo", eventHandler.UndoCheckIn)
+		protectedEvents.POST("/:eventId/sessions", middleware.RateLimit(utils.WriteLimiter), eventHandler.CreateSession)
+		protectedEvents.PUT("/:eventId/sessions/:sessionId", middleware.RateLimit(utils.WriteLimiter), eventHandler.UpdateSession)
+		protectedEvents.DELETE("/:eventId/sessions/:sessionId", eventHandler.DeleteSession)
+		protectedEvents.PUT("/:eventId/tiers/:tierId/access", middleware.RateLimit(utils.WriteLimiter), eventHandler.SetTierAccess)
 	}
 
 	// --- TICKET GATE ROUTES ---
@@ -157,11 +236,15 @@ func ConfigureRouter(
     gateRoutes.Use(middleware.AuthMiddleware(authService), middleware.RateLimit(utils.WriteLimiter))
     {
         // POST /api/v1/gate/check-in
-        // Body: { "code": "REF-001-SIGNATURE" }
+        // Body: { "code": "REF-001-SIGNATURE", "direction": "in|out", "deviceId": "...", "gateId": "..." }
         gateRoutes.POST("/check-in", eventHandler.CheckIn) 
+
+        // Offline scanning: download manifest before doors open, upload logs when back online
+        gateRoutes.GET("/events/:eventId/manifest", eventHandler.GetGateManifest)
+        gateRoutes.POST("/sync", eventHandler.SyncGateScans)
     }
 
-setupAdminRoutes(router, authHandler, eventHandler, vendorHandler, reviewHandler, inquiryHandler, feedbackHandler, authRepo, authService)
+setupAdminRoutes(router, authHandler, eventHandler, vendorHandler, reviewHandler, inquiryHandler, feedbackHandler, analyticsHandler, authRepo, authService)
 	utils.LogSuccess(serviceName, "configure", "Router configuration completed")
 	printRegisteredRoutes(router)
 	
@@ -176,6 +259,7 @@ func setupAdminRoutes(
     rh *handlerreview.ReviewHandler,
     ih *handlerinquiries.InquiryHandler,
     fh *handlerfeedback.FeedbackHandler,
+    anh *handleranalytics.AnalyticsHandler,
     repo repoauth.AuthRepository,
     // Change this line:
     authService auth.AuthService, 
@@ -187,34 +271,67 @@ func setupAdminRoutes(
         admin.PUT("/vendors/:id/verify/identity", vh.ToggleIdentityVerification)
         admin.GET("/feedback", fh.GetAllFeedback)
         admin.DELETE("/feedback/:id", fh.DeleteFeedback)
+        admin.GET("/analytics", anh.GetPlatformAnalytics)
+        admin.GET("/analytics/export", anh.ExportPlatformAnalytics)
     }
 }
 
-func RegisterReviewRoutes(r *gin.Engine, reviewHandler *handlerreview.ReviewHandler, jwtService *servicejwt.JWTService) {
+func RegisterReviewRoutes(r *gin.Engine, reviewHandler *handlerreview.ReviewHandler, authService auth.AuthService, jwtService *servicejwt.JWTService) {
 	v1 := r.Group("/api/v1/vendors/:id/reviews")
 	{
 		v1.GET("", reviewHandler.GetVendorReviews)
 		v1.POST("",
 			middleware.RateLimit(utils.WriteLimiter),
-			middleware.OptionalAuth(jwtService),
+			middleware.OptionalAuth(jwtService, authService),
 			reviewHandler.CreateReview,
 		)
 	}
 }
 
-func RegisterInquiryRoutes(r *gin.Engine, inquiryHandler *handlerinquiries.InquiryHandler, jwtService *servicejwt.JWTService) {
+func RegisterInquiryRoutes(r *gin.Engine, inquiryHandler *handlerinquiries.InquiryHandler, authService auth.AuthService, jwtService *servicejwt.JWTService) {
 	inquiries := r.Group("/api/v1/inquiries")
 	{
 		inquiries.POST("/vendor/:vendor_id",
 			middleware.RateLimit(utils.WriteLimiter),
 			middleware.GuestMiddleware(),
-			middleware.OptionalAuth(jwtService),
+			middleware.OptionalAuth(jwtService, authService),
 			inquiryHandler.CreateInquiry,
 		)
 		inquiries.GET("/vendor/:vendor_id", inquiryHandler.GetVendorInquiries)
 	}
 }
 
+// RegisterBookingRoutes wires vendor packages, availability and the
+// inquiry -> quote -> booking flow. Vendors manage theirs under /vendors/:id;
+// clients act on a quote as the user or guest who sent the inquiry.
+func RegisterBookingRoutes(r *gin.Engine, bookingHandler *handlerbooking.BookingHandler, authService auth.AuthService, jwtService *servicejwt.JWTService) {
+	r.GET("/api/v1/vendors/:id/packages", bookingHandler.ListPackages)
+	// Owners also see block reasons and booking IDs
+	r.GET("/api/v1/vendors/:id/availability", middleware.OptionalAuth(jwtService, authService), bookingHandler.GetAvailability)
+
+	vendorBookings := r.Group("/api/v1/vendors/:id")
+	vendorBookings.Use(middleware.AuthMiddleware(authService))
+	{
+		vendorBookings.POST("/packages", middleware.RateLimit(utils.WriteLimiter), bookingHandler.CreatePackage)
+		vendorBookings.PUT("/packages/:packageId", middleware.RateLimit(utils.WriteLimiter), bookingHandler.UpdatePackage)
+		vendorBookings.DELETE("/packages/:packageId", bookingHandler.DeletePackage)
+		vendorBookings.POST("/quotes", middleware.RateLimit(utils.WriteLimiter), bookingHandler.CreateQuote)
+		vendorBookings.GET("/quotes", bookingHandler.ListInquiryQuotes)
+		vendorBookings.GET("/bookings", bookingHandler.ListBookings)
+		vendorBookings.PATCH("/bookings/:bookingId", middleware.RateLimit(utils.WriteLimiter), bookingHandler.UpdateBooking)
+		vendorBookings.POST("/availability/blocks", middleware.RateLimit(utils.WriteLimiter), bookingHandler.BlockDates)
+		vendorBookings.DELETE("/availability/blocks", bookingHandler.UnblockDates)
+	}
+
+	quotes := r.Group("/api/v1/quotes")
+	quotes.Use(middleware.GuestMiddleware(), middleware.OptionalAuth(jwtService, authService))
+	{
+		quotes.GET("/:quoteId", bookingHandler.GetQuote)
+		quotes.POST("/:quoteId/accept", middleware.RateLimit(utils.WriteLimiter), bookingHandler.AcceptQuote)
+		quotes.POST("/:quoteId/decline", middleware.RateLimit(utils.WriteLimiter), bookingHandler.DeclineQuote)
+	}
+}
+
 func requestLogger() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
@@ -4,17 +4,58 @@
 package analytics
 
 import (
+	"math"
 	"time"
 
 	"github.com/eventify/backend/pkg/models"
+
+	"github.com/google/uuid"
 )
 
+// metricsFromFacts splits the read model's event totals into the raw
+// metrics the calculate* methods work from
+func metricsFromFacts(
+	facts *models.EventFactsRaw,
+) (*models.OrderMetricsRaw, *models.RevenueMetricsRaw, *models.CustomerMetricsRaw) {
+
+	orders := &models.OrderMetricsRaw{
+		Total:      facts.OrdersTotal,
+		Successful: facts.OrdersSuccess,
+		Pending:    facts.OrdersPending,
+		Failed:     facts.OrdersFailed,
+		Fraud:      facts.OrdersFraud,
+	}
+
+	// Average order value over successful orders
+	avgOrderValue := 0.0
+	if facts.OrdersSuccess > 0 {
+		avgOrderValue = float64(facts.Gross) / float64(facts.OrdersSuccess)
+	}
+
+	revenue := &models.RevenueMetricsRaw{
+		TotalRevenue:    facts.Gross,
+		SubtotalRevenue: facts.Subtotal,
+		ServiceFees:     facts.ServiceFees,
+		VATAmount:       facts.VAT,
+		OrderCount:      facts.OrdersSuccess,
+		TotalOrderValue: avgOrderValue,
+	}
+
+	customers := &models.CustomerMetricsRaw{
+		UniqueCustomers: facts.UniqueCustomers,
+		RepeatCustomers: facts.RepeatCustomers,
+	}
+
+	return orders, revenue, customers
+}
+
 // calculateOverview builds the overview metrics
 func (s *AnalyticsServiceImpl) calculateOverview(
 	eventInfo *models.EventBasicInfo,
 	ticketsSold int,
 	orderMetrics *models.OrderMetricsRaw,
 	revenueMetrics *models.RevenueMetricsRaw,
+	funnel models.FunnelData,
 ) models.OverviewData {
 
 	// Calculate total inventory
@@ -29,12 +70,6 @@ func (s *AnalyticsServiceImpl) calculateOverview(
 		sellThroughRate = (float64(ticketsSold) / float64(totalInventory)) * 100
 	}
 
-	// Calculate conversion rate
-	conversionRate := 0.0
-	if orderMetrics.Total > 0 {
-		conversionRate = (float64(orderMetrics.Successful) / float64(orderMetrics.Total)) * 100
-	}
-
 	// Determine event status
 	now := time.Now()
 	status := "upcoming"
@@ -53,7 +88,7 @@ func (s *AnalyticsServiceImpl) calculateOverview(
 		TicketsSold:     ticketsSold,
 		TotalOrders:     orderMetrics.Total,
 		SellThroughRate: roundToTwoDecimals(sellThroughRate),
-		ConversionRate:  roundToTwoDecimals(conversionRate),
+		ConversionRate:  funnel.ConversionRate,
 		DaysUntilEvent:  daysUntil,
 	}
 }
@@ -124,23 +159,29 @@ func (s *AnalyticsServiceImpl) calculateRevenue(
 // calculateTiers builds per-tier analytics
 func (s *AnalyticsServiceImpl) calculateTiers(
 	eventInfo *models.EventBasicInfo,
-	soldByTier map[string]int64,
+	tierFacts []models.TierFactsRaw,
 ) []models.TierData {
 
+	byTier := make(map[uuid.UUID]models.TierFactsRaw, len(tierFacts))
+	for _, f := range tierFacts {
+		byTier[f.TierID] = f
+	}
+
 	var tiers []models.TierData
 
 	for _, tier := range eventInfo.TicketTiers {
-		sold := int(soldByTier[tier.TierName])
+		facts := byTier[tier.TierID]
+		sold := facts.TicketsSold
 		available := tier.Quantity - sold
 		if available < 0 {
 			available = 0
 		}
 
 		// Convert price from Naira to Kobo
-		priceKobo := int(tier.Price * 100)
+		priceKobo := int(math.Round(tier.Price * 100))
 
-		// Calculate revenue
-		revenue := priceKobo * sold
+		// Revenue is what buyers actually paid for the tier
+		revenue := facts.Revenue
 
 		// Calculate sell-through rate
 		sellThroughRate := 0.0
@@ -207,16 +248,6 @@ func (s *AnalyticsServiceImpl) calculateCustomers(
 	totalRevenue int,
 ) models.CustomersData {
 
-	// Count unique customers
-	uniqueCustomers := len(metrics.UniqueEmails)
-
-	// Calculate repeat customers (customers with >1 order)
-	// For now, placeholder. In production, need more complex query
-	repeatCustomers := 0
-	if metrics.TotalOrders > uniqueCustomers {
-		repeatCustomers = metrics.TotalOrders - uniqueCustomers
-	}
-
 	// Calculate percent of total for each country
 	for i := range topCountries {
 		if totalRevenue > 0 {
@@ -227,8 +258,8 @@ func (s *AnalyticsServiceImpl) calculateCustomers(
 	}
 
 	return models.CustomersData{
-		UniqueCustomers: uniqueCustomers,
-		RepeatCustomers: repeatCustomers,
+		UniqueCustomers: metrics.UniqueCustomers,
+		RepeatCustomers: metrics.RepeatCustomers,
 		TopCountries:    topCountries,
 	}
 }
@@ -285,16 +316,62 @@ func (s *AnalyticsServiceImpl) calculatePayments(
 	}
 }
 
-// calculateTimeline builds timeline with cumulative data
+// calculateTimeline builds the daily summary timeline with cumulative data.
+// Days arrive as midnight in loc, the read model's timezone.
 func (s *AnalyticsServiceImpl) calculateTimeline(
-	data []models.TimelineData,
+	rows []models.DailySalesRaw,
+	loc *time.Location,
 ) []models.TimelineData {
 
+	var data []models.TimelineData
 	cumulative := 0
-	for i := range data {
-		cumulative += data[i].TicketsSold
-		data[i].CumulativeSold = cumulative
+	for _, row := range rows {
+		cumulative += row.TicketsSold
+		data = append(data, models.TimelineData{
+			Date:           row.Day.In(loc).Format("2006-01-02"),
+			TicketsSold:    row.TicketsSold,
+			Revenue:        row.Revenue,
+			OrderCount:     row.OrderCount,
+			CumulativeSold: cumulative,
+		})
 	}
 
 	return data
-}
\ No newline at end of file
+}
+
+// calculateFunnel lays the tracked steps out in funnel order. Rates use
+// visitors rather than raw counts so repeat page loads don't dilute them.
+func (s *AnalyticsServiceImpl) calculateFunnel(
+	counts map[string]models.FunnelCountsRaw,
+) models.FunnelData {
+
+	stages := make([]models.FunnelStage, 0, len(models.FunnelSteps))
+	viewers, previous := 0, 0
+
+	for i, step := range models.FunnelSteps {
+		raw := counts[string(step)]
+		stage := models.FunnelStage{
+			Stage:    string(step),
+			Count:    raw.Count,
+			Visitors: raw.Visitors,
+		}
+
+		if i == 0 {
+			viewers = raw.Visitors
+		} else if previous > 0 {
+			stage.RateFromPrevious = roundToTwoDecimals(float64(raw.Visitors) / float64(previous) * 100)
+		}
+		if viewers > 0 {
+			stage.RateFromStart = roundToTwoDecimals(float64(raw.Visitors) / float64(viewers) * 100)
+		}
+
+		previous = raw.Visitors
+		stages = append(stages, stage)
+	}
+
+	funnel := models.FunnelData{Stages: stages}
+	if viewers > 0 {
+		funnel.ConversionRate = stages[len(stages)-1].RateFromStart
+	}
+	return funnel
+}
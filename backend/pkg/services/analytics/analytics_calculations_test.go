@@ -0,0 +1,96 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateFunnel(t *testing.T) {
+	s := &AnalyticsServiceImpl{}
+
+	t.Run("Rates follow visitors through each step", func(t *testing.T) {
+		funnel := s.calculateFunnel(map[string]models.FunnelCountsRaw{
+			"view":                 {Count: 900, Visitors: 400},
+			"ticket_selector_open": {Count: 150, Visitors: 100},
+			"checkout_start":       {Count: 60, Visitors: 50},
+			"purchase":             {Count: 20, Visitors: 20},
+		})
+
+		require.Len(t, funnel.Stages, 4)
+		assert.Equal(t, "view", funnel.Stages[0].Stage)
+		assert.Equal(t, 900, funnel.Stages[0].Count)
+		assert.Equal(t, 100.0, funnel.Stages[0].RateFromStart)
+
+		assert.Equal(t, 25.0, funnel.Stages[1].RateFromPrevious)
+		assert.Equal(t, 50.0, funnel.Stages[2].RateFromPrevious)
+		assert.Equal(t, 12.5, funnel.Stages[2].RateFromStart)
+		assert.Equal(t, 40.0, funnel.Stages[3].RateFromPrevious)
+		assert.Equal(t, 5.0, funnel.ConversionRate)
+	})
+
+	t.Run("No tracked views means no conversion rate", func(t *testing.T) {
+		funnel := s.calculateFunnel(map[string]models.FunnelCountsRaw{
+			"purchase": {Count: 3, Visitors: 3},
+		})
+
+		require.Len(t, funnel.Stages, 4)
+		assert.Equal(t, 3, funnel.Stages[3].Visitors)
+		assert.Zero(t, funnel.Stages[3].RateFromStart)
+		assert.Zero(t, funnel.ConversionRate)
+	})
+}
+
+func TestMetricsFromFacts(t *testing.T) {
+	s := &AnalyticsServiceImpl{}
+
+	facts := &models.EventFactsRaw{
+		OrdersTotal:     10,
+		OrdersSuccess:   4,
+		OrdersPending:   3,
+		OrdersFailed:    2,
+		OrdersRefunded:  1,
+		TicketsSold:     9,
+		Gross:           110000,
+		Subtotal:        100000,
+		ServiceFees:     7000,
+		VAT:             3000,
+		UniqueCustomers: 3,
+		RepeatCustomers: 1,
+	}
+
+	orders, revenue, customers := metricsFromFacts(facts)
+	assert.Equal(t, 10, orders.Total)
+	assert.Equal(t, 4, orders.Successful)
+	assert.Equal(t, 27500.0, revenue.TotalOrderValue, "gross averaged over successful orders")
+	assert.Equal(t, 100000, revenue.SubtotalRevenue)
+	assert.Equal(t, 1, customers.RepeatCustomers)
+
+	assert.Equal(t, 50.0, s.calculateOrders(orders).AbandonmentRate)
+}
+
+func TestCalculateTiers(t *testing.T) {
+	s := &AnalyticsServiceImpl{}
+	vip, regular := uuid.New(), uuid.New()
+
+	info := &models.EventBasicInfo{TicketTiers: []models.TierInfo{
+		{TierID: regular, TierName: "Regular", Price: 50.5, Quantity: 100},
+		{TierID: vip, TierName: "VIP", Price: 200, Quantity: 10},
+	}}
+
+	tiers := s.calculateTiers(info, []models.TierFactsRaw{
+		{TierID: vip, TicketsSold: 9, Revenue: 150000, OrderCount: 5},
+	})
+
+	require.Len(t, tiers, 2)
+	assert.Equal(t, 5050, tiers[0].PriceKobo)
+	assert.Zero(t, tiers[0].Sold, "tiers without facts haven't sold")
+	assert.Equal(t, 100, tiers[0].Available)
+
+	assert.Equal(t, 9, tiers[1].Sold)
+	assert.Equal(t, 150000, tiers[1].Revenue, "revenue is what was paid, not price × sold")
+	assert.Equal(t, "high", tiers[1].Popularity)
+}
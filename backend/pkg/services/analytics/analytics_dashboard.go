@@ -0,0 +1,268 @@
+// backend/pkg/services/analytics/analytics_dashboard.go
+// Business logic for analytics - organizer-wide dashboard
+
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/eventify/backend/pkg/analytics"
+	"github.com/eventify/backend/pkg/models"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// dashboardCacheTTL is how long a computed dashboard is served as-is
+	dashboardCacheTTL = 5 * time.Minute
+	// dashboardTopTiers caps the best-tiers list
+	dashboardTopTiers = 10
+
+	// An upcoming event is at risk when it starts within atRiskWindowDays and
+	// is under atRiskSellThrough; high risk when it's a week out and under
+	// highRiskSellThrough
+	atRiskWindowDays    = 14
+	atRiskSellThrough   = 50.0
+	highRiskWindowDays  = 7
+	highRiskSellThrough = 25.0
+)
+
+// dashboardCache holds computed dashboards per organizer and window. The
+// numbers come from several aggregate queries over every order, so a few
+// minutes of staleness is a fair trade.
+type dashboardCache struct {
+	mu      sync.Mutex
+	entries map[string]dashboardCacheEntry
+}
+
+type dashboardCacheEntry struct {
+	dashboard *models.OrganizerDashboard
+	expires   time.Time
+}
+
+func newDashboardCache() *dashboardCache {
+	return &dashboardCache{entries: make(map[string]dashboardCacheEntry)}
+}
+
+func (c *dashboardCache) get(key string, now time.Time) *models.OrganizerDashboard {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || now.After(entry.expires) {
+		return nil
+	}
+	return entry.dashboard
+}
+
+func (c *dashboardCache) set(key string, dashboard *models.OrganizerDashboard, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Sweep on write so organizers who never come back don't pile up
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = dashboardCacheEntry{dashboard: dashboard, expires: now.Add(dashboardCacheTTL)}
+}
+
+// GetOrganizerDashboard aggregates sales across all the organizer's events.
+// Results are cached per organizer for a few minutes; refresh skips the cache.
+func (s *AnalyticsServiceImpl) GetOrganizerDashboard(
+	ctx context.Context,
+	organizerID uuid.UUID,
+	days int,
+	refresh bool,
+) (*models.OrganizerDashboard, error) {
+
+	now := time.Now()
+	cacheKey := fmt.Sprintf("%s:%d", organizerID, days)
+	if !refresh && s.dashboards != nil {
+		if cached := s.dashboards.get(cacheKey, now); cached != nil {
+			return cached, nil
+		}
+	}
+
+	// Daily facts are dates in the read model's timezone
+	loc, err := time.LoadLocation(analytics.FactsTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone: %w", err)
+	}
+
+	// Step 1: Per-event sales and capacity
+	events, err := s.repo.GetOrganizerEvents(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	// Step 2: Daily sales for the window, starting at local midnight
+	today := truncateToBucket(now, "day", loc)
+	since := today.AddDate(0, 0, -(days - 1))
+	daily, err := s.repo.GetOrganizerDailySales(ctx, organizerID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily sales: %w", err)
+	}
+
+	// Step 3: Best tiers and repeat buyers
+	tiers, err := s.repo.GetOrganizerTopTiers(ctx, organizerID, dashboardTopTiers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top tiers: %w", err)
+	}
+	repeat, err := s.repo.GetOrganizerRepeatBuyers(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repeat buyers: %w", err)
+	}
+
+	dashboard := buildDashboard(events, daily, tiers, repeat, since, days, loc, now)
+	dashboard.OrganizerID = organizerID.String()
+
+	if s.dashboards != nil {
+		s.dashboards.set(cacheKey, dashboard, now)
+	}
+	return dashboard, nil
+}
+
+func buildDashboard(
+	events []models.OrganizerEventRaw,
+	daily []models.DailySalesRaw,
+	tiers []models.TierSalesRaw,
+	repeat *models.RepeatBuyersRaw,
+	since time.Time,
+	days int,
+	loc *time.Location,
+	now time.Time,
+) *models.OrganizerDashboard {
+
+	dashboard := &models.OrganizerDashboard{
+		GeneratedAt: now,
+		Days:        days,
+		Events:      make([]models.EventComparison, 0, len(events)),
+		TopTiers:    make([]models.TopTier, 0, len(tiers)),
+		AtRisk:      []models.AtRiskEvent{},
+	}
+
+	// Totals first so each event's revenue share can be worked out
+	capacity := 0
+	for _, e := range events {
+		dashboard.Totals.Revenue += e.Revenue
+		dashboard.Totals.TicketsSold += e.TicketsSold
+		dashboard.Totals.Orders += e.OrderCount
+		capacity += e.Capacity
+		if e.StartDate.After(now) && !e.Cancelled {
+			dashboard.Totals.UpcomingEvents++
+		}
+	}
+	dashboard.Totals.Events = len(events)
+	dashboard.Totals.SellThroughRate = percentOf(dashboard.Totals.TicketsSold, capacity)
+
+	for _, e := range events {
+		comparison := models.EventComparison{
+			EventID:         e.EventID.String(),
+			Title:           e.Title,
+			StartDate:       e.StartDate,
+			Status:          e.Status,
+			TicketsSold:     e.TicketsSold,
+			Capacity:        e.Capacity,
+			Revenue:         e.Revenue,
+			OrderCount:      e.OrderCount,
+			SellThroughRate: percentOf(e.TicketsSold, e.Capacity),
+			RevenueShare:    percentOf(e.Revenue, dashboard.Totals.Revenue),
+		}
+		if e.OrderCount > 0 {
+			comparison.AvgOrderValue = roundToTwoDecimals(float64(e.Revenue) / float64(e.OrderCount))
+		}
+		dashboard.Events = append(dashboard.Events, comparison)
+
+		if risk, ok := assessRisk(e, now); ok {
+			dashboard.AtRisk = append(dashboard.AtRisk, risk)
+		}
+	}
+	sort.SliceStable(dashboard.AtRisk, func(i, j int) bool {
+		return dashboard.AtRisk[i].StartDate.Before(dashboard.AtRisk[j].StartDate)
+	})
+
+	for _, t := range tiers {
+		dashboard.TopTiers = append(dashboard.TopTiers, models.TopTier{
+			EventID:         t.EventID.String(),
+			EventTitle:      t.EventTitle,
+			TierName:        t.TierName,
+			TicketsSold:     t.TicketsSold,
+			Revenue:         t.Revenue,
+			SellThroughRate: percentOf(t.TicketsSold, t.Capacity),
+		})
+	}
+
+	if repeat != nil {
+		dashboard.RepeatBuyers = models.RepeatBuyerStats{
+			UniqueBuyers:      repeat.UniqueBuyers,
+			RepeatBuyers:      repeat.RepeatBuyers,
+			RepeatRate:        percentOf(repeat.RepeatBuyers, repeat.UniqueBuyers),
+			RepeatRevenue:     repeat.RepeatRevenue,
+			RepeatRevenueRate: percentOf(repeat.RepeatRevenue, repeat.TotalRevenue),
+		}
+		if repeat.UniqueBuyers > 0 {
+			dashboard.RepeatBuyers.AvgEventsPerBuyer = roundToTwoDecimals(
+				float64(repeat.EventAttendances) / float64(repeat.UniqueBuyers))
+		}
+	}
+
+	// Every day in the window gets a point, sales or not
+	byDay := make(map[string]models.DailySalesRaw, len(daily))
+	for _, d := range daily {
+		byDay[d.Day.In(loc).Format("2006-01-02")] = d
+	}
+	dashboard.Timeline = make([]models.OrganizerTimelinePoint, 0, days)
+	cumulativeSold, cumulativeRevenue := 0, 0
+	for i := 0; i < days; i++ {
+		date := since.AddDate(0, 0, i).Format("2006-01-02")
+		d := byDay[date]
+		cumulativeSold += d.TicketsSold
+		cumulativeRevenue += d.Revenue
+		dashboard.Timeline = append(dashboard.Timeline, models.OrganizerTimelinePoint{
+			Date:              date,
+			TicketsSold:       d.TicketsSold,
+			Revenue:           d.Revenue,
+			OrderCount:        d.OrderCount,
+			CumulativeSold:    cumulativeSold,
+			CumulativeRevenue: cumulativeRevenue,
+		})
+	}
+
+	return dashboard
+}
+
+// assessRisk flags published, upcoming events that are selling slowly close
+// to their start date
+func assessRisk(e models.OrganizerEventRaw, now time.Time) (models.AtRiskEvent, bool) {
+	if e.Cancelled || e.Status != "published" || e.Capacity == 0 || !e.StartDate.After(now) {
+		return models.AtRiskEvent{}, false
+	}
+
+	daysUntil := int(e.StartDate.Sub(now).Hours() / 24)
+	sellThrough := percentOf(e.TicketsSold, e.Capacity)
+	if daysUntil > atRiskWindowDays || sellThrough >= atRiskSellThrough {
+		return models.AtRiskEvent{}, false
+	}
+
+	risk := "medium"
+	if daysUntil <= highRiskWindowDays && sellThrough < highRiskSellThrough {
+		risk = "high"
+	}
+
+	return models.AtRiskEvent{
+		EventID:         e.EventID.String(),
+		Title:           e.Title,
+		StartDate:       e.StartDate,
+		DaysUntilStart:  daysUntil,
+		TicketsSold:     e.TicketsSold,
+		Capacity:        e.Capacity,
+		SellThroughRate: sellThrough,
+		Risk:            risk,
+	}, true
+}
@@ -0,0 +1,79 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDashboard(t *testing.T) {
+	lagos := time.FixedZone("WAT", 3600)
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, lagos)
+	since := time.Date(2026, 5, 8, 0, 0, 0, 0, lagos)
+
+	event := func(title string, startIn time.Duration, sold, capacity, revenue, orders int) models.OrganizerEventRaw {
+		return models.OrganizerEventRaw{
+			EventID:     uuid.New(),
+			Title:       title,
+			StartDate:   now.Add(startIn),
+			Status:      "published",
+			Capacity:    capacity,
+			TicketsSold: sold,
+			Revenue:     revenue,
+			OrderCount:  orders,
+		}
+	}
+
+	past := event("Past", -30*24*time.Hour, 90, 100, 300000, 60)
+	slowSoon := event("Slow soon", 5*24*time.Hour, 10, 100, 50000, 8)
+	slowLater := event("Slow later", 12*24*time.Hour, 40, 100, 150000, 30)
+	healthy := event("Healthy", 3*24*time.Hour, 80, 100, 500000, 50)
+	far := event("Far off", 60*24*time.Hour, 0, 100, 0, 0)
+	cancelled := event("Cancelled", 2*24*time.Hour, 0, 100, 0, 0)
+	cancelled.Cancelled = true
+
+	events := []models.OrganizerEventRaw{far, slowLater, slowSoon, healthy, cancelled, past}
+	daily := []models.DailySalesRaw{
+		{Day: since, TicketsSold: 5, Revenue: 25000, OrderCount: 3},
+		{Day: since.AddDate(0, 0, 2), TicketsSold: 7, Revenue: 35000, OrderCount: 4},
+	}
+	repeat := &models.RepeatBuyersRaw{UniqueBuyers: 100, RepeatBuyers: 20, RepeatRevenue: 250000, TotalRevenue: 1000000, EventAttendances: 130}
+
+	d := buildDashboard(events, daily, nil, repeat, since, 3, lagos, now)
+
+	t.Run("Totals span every event", func(t *testing.T) {
+		assert.Equal(t, 1000000, d.Totals.Revenue)
+		assert.Equal(t, 220, d.Totals.TicketsSold)
+		assert.Equal(t, 6, d.Totals.Events)
+		assert.Equal(t, 4, d.Totals.UpcomingEvents, "past and cancelled events aren't upcoming")
+		require.Len(t, d.Events, 6)
+		assert.Equal(t, 50.0, d.Events[3].RevenueShare)
+	})
+
+	t.Run("Slow sellers close to start are flagged, soonest first", func(t *testing.T) {
+		require.Len(t, d.AtRisk, 2)
+		assert.Equal(t, "Slow soon", d.AtRisk[0].Title)
+		assert.Equal(t, "high", d.AtRisk[0].Risk)
+		assert.Equal(t, 5, d.AtRisk[0].DaysUntilStart)
+		assert.Equal(t, "Slow later", d.AtRisk[1].Title)
+		assert.Equal(t, "medium", d.AtRisk[1].Risk)
+	})
+
+	t.Run("Timeline covers every day in the window", func(t *testing.T) {
+		require.Len(t, d.Timeline, 3)
+		assert.Equal(t, "2026-05-09", d.Timeline[1].Date)
+		assert.Zero(t, d.Timeline[1].TicketsSold)
+		assert.Equal(t, 12, d.Timeline[2].CumulativeSold)
+		assert.Equal(t, 60000, d.Timeline[2].CumulativeRevenue)
+	})
+
+	t.Run("Repeat buyers are rated against all buyers", func(t *testing.T) {
+		assert.Equal(t, 20.0, d.RepeatBuyers.RepeatRate)
+		assert.Equal(t, 25.0, d.RepeatBuyers.RepeatRevenueRate)
+		assert.Equal(t, 1.3, d.RepeatBuyers.AvgEventsPerBuyer)
+	})
+}
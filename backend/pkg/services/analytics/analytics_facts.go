@@ -0,0 +1,135 @@
+// backend/pkg/services/analytics/analytics_facts.go
+// Business logic for analytics - keeping the read model up to date
+
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// factsRefreshTimeout bounds an async refresh triggered by a sale
+	factsRefreshTimeout = 30 * time.Second
+	// factsStartupWindow is how far back the refresher looks on its first pass
+	factsStartupWindow = 24 * time.Hour
+	// factsOverlap re-reads a little of the previous window so changes
+	// committed mid-pass aren't missed
+	factsOverlap = time.Minute
+)
+
+// RefreshEventFacts rebuilds the read model for each event, once per ID
+func (s *AnalyticsServiceImpl) RefreshEventFacts(ctx context.Context, eventIDs ...uuid.UUID) error {
+	seen := make(map[uuid.UUID]bool, len(eventIDs))
+	for _, id := range eventIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if err := s.repo.RefreshEventFacts(ctx, id); err != nil {
+			return fmt.Errorf("failed to refresh facts for event %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// RefreshEventFactsAsync refreshes in the background so checkout and refund
+// paths don't wait on it. Failures are logged; the refresher catches up.
+func (s *AnalyticsServiceImpl) RefreshEventFactsAsync(eventIDs ...uuid.UUID) {
+	if len(eventIDs) == 0 {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), factsRefreshTimeout)
+		defer cancel()
+
+		if err := s.RefreshEventFacts(ctx, eventIDs...); err != nil {
+			log.Warn().Err(err).Msg("Analytics: async facts refresh failed")
+		}
+	}()
+}
+
+// RebuildFacts refreshes every event, returning how many were rebuilt. One
+// failing event doesn't stop the rest; the first error is returned.
+func (s *AnalyticsServiceImpl) RebuildFacts(ctx context.Context) (int, error) {
+	ids, err := s.repo.GetAllEventIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rebuilt := 0
+	var firstErr error
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return rebuilt, ctx.Err()
+		}
+		if err := s.repo.RefreshEventFacts(ctx, id); err != nil {
+			log.Warn().Err(err).Str("event_id", id.String()).Msg("Analytics: failed to rebuild facts")
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		rebuilt++
+	}
+
+	return rebuilt, firstErr
+}
+
+// refreshChanged refreshes events whose orders or refunds changed since the
+// cutoff. It returns the time the pass started, the next pass's cutoff.
+func (s *AnalyticsServiceImpl) refreshChanged(ctx context.Context, since time.Time) time.Time {
+	start := time.Now()
+
+	ids, err := s.repo.GetEventIDsChangedSince(ctx, since.Add(-factsOverlap))
+	if err != nil {
+		log.Error().Err(err).Msg("Analytics: failed to find changed events")
+		return since
+	}
+
+	failed := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return since
+		}
+		if err := s.repo.RefreshEventFacts(ctx, id); err != nil {
+			failed++
+			log.Warn().Err(err).Str("event_id", id.String()).Msg("Analytics: failed to refresh facts")
+		}
+	}
+
+	if len(ids) > 0 {
+		log.Info().
+			Int("events", len(ids)).
+			Int("failed", failed).
+			Dur("took", time.Since(start)).
+			Msg("📊 Analytics facts refreshed")
+	}
+	if failed > 0 {
+		// Retry the same window next time
+		return since
+	}
+	return start
+}
+
+// StartFactsRefresher picks up order changes the sale and refund hooks
+// missed (expired checkouts, failed async refreshes) until ctx is cancelled
+func (s *AnalyticsServiceImpl) StartFactsRefresher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	since := s.refreshChanged(ctx, time.Now().Add(-factsStartupWindow))
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			since = s.refreshChanged(ctx, since)
+		}
+	}
+}
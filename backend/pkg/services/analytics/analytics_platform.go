@@ -0,0 +1,217 @@
+// backend/pkg/services/analytics/analytics_platform.go
+// Business logic for analytics - platform-wide admin dashboard
+
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/eventify/backend/pkg/analytics"
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+)
+
+const (
+	// defaultPlatformDays is the range shown when no dates are given
+	defaultPlatformDays = 30
+	// maxPlatformDays bounds the daily series
+	maxPlatformDays = 366
+)
+
+var platformOrderStatuses = []models.OrderStatus{
+	models.OrderStatusPending,
+	models.OrderStatusProcessing,
+	models.OrderStatusSuccess,
+	models.OrderStatusFailed,
+	models.OrderStatusRefunded,
+	models.OrderStatusFraud,
+	models.OrderStatusExpired,
+}
+
+var platformFeedbackTypes = []models.FeedbackType{
+	models.FeedbackTypeSuggestion,
+	models.FeedbackTypeComplaint,
+	models.FeedbackTypeFeedback,
+}
+
+// GetPlatformAnalytics summarises the marketplace over a range of days in
+// analytics.FactsTimezone. The range defaults to the last 30 days.
+func (s *AnalyticsServiceImpl) GetPlatformAnalytics(
+	ctx context.Context,
+	q models.PlatformAnalyticsQuery,
+) (*models.PlatformAnalytics, error) {
+
+	loc, err := time.LoadLocation(analytics.FactsTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone: %w", err)
+	}
+
+	from, to, err := platformRange(q, time.Now().In(loc), loc)
+	if err != nil {
+		return nil, err
+	}
+
+	daily, err := s.repo.GetPlatformDaily(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	orderCounts, err := s.repo.GetOrderStatusCounts(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	feedback, err := s.repo.GetFeedbackTypeCounts(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	funnel, err := s.repo.GetVendorFunnel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	activeUsers, err := s.repo.GetActiveUserCount(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := buildPlatformAnalytics(daily, orderCounts, feedback, *funnel, loc)
+	result.From = from.Format("2006-01-02")
+	result.To = to.AddDate(0, 0, -1).Format("2006-01-02")
+	result.Timezone = analytics.FactsTimezone
+	result.Users.ActiveInRange = activeUsers
+	return result, nil
+}
+
+// platformRange turns the query into [from, to) midnights in loc. An empty
+// To means today; an empty From means 30 days ending on To.
+func platformRange(q models.PlatformAnalyticsQuery, now time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	y, m, d := now.Date()
+	last := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	if q.To != "" {
+		t, err := time.ParseInLocation("2006-01-02", q.To, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, utils.NewError(utils.ErrCategoryValidation, "to must be a date like 2025-01-31", err)
+		}
+		last = t
+	}
+
+	first := last.AddDate(0, 0, -(defaultPlatformDays - 1))
+	if q.From != "" {
+		f, err := time.ParseInLocation("2006-01-02", q.From, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, utils.NewError(utils.ErrCategoryValidation, "from must be a date like 2025-01-01", err)
+		}
+		first = f
+	}
+
+	if first.After(last) {
+		return time.Time{}, time.Time{}, utils.NewError(utils.ErrCategoryValidation, "from must not be after to", nil)
+	}
+	if first.AddDate(0, 0, maxPlatformDays).Before(last.AddDate(0, 0, 1)) {
+		return time.Time{}, time.Time{}, utils.NewError(utils.ErrCategoryValidation, fmt.Sprintf("range must be at most %d days", maxPlatformDays), nil)
+	}
+
+	return first, last.AddDate(0, 0, 1), nil
+}
+
+// buildPlatformAnalytics totals the daily rows and fills every known order
+// status and feedback type, so zero counts still show up
+func buildPlatformAnalytics(
+	daily []models.PlatformDailyRaw,
+	orderCounts, feedbackCounts []models.StatusCountRaw,
+	funnel models.PlatformVendorFunnel,
+	loc *time.Location,
+) *models.PlatformAnalytics {
+
+	result := &models.PlatformAnalytics{
+		Orders:   models.PlatformOrders{ByStatus: make(map[string]int, len(platformOrderStatuses))},
+		Feedback: models.PlatformFeedback{ByType: make(map[string]int, len(platformFeedbackTypes))},
+		Daily:    make([]models.PlatformDailyMetric, 0, len(daily)),
+	}
+
+	activeDays := 0
+	for _, day := range daily {
+		label := day.Day.In(loc).Format("2006-01-02")
+		result.Daily = append(result.Daily, models.PlatformDailyMetric{
+			Day:              label,
+			GMV:              day.GMV,
+			PlatformFees:     day.PlatformFees,
+			SuccessfulOrders: day.SuccessfulOrders,
+			NewVendors:       day.NewVendors,
+			NewUsers:         day.NewUsers,
+			ActiveUsers:      day.ActiveUsers,
+			Feedback:         day.Feedback,
+		})
+
+		result.Sales.GMV += day.GMV
+		result.Sales.PlatformFees += day.PlatformFees
+		result.Sales.SuccessfulOrders += day.SuccessfulOrders
+		result.Vendors.NewInRange += day.NewVendors
+		result.Users.NewInRange += day.NewUsers
+
+		activeDays += day.ActiveUsers
+		if day.ActiveUsers > result.Users.PeakDAU {
+			result.Users.PeakDAU = day.ActiveUsers
+			result.Users.PeakDay = label
+		}
+	}
+	sort.Slice(result.Daily, func(i, j int) bool { return result.Daily[i].Day < result.Daily[j].Day })
+
+	if result.Sales.SuccessfulOrders > 0 {
+		result.Sales.AverageOrderValue = roundToTwoDecimals(float64(result.Sales.GMV) / float64(result.Sales.SuccessfulOrders))
+	}
+	if result.Sales.GMV > 0 {
+		result.Sales.TakeRate = roundToTwoDecimals(float64(result.Sales.PlatformFees) / float64(result.Sales.GMV) * 100)
+	}
+	if len(daily) > 0 {
+		result.Users.AverageDAU = roundToTwoDecimals(float64(activeDays) / float64(len(daily)))
+	}
+
+	for _, status := range platformOrderStatuses {
+		result.Orders.ByStatus[string(status)] = 0
+	}
+	for _, c := range orderCounts {
+		result.Orders.ByStatus[c.Status] += c.Count
+		result.Orders.Total += c.Count
+	}
+
+	for _, t := range platformFeedbackTypes {
+		result.Feedback.ByType[string(t)] = 0
+	}
+	for _, c := range feedbackCounts {
+		result.Feedback.ByType[c.Status] += c.Count
+		result.Feedback.Total += c.Count
+	}
+
+	result.Vendors.Funnel = funnel
+	result.Vendors.Stages = vendorFunnelStages(funnel)
+	return result
+}
+
+func vendorFunnelStages(f models.PlatformVendorFunnel) []models.PlatformFunnelStage {
+	steps := []struct {
+		name  string
+		count int
+	}{
+		{"registered", f.Total},
+		{"identity_verified", f.IdentityVerified},
+		{"business_registered", f.BusinessRegistered},
+		{"business_verified", f.BusinessVerified},
+	}
+
+	stages := make([]models.PlatformFunnelStage, 0, len(steps))
+	for i, step := range steps {
+		stage := models.PlatformFunnelStage{Stage: step.name, Count: step.count}
+		if f.Total > 0 {
+			stage.RateFromTotal = roundToTwoDecimals(float64(step.count) / float64(f.Total) * 100)
+		}
+		if i == 0 {
+			stage.RateFromPrevious = stage.RateFromTotal
+		} else if prev := steps[i-1].count; prev > 0 {
+			stage.RateFromPrevious = roundToTwoDecimals(float64(step.count) / float64(prev) * 100)
+		}
+		stages = append(stages, stage)
+	}
+	return stages
+}
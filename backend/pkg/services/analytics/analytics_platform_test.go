@@ -0,0 +1,82 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlatformRange(t *testing.T) {
+	lagos := time.FixedZone("WAT", 3600)
+	now := time.Date(2026, 3, 15, 14, 30, 0, 0, lagos)
+
+	t.Run("Defaults to the 30 days ending today", func(t *testing.T) {
+		from, to, err := platformRange(models.PlatformAnalyticsQuery{}, now, lagos)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 2, 14, 0, 0, 0, 0, lagos), from)
+		assert.Equal(t, time.Date(2026, 3, 16, 0, 0, 0, 0, lagos), to, "to is exclusive")
+	})
+
+	t.Run("Explicit days are inclusive", func(t *testing.T) {
+		from, to, err := platformRange(models.PlatformAnalyticsQuery{From: "2026-01-01", To: "2026-01-01"}, now, lagos)
+		require.NoError(t, err)
+		assert.Equal(t, 24*time.Hour, to.Sub(from))
+	})
+
+	for name, q := range map[string]models.PlatformAnalyticsQuery{
+		"bad date":       {From: "01/01/2026"},
+		"reversed range": {From: "2026-02-01", To: "2026-01-01"},
+		"too long":       {From: "2024-01-01", To: "2026-01-01"},
+	} {
+		t.Run("Rejects "+name, func(t *testing.T) {
+			_, _, err := platformRange(q, now, lagos)
+			var appErr *utils.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, utils.ErrCategoryValidation, appErr.Category)
+		})
+	}
+}
+
+func TestBuildPlatformAnalytics(t *testing.T) {
+	lagos := time.FixedZone("WAT", 3600)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, lagos) }
+
+	daily := []models.PlatformDailyRaw{
+		{Day: day(1), GMV: 100000, PlatformFees: 5000, SuccessfulOrders: 2, NewUsers: 3, ActiveUsers: 10, Feedback: 1},
+		{Day: day(2), ActiveUsers: 4},
+		{Day: day(3), GMV: 50000, PlatformFees: 2500, SuccessfulOrders: 1, NewVendors: 2, ActiveUsers: 16},
+	}
+	orders := []models.StatusCountRaw{{Status: "success", Count: 3}, {Status: "fraud", Count: 1}}
+	feedback := []models.StatusCountRaw{{Status: "complaint", Count: 1}}
+	funnel := models.PlatformVendorFunnel{Total: 10, Active: 9, Suspended: 1, IdentityVerified: 5, BusinessRegistered: 4, BusinessVerified: 2}
+
+	result := buildPlatformAnalytics(daily, orders, feedback, funnel, lagos)
+
+	assert.Equal(t, int64(150000), result.Sales.GMV)
+	assert.Equal(t, int64(7500), result.Sales.PlatformFees)
+	assert.Equal(t, 5.0, result.Sales.TakeRate)
+	assert.Equal(t, 50000.0, result.Sales.AverageOrderValue)
+
+	assert.Equal(t, 4, result.Orders.Total)
+	assert.Equal(t, 1, result.Orders.ByStatus["fraud"])
+	assert.Contains(t, result.Orders.ByStatus, "expired", "every status is listed even when zero")
+
+	assert.Equal(t, 1, result.Feedback.Total)
+	assert.Len(t, result.Feedback.ByType, 3)
+
+	assert.Equal(t, 2, result.Vendors.NewInRange)
+	require.Len(t, result.Vendors.Stages, 4)
+	assert.Equal(t, 50.0, result.Vendors.Stages[1].RateFromPrevious)
+	assert.Equal(t, 50.0, result.Vendors.Stages[3].RateFromPrevious)
+	assert.Equal(t, 20.0, result.Vendors.Stages[3].RateFromTotal)
+
+	assert.Equal(t, 10.0, result.Users.AverageDAU)
+	assert.Equal(t, 16, result.Users.PeakDAU)
+	assert.Equal(t, "2026-03-03", result.Users.PeakDay)
+	require.Len(t, result.Daily, 3)
+	assert.Equal(t, "2026-03-02", result.Daily[1].Day)
+}
@@ -5,7 +5,9 @@ package analytics
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/eventify/backend/pkg/analytics"
 	"github.com/eventify/backend/pkg/models"
@@ -20,21 +22,38 @@ import (
 // AnalyticsService defines methods for analytics business logic
 type AnalyticsService interface {
 	GetEventAnalytics(ctx context.Context, eventID, organizerID uuid.UUID, includeTimeline bool) (*models.AnalyticsResponse, error)
+	GetSalesTimeline(ctx context.Context, eventID, organizerID uuid.UUID, q models.TimelineQuery) (*models.SalesTimeline, error)
+	GetOrganizerDashboard(ctx context.Context, organizerID uuid.UUID, days int, refresh bool) (*models.OrganizerDashboard, error)
+	GetPlatformAnalytics(ctx context.Context, q models.PlatformAnalyticsQuery) (*models.PlatformAnalytics, error)
+
+	// Read model upkeep
+	RefreshEventFacts(ctx context.Context, eventIDs ...uuid.UUID) error
+	RefreshEventFactsAsync(eventIDs ...uuid.UUID)
+	RebuildFacts(ctx context.Context) (int, error)
+	StartFactsRefresher(ctx context.Context, interval time.Duration)
 }
 
+// ErrNotEventOrganizer is returned when the caller doesn't own the event
+var ErrNotEventOrganizer = errors.New("unauthorized: event does not belong to this organizer")
+
+// ErrEventNotFound is returned (wrapped) when the event is missing or deleted
+var ErrEventNotFound = analytics.ErrEventNotFound
+
 // ============================================================================
 // IMPLEMENTATION
 // ============================================================================
 
 // AnalyticsServiceImpl implements AnalyticsService
 type AnalyticsServiceImpl struct {
-	repo analytics.AnalyticsRepository
+	repo       analytics.AnalyticsRepository
+	dashboards *dashboardCache
 }
 
 // NewAnalyticsService creates a new analytics service instance
 func NewAnalyticsService(repo analytics.AnalyticsRepository) AnalyticsService {
 	return &AnalyticsServiceImpl{
-		repo: repo,
+		repo:       repo,
+		dashboards: newDashboardCache(),
 	}
 }
 
@@ -57,53 +76,52 @@ func (s *AnalyticsServiceImpl) GetEventAnalytics(
 
 	// Step 2: Verify organizer owns this event
 	if eventInfo.OrganizerID != organizerID.String() {
-		return nil, fmt.Errorf("unauthorized: event does not belong to this organizer")
-	}
-
-	// Step 3: Fetch all analytics data
-	ticketsSold, err := s.repo.GetTicketsSold(ctx, eventID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get tickets sold: %w", err)
-	}
-
-	ticketsByTier, err := s.repo.GetTicketsSoldByTier(ctx, eventID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get tickets by tier: %w", err)
+		return nil, ErrNotEventOrganizer
 	}
 
-	orderMetrics, err := s.repo.GetOrderMetrics(ctx, eventID)
+	// Step 3: Read the event's facts from the analytics read model. An
+	// event that has never been refreshed (new, or the model predates it)
+	// is built on the spot.
+	facts, err := s.repo.GetEventFacts(ctx, eventID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get order metrics: %w", err)
+		return nil, fmt.Errorf("failed to get event facts: %w", err)
 	}
-
-	revenueMetrics, err := s.repo.GetRevenueMetrics(ctx, eventID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get revenue metrics: %w", err)
+	if facts.RefreshedAt == nil {
+		if err := s.repo.RefreshEventFacts(ctx, eventID); err != nil {
+			return nil, fmt.Errorf("failed to build event facts: %w", err)
+		}
+		if facts, err = s.repo.GetEventFacts(ctx, eventID); err != nil {
+			return nil, fmt.Errorf("failed to get event facts: %w", err)
+		}
 	}
 
-	customerMetrics, err := s.repo.GetCustomerMetrics(ctx, eventID)
+	tierFacts, err := s.repo.GetTierFacts(ctx, eventID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get customer metrics: %w", err)
+		return nil, fmt.Errorf("failed to get tier facts: %w", err)
 	}
 
-	topCountries, err := s.repo.GetTopCountries(ctx, eventID, 5)
+	paymentChannels, err := s.repo.GetChannelFacts(ctx, eventID)
 	if err != nil {
-		// Don't fail if countries not available
-		topCountries = []models.CountryData{}
+		return nil, fmt.Errorf("failed to get payment channels: %w", err)
 	}
 
-	paymentChannels, err := s.repo.GetPaymentChannels(ctx, eventID)
+	funnelCounts, err := s.repo.GetFunnelCounts(ctx, eventID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get payment channels: %w", err)
+		return nil, fmt.Errorf("failed to get funnel counts: %w", err)
 	}
 
 	// Step 4: Calculate metrics and build response
-	overview := s.calculateOverview(eventInfo, int(ticketsSold), orderMetrics, revenueMetrics)
-	tickets := s.calculateTickets(eventInfo, int(ticketsSold))
-	revenue := s.calculateRevenue(revenueMetrics, int(ticketsSold))
-	tiers := s.calculateTiers(eventInfo, ticketsByTier)
+	orderMetrics, revenueMetrics, customerMetrics := metricsFromFacts(facts)
+	ticketsSold := facts.TicketsSold
+
+	funnel := s.calculateFunnel(funnelCounts)
+	overview := s.calculateOverview(eventInfo, ticketsSold, orderMetrics, revenueMetrics, funnel)
+	tickets := s.calculateTickets(eventInfo, ticketsSold)
+	revenue := s.calculateRevenue(revenueMetrics, ticketsSold)
+	tiers := s.calculateTiers(eventInfo, tierFacts)
 	orders := s.calculateOrders(orderMetrics)
-	customers := s.calculateCustomers(customerMetrics, topCountries, revenueMetrics.TotalRevenue)
+	// Orders carry no country, so there's nothing to break customers down by
+	customers := s.calculateCustomers(customerMetrics, []models.CountryData{}, revenueMetrics.TotalRevenue)
 	payments := s.calculatePayments(paymentChannels, revenueMetrics.TotalRevenue)
 
 	// Build response
@@ -117,13 +135,15 @@ func (s *AnalyticsServiceImpl) GetEventAnalytics(
 		Orders:     orders,
 		Customers:  customers,
 		Payments:   payments,
+		Funnel:     funnel,
 	}
 
 	// Step 5: Optionally include timeline
 	if includeTimeline {
-		timeline, err := s.repo.GetSalesTimeline(ctx, eventID, "day")
-		if err == nil && len(timeline) > 0 {
-			response.Timeline = s.calculateTimeline(timeline)
+		days, err := s.repo.GetDailyFacts(ctx, eventID)
+		loc, locErr := time.LoadLocation(analytics.FactsTimezone)
+		if err == nil && locErr == nil && len(days) > 0 {
+			response.Timeline = s.calculateTimeline(days, loc)
 		}
 	}
 
@@ -0,0 +1,266 @@
+// backend/pkg/services/analytics/analytics_timeline.go
+// Business logic for analytics - bucketed sales timeline
+
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTimelineTimezone aligns buckets to Nigerian local time unless the
+// caller asks for another zone
+const DefaultTimelineTimezone = "Africa/Lagos"
+
+// maxTimelineBuckets keeps hourly charts over long ranges from exploding
+const maxTimelineBuckets = 1000
+
+var timelineStep = map[string]time.Duration{
+	"hour": time.Hour,
+	"day":  24 * time.Hour,
+	"week": 7 * 24 * time.Hour,
+}
+
+// GetSalesTimeline returns tickets and revenue per bucket and per tier with
+// empty buckets zero-filled and running totals for sell-through charts.
+// Without a From the series starts at the first sale; To defaults to now.
+func (s *AnalyticsServiceImpl) GetSalesTimeline(
+	ctx context.Context,
+	eventID, organizerID uuid.UUID,
+	q models.TimelineQuery,
+) (*models.SalesTimeline, error) {
+
+	// Step 1: Validate the query
+	if _, ok := timelineStep[q.GroupBy]; !ok {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "groupBy must be hour, day or week", nil)
+	}
+	if q.Timezone == "" {
+		q.Timezone = DefaultTimelineTimezone
+	}
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "unknown timezone: "+q.Timezone, err)
+	}
+
+	to := time.Now()
+	if q.To != nil {
+		to = *q.To
+	}
+	q.To = &to
+	if q.From != nil {
+		if !q.From.Before(to) {
+			return nil, utils.NewError(utils.ErrCategoryValidation, "from must be before to", nil)
+		}
+		if err := checkBucketCount(*q.From, to, q.GroupBy); err != nil {
+			return nil, err
+		}
+	}
+
+	// Step 2: Verify organizer owns this event
+	eventInfo, err := s.repo.GetEventInfo(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event info: %w", err)
+	}
+	if eventInfo.OrganizerID != organizerID.String() {
+		return nil, ErrNotEventOrganizer
+	}
+
+	// Step 3: Fetch and shape the series
+	rows, err := s.repo.GetSalesTimeline(ctx, eventID, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sales timeline: %w", err)
+	}
+	if q.From == nil && len(rows) > 0 {
+		if err := checkBucketCount(rows[0].Bucket, to, q.GroupBy); err != nil {
+			return nil, err
+		}
+	}
+
+	// Sales before from still count towards the running totals
+	var opening []models.SalesTimelineRaw
+	if q.From != nil {
+		if opening, err = s.repo.GetSalesBefore(ctx, eventID, *q.From); err != nil {
+			return nil, fmt.Errorf("failed to get opening sales: %w", err)
+		}
+	}
+
+	timeline := buildTimeline(rows, opening, q.GroupBy, loc, eventInfo.TicketTiers, q.From, to)
+	timeline.EventID = eventID.String()
+	timeline.Timezone = q.Timezone
+	return &timeline, nil
+}
+
+func checkBucketCount(from, to time.Time, groupBy string) error {
+	if int(to.Sub(from)/timelineStep[groupBy]) >= maxTimelineBuckets {
+		return utils.NewError(utils.ErrCategoryValidation,
+			fmt.Sprintf("range covers more than %d %s buckets; narrow from/to or use a coarser groupBy", maxTimelineBuckets, groupBy), nil)
+	}
+	return nil
+}
+
+// buildTimeline lays rows onto a continuous run of buckets from from (or the
+// first sale) up to to, accumulating as it goes. Running totals start from
+// opening, the sales made before from.
+func buildTimeline(
+	rows []models.SalesTimelineRaw,
+	opening []models.SalesTimelineRaw,
+	groupBy string,
+	loc *time.Location,
+	tierInfo []models.TierInfo,
+	from *time.Time,
+	to time.Time,
+) models.SalesTimeline {
+
+	timeline := models.SalesTimeline{
+		GroupBy: groupBy,
+		To:      to,
+		Tiers:   []string{},
+		Buckets: []models.TimelineBucket{},
+	}
+
+	// Tiers keep the event's order; tiers that have since been removed but
+	// still have sales go on the end
+	inventory := make(map[string]int, len(tierInfo))
+	for _, tier := range tierInfo {
+		if _, seen := inventory[tier.TierName]; !seen {
+			timeline.Tiers = append(timeline.Tiers, tier.TierName)
+		}
+		inventory[tier.TierName] += tier.Quantity
+		timeline.TotalInventory += tier.Quantity
+	}
+
+	addTier := func(name string) {
+		if _, known := inventory[name]; !known {
+			inventory[name] = 0
+			timeline.Tiers = append(timeline.Tiers, name)
+		}
+	}
+
+	cumulativeSold, cumulativeRevenue := 0, 0
+	tierSold := make(map[string]int, len(timeline.Tiers))
+	tierRevenue := make(map[string]int, len(timeline.Tiers))
+	for _, row := range opening {
+		if row.IsTotal {
+			cumulativeSold, cumulativeRevenue = row.TicketsSold, row.Revenue
+			continue
+		}
+		if row.TicketsSold == 0 && row.Revenue == 0 {
+			continue
+		}
+		addTier(row.TierName)
+		tierSold[row.TierName] += row.TicketsSold
+		tierRevenue[row.TierName] += row.Revenue
+	}
+
+	totals := make(map[int64]models.SalesTimelineRaw)
+	perTier := make(map[int64]map[string]models.SalesTimelineRaw)
+	for _, row := range rows {
+		key := truncateToBucket(row.Bucket, groupBy, loc).Unix()
+		if row.IsTotal {
+			totals[key] = row
+			continue
+		}
+		addTier(row.TierName)
+		if perTier[key] == nil {
+			perTier[key] = make(map[string]models.SalesTimelineRaw)
+		}
+		perTier[key][row.TierName] = row
+	}
+
+	var start time.Time
+	switch {
+	case from != nil:
+		start = truncateToBucket(*from, groupBy, loc)
+	case len(rows) > 0:
+		start = truncateToBucket(rows[0].Bucket, groupBy, loc)
+	default:
+		return timeline
+	}
+	timeline.From = &start
+
+	for b := start; b.Before(to) && len(timeline.Buckets) < maxTimelineBuckets; b = nextBucket(b, groupBy) {
+		total := totals[b.Unix()]
+		cumulativeSold += total.TicketsSold
+		cumulativeRevenue += total.Revenue
+
+		bucket := models.TimelineBucket{
+			Start:             b,
+			Label:             bucketLabel(b, groupBy),
+			TicketsSold:       total.TicketsSold,
+			Revenue:           total.Revenue,
+			OrderCount:        total.OrderCount,
+			CumulativeSold:    cumulativeSold,
+			CumulativeRevenue: cumulativeRevenue,
+			SellThroughRate:   percentOf(cumulativeSold, timeline.TotalInventory),
+			Tiers:             make([]models.TierTimelinePoint, 0, len(timeline.Tiers)),
+		}
+
+		for _, name := range timeline.Tiers {
+			row := perTier[b.Unix()][name]
+			tierSold[name] += row.TicketsSold
+			tierRevenue[name] += row.Revenue
+			bucket.Tiers = append(bucket.Tiers, models.TierTimelinePoint{
+				TierName:          name,
+				TicketsSold:       row.TicketsSold,
+				Revenue:           row.Revenue,
+				CumulativeSold:    tierSold[name],
+				CumulativeRevenue: tierRevenue[name],
+				SellThroughRate:   percentOf(tierSold[name], inventory[name]),
+			})
+		}
+
+		timeline.Buckets = append(timeline.Buckets, bucket)
+	}
+
+	return timeline
+}
+
+// truncateToBucket matches Postgres date_trunc in loc; weeks start on Monday
+func truncateToBucket(t time.Time, groupBy string, loc *time.Location) time.Time {
+	t = t.In(loc)
+	switch groupBy {
+	case "hour":
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	case "week":
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+}
+
+func nextBucket(t time.Time, groupBy string) time.Time {
+	switch groupBy {
+	case "hour":
+		return t.Add(time.Hour)
+	case "week":
+		return t.AddDate(0, 0, 7)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+func bucketLabel(t time.Time, groupBy string) string {
+	switch groupBy {
+	case "hour":
+		return t.Format("2006-01-02 15:00")
+	case "week":
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	default:
+		return t.Format("2006-01-02")
+	}
+}
+
+func percentOf(part, whole int) float64 {
+	if whole <= 0 {
+		return 0
+	}
+	return roundToTwoDecimals(float64(part) / float64(whole) * 100)
+}
@@ -0,0 +1,110 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	lagos := time.FixedZone("WAT", 3600)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, lagos) }
+	tiers := []models.TierInfo{{TierName: "Regular", Quantity: 100}, {TierName: "VIP", Quantity: 20}}
+
+	t.Run("Empty days are zero-filled and totals keep running", func(t *testing.T) {
+		rows := []models.SalesTimelineRaw{
+			{Bucket: day(2), IsTotal: true, TicketsSold: 10, Revenue: 50000, OrderCount: 4},
+			{Bucket: day(2), TierName: "Regular", TicketsSold: 8, Revenue: 32000},
+			{Bucket: day(2), TierName: "VIP", TicketsSold: 2, Revenue: 18000},
+			{Bucket: day(4), IsTotal: true, TicketsSold: 20, Revenue: 80000, OrderCount: 9},
+			{Bucket: day(4), TierName: "Regular", TicketsSold: 20, Revenue: 80000},
+		}
+
+		from := day(1)
+		tl := buildTimeline(rows, nil, "day", lagos, tiers, &from, day(5))
+
+		assert.Equal(t, []string{"Regular", "VIP"}, tl.Tiers)
+		assert.Equal(t, 120, tl.TotalInventory)
+		require.Len(t, tl.Buckets, 4)
+
+		assert.Equal(t, "2026-03-01", tl.Buckets[0].Label)
+		assert.Zero(t, tl.Buckets[0].TicketsSold)
+		assert.Len(t, tl.Buckets[0].Tiers, 2, "every bucket carries every tier")
+
+		assert.Zero(t, tl.Buckets[2].TicketsSold)
+		assert.Equal(t, 10, tl.Buckets[2].CumulativeSold)
+
+		last := tl.Buckets[3]
+		assert.Equal(t, 30, last.CumulativeSold)
+		assert.Equal(t, 130000, last.CumulativeRevenue)
+		assert.Equal(t, 25.0, last.SellThroughRate)
+		assert.Equal(t, 28, last.Tiers[0].CumulativeSold)
+		assert.Equal(t, 10.0, last.Tiers[1].SellThroughRate)
+	})
+
+	t.Run("Series starts at the first sale without a from", func(t *testing.T) {
+		rows := []models.SalesTimelineRaw{{Bucket: day(3).Add(14 * time.Hour), IsTotal: true, TicketsSold: 1}}
+
+		tl := buildTimeline(rows, nil, "hour", lagos, tiers, nil, day(3).Add(17*time.Hour))
+		require.Len(t, tl.Buckets, 3)
+		assert.Equal(t, "2026-03-03 14:00", tl.Buckets[0].Label)
+		assert.Equal(t, 1, tl.Buckets[2].CumulativeSold)
+	})
+
+	t.Run("Weeks start on Monday in the requested zone", func(t *testing.T) {
+		// Sunday 23:30 UTC is already Monday in Lagos
+		sunday := time.Date(2026, 3, 8, 23, 30, 0, 0, time.UTC)
+		monday := truncateToBucket(sunday, "week", lagos)
+		assert.Equal(t, day(9), monday)
+		assert.Equal(t, "2026-W11", bucketLabel(monday, "week"))
+	})
+
+	t.Run("Tiers sold but since removed are still charted", func(t *testing.T) {
+		rows := []models.SalesTimelineRaw{{Bucket: day(1), TierName: "Early Bird", TicketsSold: 5}}
+
+		tl := buildTimeline(rows, nil, "day", lagos, tiers, nil, day(2))
+		assert.Equal(t, []string{"Regular", "VIP", "Early Bird"}, tl.Tiers)
+		assert.Zero(t, tl.Buckets[0].Tiers[2].SellThroughRate)
+	})
+
+	t.Run("A from mid-sale opens with the sales made before it", func(t *testing.T) {
+		opening := []models.SalesTimelineRaw{
+			{IsTotal: true, TicketsSold: 40, Revenue: 200000, OrderCount: 15},
+			{TierName: "Regular", TicketsSold: 30, Revenue: 120000},
+			{TierName: "VIP", TicketsSold: 5, Revenue: 45000},
+			{TierName: "Early Bird", TicketsSold: 5, Revenue: 35000},
+		}
+		rows := []models.SalesTimelineRaw{
+			{Bucket: day(3), IsTotal: true, TicketsSold: 2, Revenue: 8000, OrderCount: 1},
+			{Bucket: day(3), TierName: "Regular", TicketsSold: 2, Revenue: 8000},
+		}
+
+		from := day(2)
+		tl := buildTimeline(rows, opening, "day", lagos, tiers, &from, day(4))
+		require.Len(t, tl.Buckets, 2)
+		assert.Equal(t, []string{"Regular", "VIP", "Early Bird"}, tl.Tiers)
+
+		first := tl.Buckets[0]
+		assert.Zero(t, first.TicketsSold)
+		assert.Equal(t, 40, first.CumulativeSold)
+		assert.Equal(t, 200000, first.CumulativeRevenue)
+		assert.Equal(t, 33.33, first.SellThroughRate)
+		assert.Equal(t, 30, first.Tiers[0].CumulativeSold)
+		assert.Equal(t, 25.0, first.Tiers[1].SellThroughRate)
+
+		last := tl.Buckets[1]
+		assert.Equal(t, 42, last.CumulativeSold)
+		assert.Equal(t, 208000, last.CumulativeRevenue)
+		assert.Equal(t, 32, last.Tiers[0].CumulativeSold)
+		assert.Equal(t, 5, last.Tiers[2].CumulativeSold)
+	})
+
+	t.Run("No sales and no from gives an empty series", func(t *testing.T) {
+		tl := buildTimeline(nil, nil, "day", lagos, tiers, nil, day(5))
+		assert.Empty(t, tl.Buckets)
+		assert.Nil(t, tl.From)
+	})
+}
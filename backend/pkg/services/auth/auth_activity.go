@@ -0,0 +1,80 @@
+// backend/pkg/services/auth/auth_activity.go
+
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ActivityTimezone is the zone whose calendar days activity is counted in
+const ActivityTimezone = "Africa/Lagos"
+
+var activityLocation = func() *time.Location {
+	loc, err := time.LoadLocation(ActivityTimezone)
+	if err != nil {
+		return time.FixedZone("WAT", 3600)
+	}
+	return loc
+}()
+
+// activitySeen remembers who has already been recorded today, so only a
+// user's first authenticated request each day touches the database
+type activitySeen struct {
+	mu   sync.Mutex
+	day  string
+	seen map[uuid.UUID]struct{}
+}
+
+// mark reports whether userID is new for day, starting a fresh set when the day rolls over
+func (a *activitySeen) mark(userID uuid.UUID, day string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.day != day {
+		a.day = day
+		a.seen = make(map[uuid.UUID]struct{})
+	}
+	if _, ok := a.seen[userID]; ok {
+		return false
+	}
+	a.seen[userID] = struct{}{}
+	return true
+}
+
+func (a *activitySeen) forget(userID uuid.UUID, day string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.day == day {
+		delete(a.seen, userID)
+	}
+}
+
+// RecordActivity counts the user as active today. It returns immediately;
+// the write happens in the background.
+func (s *authWriteService) RecordActivity(userID uuid.UUID) {
+	if userID == uuid.Nil {
+		return
+	}
+
+	day := time.Now().In(activityLocation).Format("2006-01-02")
+	if !s.activity.mark(userID, day) {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := s.authRepo.RecordUserActivity(ctx, userID, day); err != nil {
+			// Let the next request try again
+			s.activity.forget(userID, day)
+			log.Warn().Err(err).Str("user_id", userID.String()).Msg("Failed to record user activity")
+		}
+	}()
+}
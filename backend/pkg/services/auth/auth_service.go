@@ -45,4 +45,7 @@ type AuthService interface {
 	// Token Blacklist Operations (for logout)
 	BlacklistToken(ctx context.Context, token string, expiry time.Time) error
 	IsTokenBlacklisted(ctx context.Context, token string) (bool, error)
+
+	// Activity tracking (daily active users)
+	RecordActivity(userID uuid.UUID)
 }
\ No newline at end of file
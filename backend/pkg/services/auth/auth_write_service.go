@@ -22,6 +22,7 @@ type authWriteService struct {
 	authRepo         repoauth.AuthRepository
 	refreshTokenRepo repoauth.RefreshTokenRepository
 	jwtService       *servicejwt.JWTService
+	activity         *activitySeen
 }
 
 const (
@@ -39,6 +40,7 @@ func NewAuthService(auth repoauth.AuthRepository, token repoauth.RefreshTokenRep
 		authRepo:         auth,
 		refreshTokenRepo: token,
 		jwtService:       jwt,
+		activity:         &activitySeen{},
 	}
 }
 
@@ -76,6 +78,7 @@ func (s *authWriteService) Login(ctx context.Context, email, password, ipAddress
 
     s.authRepo.RecordLoginAttempt(ctx, email, true)
     s.authRepo.UpdateLastLogin(ctx, user.ID)
+    s.RecordActivity(user.ID)
 
     // FIXED: Now using real metadata instead of hardcoded strings
     tokens, err := s.generateTokenPair(ctx, user.ID.String(), 3600*24*30, nil, ipAddress, userAgent)
@@ -169,6 +172,8 @@ func (s *authWriteService) RefreshToken(ctx context.Context, oldTokenStr string,
 		return nil, err
 	}
 
+	s.RecordActivity(userID)
+
 	// Generate new pair linked to this parent ID to maintain the "Family" chain
 	return s.generateTokenPair(ctx, userID.String(), 0, &storedToken.ID, ipAddress, userAgent)
 }
@@ -0,0 +1,149 @@
+// backend/pkg/services/booking/booking_availability.go
+
+package booking
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultCalendarDays is how far ahead the calendar looks without ?to=
+	defaultCalendarDays = 90
+	// maxCalendarDays caps both calendar reads and a single block request
+	maxCalendarDays      = 366
+	maxBlockReasonLength = 200
+)
+
+// GetAvailability returns the vendor's unavailable days in the range. The
+// vendor's owner also sees block reasons and which booking holds a day.
+func (s *bookingService) GetAvailability(ctx context.Context, vendorID uuid.UUID, viewerID *uuid.UUID, from, to string) (*models.VendorAvailability, error) {
+	from, to, err := calendarRange(from, to, time.Now().In(s.loc), defaultCalendarDays)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.repo.GetVendorOwnerID(ctx, vendorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrVendorNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := s.repo.GetUnavailableDays(ctx, vendorID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.VendorAvailability{
+		VendorID:    vendorID,
+		From:        from,
+		To:          to,
+		Timezone:    bookingTimezone,
+		Unavailable: mergeUnavailableDays(days, viewerID != nil && *viewerID == owner),
+	}, nil
+}
+
+// BlockDates marks a range of future days unavailable. Days already booked
+// can be blocked too; they stay unavailable either way.
+func (s *bookingService) BlockDates(ctx context.Context, vendorID, ownerID uuid.UUID, input models.DateBlockInput) (int64, error) {
+	today := time.Now().In(s.loc)
+	from, to, err := calendarRange(input.From, input.To, today, 1)
+	if err != nil {
+		return 0, err
+	}
+	if from < today.Format(bookingDateLayout) {
+		return 0, validationError("cannot block days in the past")
+	}
+	reason := strings.TrimSpace(input.Reason)
+	if len(reason) > maxBlockReasonLength {
+		return 0, validationError("reason must be at most %d characters", maxBlockReasonLength)
+	}
+
+	if err := s.checkVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return 0, err
+	}
+	return s.repo.BlockDates(ctx, vendorID, from, to, reason)
+}
+
+// UnblockDates frees the blocked days in a range
+func (s *bookingService) UnblockDates(ctx context.Context, vendorID, ownerID uuid.UUID, input models.DateBlockInput) (int64, error) {
+	from, to, err := calendarRange(input.From, input.To, time.Now().In(s.loc), 1)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.checkVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return 0, err
+	}
+	return s.repo.UnblockDates(ctx, vendorID, from, to)
+}
+
+// checkDateAvailable fails with ErrDateUnavailable when the vendor is
+// booked or has blocked the date
+func (s *bookingService) checkDateAvailable(ctx context.Context, vendorID uuid.UUID, date string) error {
+	available, err := s.repo.IsDateAvailable(ctx, vendorID, date)
+	if err != nil {
+		return err
+	}
+	if !available {
+		return ErrDateUnavailable
+	}
+	return nil
+}
+
+// calendarRange validates an inclusive YYYY-MM-DD range. An empty from is
+// today; an empty to covers defaultDays days starting at from.
+func calendarRange(from, to string, today time.Time, defaultDays int) (string, string, error) {
+	if from == "" {
+		from = today.Format(bookingDateLayout)
+	}
+	start, err := time.Parse(bookingDateLayout, from)
+	if err != nil {
+		return "", "", validationError("from must be a date in YYYY-MM-DD format")
+	}
+
+	end := start.AddDate(0, 0, defaultDays-1)
+	if to != "" {
+		if end, err = time.Parse(bookingDateLayout, to); err != nil {
+			return "", "", validationError("to must be a date in YYYY-MM-DD format")
+		}
+	}
+
+	if end.Before(start) {
+		return "", "", validationError("to must not be before from")
+	}
+	if end.Sub(start) >= maxCalendarDays*24*time.Hour {
+		return "", "", validationError("a range can cover at most %d days", maxCalendarDays)
+	}
+	return start.Format(bookingDateLayout), end.Format(bookingDateLayout), nil
+}
+
+// mergeUnavailableDays keeps one entry per date, booked over blocked, and
+// hides the details from anyone but the owner. days must be sorted by date.
+func mergeUnavailableDays(days []models.UnavailableDay, owner bool) []models.UnavailableDay {
+	merged := make([]models.UnavailableDay, 0, len(days))
+	for _, day := range days {
+		if !owner {
+			day.Reason = ""
+			day.BookingID = nil
+		}
+
+		last := len(merged) - 1
+		if last >= 0 && merged[last].Date == day.Date {
+			if day.Status == models.AvailabilityBooked {
+				merged[last] = day
+			}
+			continue
+		}
+		merged = append(merged, day)
+	}
+	return merged
+}
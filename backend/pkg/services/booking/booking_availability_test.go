@@ -0,0 +1,76 @@
+package booking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarRange(t *testing.T) {
+	today := time.Date(2026, 3, 15, 22, 0, 0, 0, time.FixedZone("WAT", 3600))
+
+	t.Run("Defaults to the window starting today", func(t *testing.T) {
+		from, to, err := calendarRange("", "", today, defaultCalendarDays)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-03-15", from)
+		assert.Equal(t, "2026-06-12", to, "90 days inclusive")
+	})
+
+	t.Run("A single day", func(t *testing.T) {
+		from, to, err := calendarRange("2026-04-01", "", today, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-04-01", from)
+		assert.Equal(t, "2026-04-01", to)
+	})
+
+	t.Run("A full year is allowed", func(t *testing.T) {
+		_, _, err := calendarRange("2026-01-01", "2027-01-01", today, 1)
+		assert.NoError(t, err)
+	})
+
+	for name, r := range map[string][2]string{
+		"bad from":       {"15/03/2026", ""},
+		"bad to":         {"2026-03-15", "tomorrow"},
+		"reversed range": {"2026-04-02", "2026-04-01"},
+		"too long":       {"2026-01-01", "2027-01-02"},
+	} {
+		t.Run("Rejects "+name, func(t *testing.T) {
+			_, _, err := calendarRange(r[0], r[1], today, 1)
+			var appErr *utils.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, utils.ErrCategoryValidation, appErr.Category)
+		})
+	}
+}
+
+func TestMergeUnavailableDays(t *testing.T) {
+	bookingID := uuid.New()
+	days := []models.UnavailableDay{
+		{Date: "2026-04-01", Status: models.AvailabilityBlocked, Reason: "Holiday"},
+		{Date: "2026-04-01", Status: models.AvailabilityBooked, BookingID: &bookingID},
+		{Date: "2026-04-02", Status: models.AvailabilityBlocked, Reason: "Holiday"},
+	}
+
+	t.Run("Owner sees details and booked wins", func(t *testing.T) {
+		merged := mergeUnavailableDays(days, true)
+		require.Len(t, merged, 2)
+		assert.Equal(t, models.AvailabilityBooked, merged[0].Status)
+		assert.Equal(t, &bookingID, merged[0].BookingID)
+		assert.Equal(t, "Holiday", merged[1].Reason)
+	})
+
+	t.Run("Public sees only dates and status", func(t *testing.T) {
+		merged := mergeUnavailableDays(days, false)
+		require.Len(t, merged, 2)
+		for _, day := range merged {
+			assert.Empty(t, day.Reason)
+			assert.Nil(t, day.BookingID)
+		}
+		assert.Equal(t, models.AvailabilityBooked, merged[0].Status)
+	})
+}
@@ -0,0 +1,339 @@
+// backend/pkg/services/booking/booking_quotes.go
+
+package booking
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// depositTransitions and bookingTransitions list the moves a vendor may make.
+// Waiving a pending deposit sets it to not_required.
+var depositTransitions = map[models.DepositStatus][]models.DepositStatus{
+	models.DepositPending: {models.DepositPaid, models.DepositNotRequired},
+	models.DepositPaid:    {models.DepositRefunded},
+}
+
+var bookingTransitions = map[models.BookingStatus][]models.BookingStatus{
+	models.BookingConfirmed: {models.BookingCancelled, models.BookingCompleted},
+}
+
+// ============================================================================
+// QUOTES
+// ============================================================================
+
+// CreateQuote sends a quote for one of the vendor's inquiries, withdrawing
+// any quote still open on it. The vendor must be free on the event date.
+func (s *bookingService) CreateQuote(ctx context.Context, vendorID, ownerID uuid.UUID, input models.QuoteInput) (*models.Quote, error) {
+	now := time.Now()
+	quote, err := buildQuote(input, now.In(s.loc))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return nil, err
+	}
+
+	inquiry, err := s.inquiries.FindByID(ctx, input.InquiryID)
+	if err != nil {
+		return nil, err
+	}
+	if inquiry == nil || inquiry.VendorID != vendorID {
+		return nil, ErrInquiryNotFound
+	}
+
+	if input.PackageID != nil {
+		if _, err := s.repo.GetPackage(ctx, vendorID, *input.PackageID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkDateAvailable(ctx, vendorID, quote.EventDate); err != nil {
+		return nil, err
+	}
+
+	quote.ID = uuid.New()
+	quote.InquiryID = inquiry.ID
+	quote.VendorID = vendorID
+	quote.PackageID = input.PackageID
+	quote.Status = models.QuoteSent
+	quote.CreatedAt = now
+	quote.UpdatedAt = now
+	for i := range quote.LineItems {
+		quote.LineItems[i].ID = uuid.New()
+		quote.LineItems[i].QuoteID = quote.ID
+	}
+
+	if err := s.repo.CreateQuote(ctx, quote); err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// ListInquiryQuotes returns every quote the vendor sent for an inquiry
+func (s *bookingService) ListInquiryQuotes(ctx context.Context, vendorID, ownerID, inquiryID uuid.UUID) ([]models.Quote, error) {
+	if err := s.checkVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return nil, err
+	}
+
+	inquiry, err := s.inquiries.FindByID(ctx, inquiryID)
+	if err != nil {
+		return nil, err
+	}
+	if inquiry == nil || inquiry.VendorID != vendorID {
+		return nil, ErrInquiryNotFound
+	}
+
+	quotes, err := s.repo.ListQuotesByInquiry(ctx, inquiryID)
+	if err != nil {
+		return nil, err
+	}
+	if quotes == nil {
+		quotes = []models.Quote{}
+	}
+	return quotes, nil
+}
+
+// GetClientQuote returns a quote to the client it was sent to
+func (s *bookingService) GetClientQuote(ctx context.Context, quoteID uuid.UUID, client models.QuoteClient) (*models.Quote, error) {
+	quote, _, err := s.clientQuote(ctx, quoteID, client)
+	return quote, err
+}
+
+// AcceptQuote books the vendor on the quoted date, failing with
+// ErrDateUnavailable if the day was booked or blocked since the quote was
+// sent. The deposit starts pending unless the quote asked for none.
+func (s *bookingService) AcceptQuote(ctx context.Context, quoteID uuid.UUID, client models.QuoteClient) (*models.Booking, error) {
+	quote, inquiry, err := s.clientQuote(ctx, quoteID, client)
+	if err != nil {
+		return nil, err
+	}
+	if quote.Status != models.QuoteSent {
+		return nil, ErrQuoteNotOpen
+	}
+
+	booking := newBooking(quote, inquiry, time.Now())
+	if err := s.repo.AcceptQuote(ctx, quote.ID, booking); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("booking_id", booking.ID.String()).
+		Str("vendor_id", booking.VendorID.String()).
+		Str("event_date", booking.EventDate).
+		Msg("📅 Quote accepted, booking created")
+	return booking, nil
+}
+
+func (s *bookingService) DeclineQuote(ctx context.Context, quoteID uuid.UUID, client models.QuoteClient) error {
+	if _, _, err := s.clientQuote(ctx, quoteID, client); err != nil {
+		return err
+	}
+	return s.repo.DeclineQuote(ctx, quoteID)
+}
+
+// clientQuote loads a quote and its inquiry, checking the caller is the
+// client who sent the inquiry
+func (s *bookingService) clientQuote(ctx context.Context, quoteID uuid.UUID, client models.QuoteClient) (*models.Quote, *models.Inquiry, error) {
+	quote, err := s.repo.GetQuote(ctx, quoteID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inquiry, err := s.inquiries.FindByID(ctx, quote.InquiryID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if inquiry == nil {
+		return nil, nil, ErrQuoteNotFound
+	}
+	if !isInquiryClient(inquiry, client) {
+		return nil, nil, ErrNotQuoteClient
+	}
+	return quote, inquiry, nil
+}
+
+// isInquiryClient matches signed-in inquiries by user and guest inquiries
+// by the guest_id cookie they were sent with
+func isInquiryClient(inquiry *models.Inquiry, client models.QuoteClient) bool {
+	if inquiry.UserID != nil {
+		return client.UserID != nil && *client.UserID == *inquiry.UserID
+	}
+	return inquiry.GuestID != "" && client.GuestID == inquiry.GuestID
+}
+
+// buildQuote validates a quote body and totals its line items. today is the
+// current time in the booking timezone.
+func buildQuote(input models.QuoteInput, today time.Time) (*models.Quote, error) {
+	eventDate, err := time.ParseInLocation(bookingDateLayout, input.EventDate, today.Location())
+	if err != nil {
+		return nil, validationError("eventDate must be a date in YYYY-MM-DD format")
+	}
+	y, m, d := today.Date()
+	if eventDate.Before(time.Date(y, m, d, 0, 0, 0, 0, today.Location())) {
+		return nil, validationError("eventDate cannot be in the past")
+	}
+
+	if len(input.LineItems) == 0 || len(input.LineItems) > maxQuoteLineItems {
+		return nil, validationError("a quote needs between 1 and %d line items", maxQuoteLineItems)
+	}
+
+	validDays := input.ValidDays
+	if validDays == 0 {
+		validDays = defaultQuoteValidDays
+	}
+	if validDays < 1 || validDays > maxQuoteValidDays {
+		return nil, validationError("validDays must be between 1 and %d", maxQuoteValidDays)
+	}
+
+	notes := strings.TrimSpace(input.Notes)
+	if len(notes) > maxQuoteNotesLength {
+		return nil, validationError("notes must be at most %d characters", maxQuoteNotesLength)
+	}
+
+	quote := &models.Quote{
+		EventDate:  input.EventDate,
+		Notes:      notes,
+		ValidUntil: today.AddDate(0, 0, validDays),
+		LineItems:  make([]models.QuoteLineItem, 0, len(input.LineItems)),
+	}
+
+	for i, in := range input.LineItems {
+		desc := strings.TrimSpace(in.Description)
+		if desc == "" || len(desc) > maxLineItemDescLength {
+			return nil, validationError("line item %d: description must be between 1 and %d characters", i+1, maxLineItemDescLength)
+		}
+		if in.Quantity < 1 || in.Quantity > maxLineItemQuantity {
+			return nil, validationError("line item %d: quantity must be between 1 and %d", i+1, maxLineItemQuantity)
+		}
+		if in.UnitPriceKobo < 0 || in.UnitPriceKobo > maxPackagePriceKobo {
+			return nil, validationError("line item %d: unitPriceKobo is out of range", i+1)
+		}
+
+		total := in.UnitPriceKobo * int64(in.Quantity)
+		quote.SubtotalKobo += total
+		quote.LineItems = append(quote.LineItems, models.QuoteLineItem{
+			Position:      i + 1,
+			Description:   desc,
+			Quantity:      in.Quantity,
+			UnitPriceKobo: in.UnitPriceKobo,
+			TotalKobo:     total,
+		})
+	}
+
+	if quote.SubtotalKobo <= 0 {
+		return nil, validationError("a quote must total more than zero")
+	}
+	if input.DepositKobo < 0 || input.DepositKobo > quote.SubtotalKobo {
+		return nil, validationError("depositKobo must be between 0 and the quote total")
+	}
+	quote.DepositKobo = input.DepositKobo
+
+	return quote, nil
+}
+
+// newBooking copies the accepted quote and the client's details
+func newBooking(quote *models.Quote, inquiry *models.Inquiry, now time.Time) *models.Booking {
+	deposit := models.DepositPending
+	if quote.DepositKobo == 0 {
+		deposit = models.DepositNotRequired
+	}
+
+	quoteID, inquiryID := quote.ID, inquiry.ID
+	return &models.Booking{
+		ID:            uuid.New(),
+		QuoteID:       &quoteID,
+		InquiryID:     &inquiryID,
+		VendorID:      quote.VendorID,
+		UserID:        inquiry.UserID,
+		GuestID:       inquiry.GuestID,
+		ClientName:    inquiry.Name,
+		ClientEmail:   inquiry.Email,
+		EventDate:     quote.EventDate,
+		TotalKobo:     quote.SubtotalKobo,
+		DepositKobo:   quote.DepositKobo,
+		DepositStatus: deposit,
+		Status:        models.BookingConfirmed,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// ============================================================================
+// BOOKINGS
+// ============================================================================
+
+// ListBookings returns the vendor's bookings between two optional
+// YYYY-MM-DD dates
+func (s *bookingService) ListBookings(ctx context.Context, vendorID, ownerID uuid.UUID, from, to string) ([]models.Booking, error) {
+	for _, date := range []string{from, to} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(bookingDateLayout, date); err != nil {
+			return nil, validationError("from and to must be dates in YYYY-MM-DD format")
+		}
+	}
+	if err := s.checkVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return nil, err
+	}
+
+	bookings, err := s.repo.ListBookings(ctx, vendorID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if bookings == nil {
+		bookings = []models.Booking{}
+	}
+	return bookings, nil
+}
+
+// UpdateBooking moves a booking's status and/or deposit status along
+func (s *bookingService) UpdateBooking(ctx context.Context, vendorID, bookingID, ownerID uuid.UUID, update models.BookingUpdate) (*models.Booking, error) {
+	if update.Status == "" && update.DepositStatus == "" {
+		return nil, validationError("status or depositStatus is required")
+	}
+	if err := s.checkVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.repo.GetBooking(ctx, vendorID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyBookingUpdate(booking, update, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateBooking(ctx, booking); err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+// applyBookingUpdate checks each requested move is allowed and applies it.
+// Asking for the current value is a no-op.
+func applyBookingUpdate(booking *models.Booking, update models.BookingUpdate, now time.Time) error {
+	if update.Status != "" && update.Status != booking.Status {
+		if !slices.Contains(bookingTransitions[booking.Status], update.Status) {
+			return validationError("a %s booking cannot be marked %s", booking.Status, update.Status)
+		}
+		booking.Status = update.Status
+	}
+
+	if update.DepositStatus != "" && update.DepositStatus != booking.DepositStatus {
+		if !slices.Contains(depositTransitions[booking.DepositStatus], update.DepositStatus) {
+			return validationError("a %s deposit cannot be marked %s", booking.DepositStatus, update.DepositStatus)
+		}
+		booking.DepositStatus = update.DepositStatus
+		if update.DepositStatus == models.DepositPaid {
+			booking.DepositPaidAt = &now
+		}
+	}
+	return nil
+}
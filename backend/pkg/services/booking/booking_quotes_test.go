@@ -0,0 +1,139 @@
+package booking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildQuote(t *testing.T) {
+	lagos := time.FixedZone("WAT", 3600)
+	now := time.Date(2026, 3, 15, 23, 30, 0, 0, lagos)
+
+	valid := func() models.QuoteInput {
+		return models.QuoteInput{
+			InquiryID:   uuid.New(),
+			EventDate:   "2026-04-01",
+			DepositKobo: 50000,
+			LineItems: []models.QuoteLineItemInput{
+				{Description: " Photography ", Quantity: 1, UnitPriceKobo: 150000},
+				{Description: "Prints", Quantity: 20, UnitPriceKobo: 2500},
+			},
+		}
+	}
+
+	t.Run("Totals line items and defaults validity", func(t *testing.T) {
+		quote, err := buildQuote(valid(), now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(200000), quote.SubtotalKobo)
+		assert.Equal(t, int64(50000), quote.DepositKobo)
+		assert.Equal(t, now.AddDate(0, 0, defaultQuoteValidDays), quote.ValidUntil)
+		require.Len(t, quote.LineItems, 2)
+		assert.Equal(t, "Photography", quote.LineItems[0].Description)
+		assert.Equal(t, 2, quote.LineItems[1].Position)
+		assert.Equal(t, int64(50000), quote.LineItems[1].TotalKobo)
+	})
+
+	t.Run("Accepts an event later today", func(t *testing.T) {
+		in := valid()
+		in.EventDate = "2026-03-15"
+		_, err := buildQuote(in, now)
+		assert.NoError(t, err)
+	})
+
+	for name, mutate := range map[string]func(*models.QuoteInput){
+		"past date":          func(in *models.QuoteInput) { in.EventDate = "2026-03-14" },
+		"bad date":           func(in *models.QuoteInput) { in.EventDate = "15/03/2026" },
+		"no line items":      func(in *models.QuoteInput) { in.LineItems = nil },
+		"zero quantity":      func(in *models.QuoteInput) { in.LineItems[0].Quantity = 0 },
+		"blank description":  func(in *models.QuoteInput) { in.LineItems[0].Description = "  " },
+		"deposit over total": func(in *models.QuoteInput) { in.DepositKobo = 300000 },
+		"free quote": func(in *models.QuoteInput) {
+			in.DepositKobo = 0
+			in.LineItems = []models.QuoteLineItemInput{{Description: "Consultation", Quantity: 1}}
+		},
+		"validity too long": func(in *models.QuoteInput) { in.ValidDays = maxQuoteValidDays + 1 },
+	} {
+		t.Run("Rejects "+name, func(t *testing.T) {
+			in := valid()
+			mutate(&in)
+			_, err := buildQuote(in, now)
+			var appErr *utils.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, utils.ErrCategoryValidation, appErr.Category)
+		})
+	}
+}
+
+func TestIsInquiryClient(t *testing.T) {
+	userID, otherID := uuid.New(), uuid.New()
+
+	signedIn := &models.Inquiry{UserID: &userID, GuestID: "guest-1"}
+	assert.True(t, isInquiryClient(signedIn, models.QuoteClient{UserID: &userID}))
+	assert.False(t, isInquiryClient(signedIn, models.QuoteClient{UserID: &otherID, GuestID: "guest-1"}),
+		"a signed-in inquiry can't be claimed by cookie")
+
+	guest := &models.Inquiry{GuestID: "guest-1"}
+	assert.True(t, isInquiryClient(guest, models.QuoteClient{UserID: &otherID, GuestID: "guest-1"}))
+	assert.False(t, isInquiryClient(guest, models.QuoteClient{GuestID: "guest-2"}))
+	assert.False(t, isInquiryClient(&models.Inquiry{}, models.QuoteClient{}))
+}
+
+func TestNewBookingDepositStatus(t *testing.T) {
+	inquiry := &models.Inquiry{ID: uuid.New(), Name: "Ada", Email: "ada@example.com"}
+
+	booking := newBooking(&models.Quote{ID: uuid.New(), SubtotalKobo: 100000, DepositKobo: 30000}, inquiry, time.Now())
+	assert.Equal(t, models.DepositPending, booking.DepositStatus)
+	assert.Equal(t, models.BookingConfirmed, booking.Status)
+	assert.Equal(t, "Ada", booking.ClientName)
+
+	booking = newBooking(&models.Quote{ID: uuid.New(), SubtotalKobo: 100000}, inquiry, time.Now())
+	assert.Equal(t, models.DepositNotRequired, booking.DepositStatus)
+}
+
+func TestApplyBookingUpdate(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Marks a pending deposit paid", func(t *testing.T) {
+		b := &models.Booking{Status: models.BookingConfirmed, DepositStatus: models.DepositPending}
+		require.NoError(t, applyBookingUpdate(b, models.BookingUpdate{DepositStatus: models.DepositPaid}, now))
+		assert.Equal(t, models.DepositPaid, b.DepositStatus)
+		require.NotNil(t, b.DepositPaidAt)
+	})
+
+	t.Run("Repeating the current status is a no-op", func(t *testing.T) {
+		b := &models.Booking{Status: models.BookingCompleted, DepositStatus: models.DepositPaid}
+		assert.NoError(t, applyBookingUpdate(b, models.BookingUpdate{Status: models.BookingCompleted}, now))
+	})
+
+	for name, tc := range map[string]struct {
+		booking models.Booking
+		update  models.BookingUpdate
+	}{
+		"refunding an unpaid deposit": {
+			models.Booking{Status: models.BookingConfirmed, DepositStatus: models.DepositPending},
+			models.BookingUpdate{DepositStatus: models.DepositRefunded},
+		},
+		"reopening a cancelled booking": {
+			models.Booking{Status: models.BookingCancelled, DepositStatus: models.DepositNotRequired},
+			models.BookingUpdate{Status: models.BookingConfirmed},
+		},
+		"an unknown status": {
+			models.Booking{Status: models.BookingConfirmed, DepositStatus: models.DepositPending},
+			models.BookingUpdate{Status: "postponed"},
+		},
+	} {
+		t.Run("Rejects "+name, func(t *testing.T) {
+			b := tc.booking
+			err := applyBookingUpdate(&b, tc.update, now)
+			var appErr *utils.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, utils.ErrCategoryValidation, appErr.Category)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// backend/pkg/services/booking/booking_services.go
+
+package booking
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	repobooking "github.com/eventify/backend/pkg/repository/booking"
+	repoinquiries "github.com/eventify/backend/pkg/repository/inquiries"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxPackageNameLength  = 120
+	maxPackageDescLength  = 2000
+	maxPackageInclusions  = 30
+	maxInclusionLength    = 200
+	maxPackagePriceKobo   = 1_000_000_000_000 // ₦10bn, well past any real package
+	maxQuoteLineItems     = 50
+	maxLineItemQuantity   = 10_000
+	maxLineItemDescLength = 200
+	maxQuoteNotesLength   = 2000
+	defaultQuoteValidDays = 7
+	maxQuoteValidDays     = 90
+	bookingTimezone       = "Africa/Lagos"
+	bookingDateLayout     = "2006-01-02"
+)
+
+var (
+	ErrVendorNotFound  = errors.New("vendor not found")
+	ErrNotVendorOwner  = errors.New("unauthorized: vendor does not belong to this user")
+	ErrInquiryNotFound = errors.New("inquiry not found")
+	ErrNotQuoteClient  = errors.New("unauthorized: quote was not sent to this client")
+	ErrPackageNotFound = repobooking.ErrPackageNotFound
+	ErrQuoteNotFound   = repobooking.ErrQuoteNotFound
+	ErrQuoteNotOpen    = repobooking.ErrQuoteNotOpen
+	ErrInquiryBooked   = repobooking.ErrInquiryBooked
+	ErrBookingNotFound = repobooking.ErrBookingNotFound
+	ErrBookingChanged  = repobooking.ErrBookingChanged
+	ErrDateUnavailable = repobooking.ErrDateUnavailable
+)
+
+type BookingService interface {
+	ListPackages(ctx context.Context, vendorID uuid.UUID) ([]models.VendorPackage, error)
+	CreatePackage(ctx context.Context, vendorID, ownerID uuid.UUID, input models.VendorPackageInput) (*models.VendorPackage, error)
+	UpdatePackage(ctx context.Context, vendorID, packageID, ownerID uuid.UUID, input models.VendorPackageInput) (*models.VendorPackage, error)
+	DeletePackage(ctx context.Context, vendorID, packageID, ownerID uuid.UUID) error
+
+	CreateQuote(ctx context.Context, vendorID, ownerID uuid.UUID, input models.QuoteInput) (*models.Quote, error)
+	ListInquiryQuotes(ctx context.Context, vendorID, ownerID, inquiryID uuid.UUID) ([]models.Quote, error)
+	GetClientQuote(ctx context.Context, quoteID uuid.UUID, client models.QuoteClient) (*models.Quote, error)
+	AcceptQuote(ctx context.Context, quoteID uuid.UUID, client models.QuoteClient) (*models.Booking, error)
+	DeclineQuote(ctx context.Context, quoteID uuid.UUID, client models.QuoteClient) error
+
+	ListBookings(ctx context.Context, vendorID, ownerID uuid.UUID, from, to string) ([]models.Booking, error)
+	UpdateBooking(ctx context.Context, vendorID, bookingID, ownerID uuid.UUID, update models.BookingUpdate) (*models.Booking, error)
+
+	GetAvailability(ctx context.Context, vendorID uuid.UUID, viewerID *uuid.UUID, from, to string) (*models.VendorAvailability, error)
+	BlockDates(ctx context.Context, vendorID, ownerID uuid.UUID, input models.DateBlockInput) (int64, error)
+	UnblockDates(ctx context.Context, vendorID, ownerID uuid.UUID, input models.DateBlockInput) (int64, error)
+}
+
+type bookingService struct {
+	repo      repobooking.BookingRepository
+	inquiries repoinquiries.InquiryReadRepository
+	loc       *time.Location
+}
+
+func NewBookingService(
+	repo repobooking.BookingRepository,
+	inquiries repoinquiries.InquiryReadRepository,
+) BookingService {
+	loc, err := time.LoadLocation(bookingTimezone)
+	if err != nil {
+		loc = time.FixedZone("WAT", 3600)
+	}
+	return &bookingService{repo: repo, inquiries: inquiries, loc: loc}
+}
+
+// checkVendorOwner confirms the vendor exists and belongs to ownerID
+func (s *bookingService) checkVendorOwner(ctx context.Context, vendorID, ownerID uuid.UUID) error {
+	owner, err := s.repo.GetVendorOwnerID(ctx, vendorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrVendorNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if owner != ownerID {
+		return ErrNotVendorOwner
+	}
+	return nil
+}
+
+// ============================================================================
+// PACKAGES
+// ============================================================================
+
+// ListPackages returns a vendor's published packages, cheapest first
+func (s *bookingService) ListPackages(ctx context.Context, vendorID uuid.UUID) ([]models.VendorPackage, error) {
+	if _, err := s.repo.GetVendorOwnerID(ctx, vendorID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrVendorNotFound
+		}
+		return nil, err
+	}
+	packages, err := s.repo.ListPackages(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+	if packages == nil {
+		packages = []models.VendorPackage{}
+	}
+	return packages, nil
+}
+
+func (s *bookingService) CreatePackage(ctx context.Context, vendorID, ownerID uuid.UUID, input models.VendorPackageInput) (*models.VendorPackage, error) {
+	pkg, err := buildPackage(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	pkg.ID = uuid.New()
+	pkg.VendorID = vendorID
+	pkg.CreatedAt = now
+	pkg.UpdatedAt = now
+
+	if err := s.repo.CreatePackage(ctx, pkg); err != nil {
+		return nil, err
+	}
+	return pkg, nil
+}
+
+// UpdatePackage replaces the package's details with input
+func (s *bookingService) UpdatePackage(ctx context.Context, vendorID, packageID, ownerID uuid.UUID, input models.VendorPackageInput) (*models.VendorPackage, error) {
+	pkg, err := buildPackage(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetPackage(ctx, vendorID, packageID)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg.ID = existing.ID
+	pkg.VendorID = existing.VendorID
+	pkg.CreatedAt = existing.CreatedAt
+	pkg.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdatePackage(ctx, pkg); err != nil {
+		return nil, err
+	}
+	return pkg, nil
+}
+
+// DeletePackage removes the package; quotes built from it keep their line items
+func (s *bookingService) DeletePackage(ctx context.Context, vendorID, packageID, ownerID uuid.UUID) error {
+	if err := s.checkVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return err
+	}
+	return s.repo.DeletePackage(ctx, vendorID, packageID)
+}
+
+// buildPackage validates and tidies a package body
+func buildPackage(input models.VendorPackageInput) (*models.VendorPackage, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > maxPackageNameLength {
+		return nil, validationError("name must be between 1 and %d characters", maxPackageNameLength)
+	}
+	description := strings.TrimSpace(input.Description)
+	if len(description) > maxPackageDescLength {
+		return nil, validationError("description must be at most %d characters", maxPackageDescLength)
+	}
+	if input.PriceMinKobo < 0 || input.PriceMinKobo > maxPackagePriceKobo {
+		return nil, validationError("priceMinKobo is out of range")
+	}
+	if input.PriceMaxKobo != nil && (*input.PriceMaxKobo < input.PriceMinKobo || *input.PriceMaxKobo > maxPackagePriceKobo) {
+		return nil, validationError("priceMaxKobo must be at least priceMinKobo")
+	}
+	if len(input.Inclusions) > maxPackageInclusions {
+		return nil, validationError("a package can list at most %d inclusions", maxPackageInclusions)
+	}
+
+	inclusions := make([]string, 0, len(input.Inclusions))
+	for _, inc := range input.Inclusions {
+		inc = strings.TrimSpace(inc)
+		if inc == "" {
+			continue
+		}
+		if len(inc) > maxInclusionLength {
+			return nil, validationError("each inclusion must be at most %d characters", maxInclusionLength)
+		}
+		inclusions = append(inclusions, inc)
+	}
+
+	return &models.VendorPackage{
+		Name:         name,
+		Description:  description,
+		PriceMinKobo: input.PriceMinKobo,
+		PriceMaxKobo: input.PriceMaxKobo,
+		Inclusions:   inclusions,
+	}, nil
+}
+
+func validationError(format string, args ...interface{}) error {
+	return utils.NewError(utils.ErrCategoryValidation, fmt.Sprintf(format, args...), nil)
+}
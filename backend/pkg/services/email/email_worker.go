@@ -80,6 +80,7 @@ func (w *EmailWorker) sendEmail(entry models.EmailOutbox) error {
 
 	// 2. Build the message body based on the template type
 	var body string
+	var attachments []utils.EmailAttachment
 	switch entry.TemplateType {
 	case "TICKET_DELIVERY":
 		userName := payload["user_name"]
@@ -91,10 +92,29 @@ func (w *EmailWorker) sendEmail(entry models.EmailOutbox) error {
 			"Hello %s,\n\nYour payment for %s was successful!\nOrder Reference: %s\n\nYour Ticket Codes:\n%v\n\nEnjoy the event!\n- The Eventify Team",
 			userName, eventTitle, orderRef, ticketCodes,
 		)
+
+		// Add-to-calendar file built when the order was finalized
+		if ics, ok := payload["calendar_ics"].(string); ok && ics != "" {
+			attachments = append(attachments, utils.EmailAttachment{
+				Filename:    "event.ics",
+				ContentType: "text/calendar; charset=utf-8; method=PUBLISH",
+				Content:     []byte(ics),
+			})
+		}
+	case "EVENT_CANCELLED":
+		body = fmt.Sprintf(
+			"Hello %s,\n\nWe're sorry: %s (%s) has been cancelled by the organizer.\nReason: %v\n\nA refund for order %s has been started automatically and will be sent back to your original payment method.\n\n- The Eventify Team",
+			payload["user_name"], payload["event_title"], payload["event_date"], payload["reason"], payload["order_ref"],
+		)
+	case "EVENT_POSTPONED":
+		body = fmt.Sprintf(
+			"Hello %s,\n\n%s has moved from %s to %s.\nReason: %v\n\nYour tickets (order %s) remain valid for the new date. If you can no longer attend, you can request a refund until %s.\n\n- The Eventify Team",
+			payload["user_name"], payload["event_title"], payload["old_date"], payload["new_date"], payload["reason"], payload["order_ref"], payload["refund_deadline"],
+		)
 	default:
 		body = fmt.Sprintf("Generic notification: %v", payload)
 	}
 
 	// 3. Call our Mock utility (later this becomes utils.SendEmail)
-	return utils.MockSendEmail(entry.RecipientEmail, entry.Subject, body)
+	return utils.MockSendEmailWithAttachments(entry.RecipientEmail, entry.Subject, body, attachments)
 }
\ No newline at end of file
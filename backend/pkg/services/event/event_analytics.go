@@ -47,17 +47,26 @@ func (s *eventService) GetEventAnalytics(
 		return nil, errors.New("unauthorized: you don't own this event")
 	}
 
-	// Query ticket tier data
+	// Sales come from the analytics read model, so these numbers agree with
+	// the organizer analytics endpoints. Availability is the live inventory
+	// count, which the read model can lag behind.
 	query := `
 		SELECT 
-			name,
-			price_kobo,
-			capacity,
-			sold,
-			available
-		FROM ticket_tiers
-		WHERE event_id = $1
-		ORDER BY price_kobo DESC
+			tt.name,
+			tt.price_kobo,
+			tt.capacity,
+			tt.available,
+			COALESCE(f.tickets_sold, 0) as sold,
+			COALESCE(f.revenue_kobo, 0) as revenue_kobo
+		FROM ticket_tiers tt
+		LEFT JOIN (
+			SELECT ticket_tier_id, SUM(tickets_sold) as tickets_sold, SUM(revenue_kobo) as revenue_kobo
+			FROM analytics_tier_daily
+			WHERE event_id = $1
+			GROUP BY ticket_tier_id
+		) f ON f.ticket_tier_id = tt.id
+		WHERE tt.event_id = $1
+		ORDER BY tt.price_kobo DESC
 	`
 
 	rows, err := s.db.QueryContext(ctx, query, eventID)
@@ -73,21 +82,23 @@ func (s *eventService) GetEventAnalytics(
 
 	for rows.Next() {
 		var tierName string
-		var priceKobo, capacity, sold, available int32
+		var priceKobo, capacity, available, sold int32
+		var revenueKobo int64
 
 		err := rows.Scan(
 			&tierName,
 			&priceKobo,
 			&capacity,
-			&sold,
 			&available,
+			&sold,
+			&revenueKobo,
 		)
 		if err != nil {
 			return nil, err
 		}
 
 		// Convert Kobo to Naira for financial calculations
-		tierRevenueNaira := (float64(sold) * float64(priceKobo)) / 100.0
+		tierRevenueNaira := float64(revenueKobo) / 100.0
 		tierPriceNaira := float64(priceKobo) / 100.0
 
 		tierStats := TierStats{
@@ -102,7 +113,7 @@ func (s *eventService) GetEventAnalytics(
 		tierBreakdown = append(tierBreakdown, tierStats)
 		totalInventory += capacity
 		totalSold += sold
-		totalRevenueKobo += int64(sold) * int64(priceKobo)
+		totalRevenueKobo += revenueKobo
 	}
 
 	// Calculate conversion rate
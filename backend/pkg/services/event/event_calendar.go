@@ -0,0 +1,60 @@
+// backend/pkg/services/event/event_calendar.go
+
+package event
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+)
+
+// ============================================================================
+// CALENDAR EXPORT
+// ============================================================================
+
+// GetEventCalendar renders a single event as an .ics file
+func (s *eventService) GetEventCalendar(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID, token string) ([]byte, *models.Event, error) {
+	event, err := s.loadPublicEvent(ctx, eventID, userID, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	return utils.BuildICalendar(event.EventTitle, []utils.CalendarEvent{event.CalendarEvent()}), event, nil
+}
+
+// GetCalendarLinks returns the add-to-calendar links shown on the event page.
+// Unlisted events carry the share token on the .ics link so the download works.
+func (s *eventService) GetCalendarLinks(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID, token string) (*models.CalendarLinks, error) {
+	event, err := s.loadPublicEvent(ctx, eventID, userID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	ics := "/events/" + event.ID.String() + "/calendar.ics"
+	if token != "" && event.Status == models.EventStatusUnlisted {
+		ics += "?token=" + url.QueryEscape(token)
+	}
+
+	entry := event.CalendarEvent()
+	return &models.CalendarLinks{
+		ICS:     ics,
+		Google:  utils.GoogleCalendarURL(entry),
+		Outlook: utils.OutlookCalendarURL(entry),
+	}, nil
+}
+
+// GetUserCalendarFeed renders every event the user holds tickets for
+func (s *eventService) GetUserCalendarFeed(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	events, err := s.eventRepo.GetTicketHolderEvents(ctx, userID)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load calendar events", err)
+	}
+
+	entries := make([]utils.CalendarEvent, len(events))
+	for i := range events {
+		entries[i] = events[i].CalendarEvent()
+	}
+	return utils.BuildICalendar("My Eventify Tickets", entries), nil
+}
@@ -0,0 +1,159 @@
+// backend/pkg/services/event/event_cancellation.go
+
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	repoevent "github.com/eventify/backend/pkg/repository/event"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ============================================================================
+// CANCELLATION & POSTPONEMENT
+// ============================================================================
+
+const holderDateFormat = "Monday, Jan 02, 2006 3:04 PM"
+
+// CancelEvent cancels an event that may already have sales. Every paid order is
+// queued for a refund and every holder is emailed through the outbox.
+func (s *eventService) CancelEvent(
+	ctx context.Context,
+	eventID, organizerID uuid.UUID,
+	reason string,
+) (*models.RefundProgress, error) {
+	event, err := s.loadOwnedEvent(ctx, eventID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	if event.CancelledAt != nil {
+		return nil, utils.NewConflictError("event is already cancelled", nil)
+	}
+	reason = strings.TrimSpace(reason)
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.eventRepo.CancelEventTx(ctx, tx, eventID, reason); err != nil {
+		if errors.Is(err, repoevent.ErrEventAlreadyCancelled) {
+			return nil, utils.NewConflictError("event is already cancelled", err)
+		}
+		return nil, err
+	}
+
+	batch, err := s.eventRepo.CreateRefundBatchTx(ctx, tx, eventID, models.RefundReasonEventCancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	notified, err := s.eventRepo.QueueHolderEmailsTx(ctx, tx, eventID, "EVENT_CANCELLED",
+		fmt.Sprintf("Cancelled: %s", event.EventTitle),
+		map[string]interface{}{
+			"event_id":    eventID,
+			"event_title": event.EventTitle,
+			"event_date":  event.StartDate.In(eventLocation()).Format(holderDateFormat),
+			"reason":      reason,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit cancellation: %w", err)
+	}
+
+	log.Info().
+		Str("event_id", eventID.String()).
+		Str("batch_id", batch.ID.String()).
+		Int64("holders_notified", notified).
+		Msg("🚫 Event cancelled, refunds queued")
+
+	return s.eventRepo.GetRefundProgress(ctx, eventID)
+}
+
+// PostponeEvent moves an event to new dates. Holders keep their tickets but may
+// opt into a refund until the refund deadline.
+func (s *eventService) PostponeEvent(
+	ctx context.Context,
+	eventID, organizerID uuid.UUID,
+	req *models.PostponeEventRequest,
+) (*models.Event, error) {
+	event, err := s.loadOwnedEvent(ctx, eventID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	if event.CancelledAt != nil {
+		return nil, utils.NewConflictError("a cancelled event cannot be postponed", nil)
+	}
+	if !req.StartDate.Before(req.EndDate) {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "start date must be before end date", nil)
+	}
+	if !req.StartDate.After(event.StartDate) {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "new start date must be later than the current one", nil)
+	}
+
+	window := req.RefundWindowDays
+	if window == 0 {
+		window = models.DefaultRefundWindowDays
+	}
+	deadline := time.Now().AddDate(0, 0, window)
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.eventRepo.PostponeEventTx(ctx, tx, eventID, req.StartDate, req.EndDate, deadline); err != nil {
+		if errors.Is(err, repoevent.ErrEventAlreadyCancelled) {
+			return nil, utils.NewConflictError("a cancelled event cannot be postponed", err)
+		}
+		return nil, err
+	}
+
+	loc := eventLocation()
+	notified, err := s.eventRepo.QueueHolderEmailsTx(ctx, tx, eventID, "EVENT_POSTPONED",
+		fmt.Sprintf("New date: %s", event.EventTitle),
+		map[string]interface{}{
+			"event_id":        eventID,
+			"event_title":     event.EventTitle,
+			"old_date":        event.StartDate.In(loc).Format(holderDateFormat),
+			"new_date":        req.StartDate.In(loc).Format(holderDateFormat),
+			"reason":          strings.TrimSpace(req.Reason),
+			"refund_deadline": deadline.In(loc).Format(holderDateFormat),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit postponement: %w", err)
+	}
+
+	log.Info().
+		Str("event_id", eventID.String()).
+		Time("new_start", req.StartDate).
+		Time("refund_deadline", deadline).
+		Int64("holders_notified", notified).
+		Msg("📅 Event postponed")
+
+	return s.eventRepo.GetEventByID(ctx, eventID, nil)
+}
+
+// GetRefundProgress reports how far the refunds for an event have got
+func (s *eventService) GetRefundProgress(ctx context.Context, eventID, organizerID uuid.UUID) (*models.RefundProgress, error) {
+	if err := s.verifyEventOwnership(ctx, eventID, organizerID); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.GetRefundProgress(ctx, eventID)
+}
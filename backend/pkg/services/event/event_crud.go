@@ -12,6 +12,7 @@ import (
 	repoevent "github.com/eventify/backend/pkg/repository/event"
 	"github.com/eventify/backend/pkg/utils"
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 )
 
@@ -29,8 +30,12 @@ func (s *eventService) CreateEvent(
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
-	// Generate slug from title
-	event.EventSlug = models.ToNullString(utils.GenerateSlug(event.EventTitle))
+	if err := s.resolveEventImage(ctx, event); err != nil {
+		return err
+	}
+
+	// Best effort: a venue we can't place just won't show up in near-me searches
+	s.geocodeVenue(ctx, event)
 
 	if len(tiers) == 0 {
 		return errors.New("at least one ticket tier is required")
@@ -54,6 +59,18 @@ func (s *eventService) CreateEvent(
 	event.CreatedAt = now
 	event.UpdatedAt = now
 
+	// Unlisted events are shared by link, so they need a token from the start
+	if err := ensureShareToken(event); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Unique slug from the title; collisions get a -2, -3... suffix
+	if err := s.assignSlugTx(ctx, tx, event); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to assign slug: %w", err)
+	}
+
 	// Create event
 	confirmedEventID, err := s.eventRepo.CreateEvent(ctx, tx, event)
 	if err != nil {
@@ -101,6 +118,11 @@ func (s *eventService) GetEventByID(
 		return nil, err
 	}
 	
+	// Sessions and tier access for multi-day events
+	if err := s.attachSchedule(ctx, event); err != nil {
+		log.Printf("⚠️ [eventService.GetEventByID] Failed to load schedule: %v", err)
+	}
+
 	// Verify price conversion in service layer
 	log.Printf("🔍 [eventService.GetEventByID] Verifying ticket prices:")
 	
@@ -148,13 +170,29 @@ func (s *eventService) GetEventByID(
 	return event, nil
 }
 
-// GetAllEvents retrieves all public events with filters
+// GetAllEvents retrieves one page of public events with filters and the total match count
 func (s *eventService) GetAllEvents(
 	ctx context.Context,
 	filters repoevent.EventFilters,
-) ([]*models.Event, error) {
+) ([]*models.Event, *models.PageInfo, error) {
 	filters.IsDeleted = false
-	return s.eventRepo.GetEvents(ctx, filters)
+	published := models.EventStatusPublished
+	filters.Status = &published
+
+	events, nextCursor, err := s.eventRepo.GetEventsPage(ctx, filters)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidCursor) {
+			return nil, nil, utils.NewError(utils.ErrCategoryValidation, "invalid or expired cursor", err)
+		}
+		return nil, nil, err
+	}
+
+	total, err := s.eventRepo.CountEvents(ctx, filters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, &models.PageInfo{NextCursor: nextCursor, Total: total, Limit: filters.Limit}, nil
 }
 
 // GetEventsByOrganizer retrieves events for a specific organizer
@@ -164,9 +202,10 @@ func (s *eventService) GetEventsByOrganizer(
 	includeDeleted bool,
 ) ([]*models.Event, error) {
 	filters := repoevent.EventFilters{
-		OrganizerID: &organizerID,
-		IsDeleted:   includeDeleted,
-		Limit:       100,
+		OrganizerID:      &organizerID,
+		IsDeleted:        includeDeleted,
+		IncludeCancelled: true,
+		Limit:            100,
 	}
 	return s.eventRepo.GetEvents(ctx, filters)
 }
@@ -189,12 +228,35 @@ func (s *eventService) UpdateEvent(
 		return nil, errors.New("cannot update a deleted event")
 	}
 
+	if err := s.prepareImageUpdate(ctx, existing, updates); err != nil {
+		return nil, err
+	}
+
 	tx, err := s.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback()
 
+	if err := s.updateEventTx(ctx, tx, existing, updates); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit update: %w", err)
+	}
+
+	return s.eventRepo.GetEventByID(ctx, eventID, nil)
+}
+
+// updateEventTx applies a DTO to an already-loaded event inside the caller's transaction.
+// Shared by single-event updates and "edit all future" series updates.
+func (s *eventService) updateEventTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	existing *models.Event,
+	updates *EventUpdateDTO,
+) error {
 	// 1. Business Rule: Guard against modification of tiers with existing sales
 	if updates.Tickets != nil {
 		for _, updatedTier := range updates.Tickets {
@@ -202,33 +264,46 @@ func (s *eventService) UpdateEvent(
 				// If IDs match and sales exist, check if sensitive fields are being changed
 				if updatedTier.ID == existingTier.ID && existingTier.Sold > 0 {
 					if updatedTier.PriceKobo != existingTier.PriceKobo || updatedTier.Name != existingTier.Name {
-						return nil, errors.New("cannot change price or name of a ticket tier that has already started selling")
+						return errors.New("cannot change price or name of a ticket tier that has already started selling")
 					}
 				}
 			}
 		}
 	}
 
-	// 2. Apply updates (Slug generation happens inside applyUpdatesToModel)
+	// 2. Apply updates; a new title means a new slug, with the old one kept as a redirect
+	oldSlug := existing.EventSlug.String
 	updatedModel := s.applyUpdatesToModel(existing, updates)
 	updatedModel.UpdatedAt = time.Now()
 
+	if updates.EventTitle != nil || (updates.StartDate != nil && updatedModel.RecurrenceID != nil) {
+		if err := s.refreshSlugTx(ctx, tx, updatedModel, oldSlug); err != nil {
+			return fmt.Errorf("failed to update slug: %w", err)
+		}
+	}
+
+	// A moved venue needs fresh coordinates unless the organizer pinned them
+	if updates.venueChanged() && updates.Latitude == nil && updates.Longitude == nil {
+		updatedModel.Latitude, updatedModel.Longitude = nil, nil
+		s.geocodeVenue(ctx, updatedModel)
+	}
+
+	if !updatedModel.ReentryPolicy.IsValid() {
+		return errors.New("reentry policy must be 'single' or 'in_out'")
+	}
+
 	if err := s.eventRepo.UpdateEvent(ctx, tx, updatedModel); err != nil {
-		return nil, fmt.Errorf("failed to update event record: %w", err)
+		return fmt.Errorf("failed to update event record: %w", err)
 	}
 
 	// 3. Sync Tiers (Note: We removed the *100 loop because it's now in the handler/DTO mapping)
 	if updates.Tickets != nil {
-		if err := s.eventRepo.SyncTicketTiers(ctx, tx, eventID, updates.Tickets); err != nil {
-			return nil, fmt.Errorf("failed to sync ticket tiers: %w", err)
+		if err := s.eventRepo.SyncTicketTiers(ctx, tx, existing.ID, updates.Tickets); err != nil {
+			return fmt.Errorf("failed to sync ticket tiers: %w", err)
 		}
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit update: %w", err)
-	}
-
-	return s.eventRepo.GetEventByID(ctx, eventID, nil)
+	return nil
 }
 
 // SoftDeleteEvent marks an event as deleted if no tickets have been sold
@@ -259,7 +334,7 @@ func (s *eventService) SoftDeleteEvent(
 				Str("event_id", eventID.String()).
 				Int32("sold_count", tier.Sold).
 				Msg("Service: Blocked deletion of event with active sales")
-			return errors.New("cannot delete event: tickets have already been sold. Please contact support for cancellations")
+			return errors.New("cannot delete event: tickets have already been sold. Cancel the event instead to refund ticket holders")
 		}
 	}
 
@@ -275,17 +350,19 @@ func (s *eventService) SoftDeleteEvent(
 // applyUpdatesToModel maps DTO patches to the Event model
 func (s *eventService) applyUpdatesToModel(m *models.Event, u *EventUpdateDTO) *models.Event {
     // 1. Logic for Fields that trigger Side Effects
+    // The slug follows the title, but needs the transaction: see refreshSlugTx
     if u.EventTitle != nil {
         m.EventTitle = *u.EventTitle
-        // Slugs use NullString in DB, so we use your helper
-        m.EventSlug = models.ToNullString(utils.GenerateSlug(*u.EventTitle))
     }
 
     // 2. Logic for Standard Pointers (Direct Assignment)
     if u.EventDescription != nil { m.EventDescription = *u.EventDescription }
     if u.Category != nil { m.Category = *u.Category }
     if u.EventType != nil { m.EventType = *u.EventType }
-    if u.EventImageURL != nil { m.EventImageURL = *u.EventImageURL }
+    if u.EventImageURL != nil && *u.EventImageURL != m.EventImageURL {
+        m.EventImageURL = *u.EventImageURL
+        m.ImageAssetID = u.ImageAssetID // a bare URL change drops the old asset link
+    }
     
     // These match the *string type in your models.Event
     if u.VenueName != nil { m.VenueName = u.VenueName }
@@ -296,11 +373,14 @@ func (s *eventService) applyUpdatesToModel(m *models.Event, u *EventUpdateDTO) *
     
     if u.VirtualPlatform != nil { m.VirtualPlatform = u.VirtualPlatform }
     if u.MeetingLink != nil { m.MeetingLink = u.MeetingLink }
+    if u.Latitude != nil { m.Latitude = u.Latitude }
+    if u.Longitude != nil { m.Longitude = u.Longitude }
 
     // 3. Logic for Value Types
     if u.StartDate != nil { m.StartDate = *u.StartDate }
     if u.EndDate != nil { m.EndDate = *u.EndDate }
     if u.MaxAttendees != nil { m.MaxAttendees = u.MaxAttendees }
+    if u.ReentryPolicy != nil { m.ReentryPolicy = *u.ReentryPolicy }
 
     // 4. Logic for Slices (Dereferencing the DTO pointer)
     if u.Tags != nil {
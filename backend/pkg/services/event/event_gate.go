@@ -0,0 +1,442 @@
+// backend/pkg/services/event/event_gate.go
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ============================================================================
+// OFFLINE GATE MANIFEST
+// ============================================================================
+
+// ExportGateManifest builds a signed snapshot of every ticket code for an event
+// so scanner devices can validate entries without connectivity.
+func (s *eventService) ExportGateManifest(
+	ctx context.Context,
+	eventID, organizerID uuid.UUID,
+) (*models.GateManifest, error) {
+	if err := s.verifyEventOwnership(ctx, eventID, organizerID); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.eventRepo.GetGateManifestEntries(ctx, eventID)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to build gate manifest", err)
+	}
+
+	for i := range entries {
+		entries[i].Status = models.ManifestStatusFromTicket(models.TicketStatus(entries[i].Status))
+	}
+	if entries == nil {
+		entries = []models.GateManifestEntry{}
+	}
+
+	manifest := &models.GateManifest{
+		EventID:     eventID,
+		GeneratedAt: time.Now().UTC().Truncate(time.Second),
+		Count:       len(entries),
+		Entries:     entries,
+	}
+	manifest.Signature = utils.SignGateManifest(canonicalManifestPayload(manifest))
+
+	log.Info().
+		Str("event_id", eventID.String()).
+		Int("entries", manifest.Count).
+		Msg("📦 Gate manifest exported")
+
+	return manifest, nil
+}
+
+// canonicalManifestPayload is the exact byte sequence that gets signed:
+//
+//	<eventId>\n<generatedAt unix seconds>\n<code>:<status>\n...
+//
+// A line format is used instead of JSON so scanner apps can rebuild it
+// without worrying about key ordering or whitespace.
+func canonicalManifestPayload(m *models.GateManifest) []byte {
+	var b strings.Builder
+	b.WriteString(m.EventID.String())
+	b.WriteByte('\n')
+	b.WriteString(strconv.FormatInt(m.GeneratedAt.Unix(), 10))
+	for _, e := range m.Entries {
+		b.WriteByte('\n')
+		b.WriteString(e.Code)
+		b.WriteByte(':')
+		b.WriteString(e.Status)
+	}
+	return []byte(b.String())
+}
+
+// ============================================================================
+// OFFLINE SCAN SYNC
+// ============================================================================
+
+// SyncGateScans applies a batch of offline scan logs under the same tier
+// access and re-entry rules as online check-ins. Within a batch, scans of a
+// ticket are taken earliest first, then by gate ID, then device ID. An
+// admission already on record always stands; later uploads of earlier scans
+// are reported as duplicates rather than rewriting it.
+func (s *eventService) SyncGateScans(
+	ctx context.Context,
+	organizerID uuid.UUID,
+	req *models.GateSyncRequest,
+) (*models.GateSyncResponse, error) {
+	if err := s.verifyEventOwnership(ctx, req.EventID, organizerID); err != nil {
+		return nil, err
+	}
+
+	scans := normalizeGateScans(req.Scans, req.DeviceID)
+
+	codes := make([]string, 0, len(scans))
+	seen := make(map[string]bool, len(scans))
+	for _, scan := range scans {
+		if !seen[scan.Code] {
+			seen[scan.Code] = true
+			codes = append(codes, scan.Code)
+		}
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	states, err := s.eventRepo.GetTicketStatesForUpdateTx(ctx, tx, codes)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load tickets", err)
+	}
+
+	tierIDs := make([]uuid.UUID, 0, len(states))
+	ticketIDs := make([]uuid.UUID, 0, len(states))
+	for _, state := range states {
+		if state.EventID == req.EventID {
+			tierIDs = append(tierIDs, state.TicketTierID)
+			ticketIDs = append(ticketIDs, state.ID)
+		}
+	}
+	access, err := s.eventRepo.GetTierDayAccessTx(ctx, tx, tierIDs)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load tier access", err)
+	}
+	history, err := s.eventRepo.GetGateAdmissionsTx(ctx, tx, ticketIDs)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load gate admissions", err)
+	}
+
+	results, admissions := resolveGateScans(req.EventID, scans, states, access, history)
+
+	for _, idx := range admissions {
+		scan := scans[idx]
+		if err := s.eventRepo.MarkTicketAsUsedAtTx(ctx, tx, scan.Code, scan.ScannedAt, scan.DeviceID, scan.GateID); err != nil {
+			return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to apply offline check-in", err)
+		}
+	}
+
+	// Every uploaded scan lands in the audit log, except logs that were already synced before
+	for _, r := range results {
+		if r.Outcome == models.GateScanAccepted && r.Reason != "" {
+			continue
+		}
+		if err := s.eventRepo.InsertTicketScanTx(ctx, tx, syncResultToScan(req.EventID, r, states, organizerID)); err != nil {
+			return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to record synced scan", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit gate sync: %w", err)
+	}
+
+	resp := &models.GateSyncResponse{
+		EventID: req.EventID,
+		Results: results,
+	}
+	for _, r := range results {
+		switch r.Outcome {
+		case models.GateScanAccepted:
+			resp.Accepted++
+		case models.GateScanDuplicate:
+			resp.Duplicates++
+		case models.GateScanRejected:
+			resp.Rejected++
+		}
+	}
+
+	log.Info().
+		Str("event_id", req.EventID.String()).
+		Str("device_id", req.DeviceID).
+		Int("accepted", resp.Accepted).
+		Int("duplicates", resp.Duplicates).
+		Int("rejected", resp.Rejected).
+		Msg("🔄 Offline gate scans synced")
+
+	return resp, nil
+}
+
+// syncResultToScan converts a resolved offline scan into an audit log row
+func syncResultToScan(
+	eventID uuid.UUID,
+	r models.GateScanResult,
+	states map[string]models.GateTicketState,
+	organizerID uuid.UUID,
+) *models.TicketScan {
+	scan := &models.TicketScan{
+		TicketCode: clipScanField(r.Code),
+		Direction:  models.ScanIn,
+		Result:     models.ScanGranted,
+		DeviceID:   optionalString(r.DeviceID),
+		GateID:     optionalString(r.GateID),
+		StaffID:    &organizerID,
+		EventID:    &eventID,
+		ScannedAt:  r.ScannedAt,
+	}
+	if state, ok := states[r.Code]; ok && state.EventID == eventID {
+		scan.TicketID = &state.ID
+	}
+	if r.Outcome != models.GateScanAccepted {
+		scan.Result = models.ScanDenied
+		scan.DenialReason = optionalString(r.Reason)
+	}
+	return scan
+}
+
+// normalizeGateScans fills in the uploading device and trims values so that
+// comparisons are stable. Times are truncated to Postgres precision.
+func normalizeGateScans(scans []models.GateScan, batchDeviceID string) []models.GateScan {
+	out := make([]models.GateScan, len(scans))
+	for i, scan := range scans {
+		scan.Code = strings.TrimSpace(scan.Code)
+		scan.GateID = clipScanField(strings.TrimSpace(scan.GateID))
+		scan.DeviceID = clipScanField(strings.TrimSpace(scan.DeviceID))
+		if scan.DeviceID == "" {
+			scan.DeviceID = clipScanField(strings.TrimSpace(batchDeviceID))
+		}
+		scan.ScannedAt = scan.ScannedAt.UTC().Truncate(time.Microsecond)
+		out[i] = scan
+	}
+	return out
+}
+
+// gateScanLess orders scans of the same ticket: earliest first, then gate, then device
+func gateScanLess(a, b models.GateScan) bool {
+	if !a.ScannedAt.Equal(b.ScannedAt) {
+		return a.ScannedAt.Before(b.ScannedAt)
+	}
+	if a.GateID != b.GateID {
+		return a.GateID < b.GateID
+	}
+	return a.DeviceID < b.DeviceID
+}
+
+// resolveGateScans decides the outcome of every scan without touching the database.
+// access holds each tier's day rules and history each ticket's session-less
+// gate scans since its last undo. It returns results in the same order as the
+// input and the indexes of scans that must be written as admissions.
+func resolveGateScans(
+	eventID uuid.UUID,
+	scans []models.GateScan,
+	states map[string]models.GateTicketState,
+	access map[uuid.UUID]models.TierDayAccess,
+	history map[uuid.UUID][]models.TicketScan,
+) ([]models.GateScanResult, []int) {
+	results := make([]models.GateScanResult, len(scans))
+	for i, scan := range scans {
+		results[i] = models.GateScanResult{
+			Code:      scan.Code,
+			GateID:    scan.GateID,
+			DeviceID:  scan.DeviceID,
+			ScannedAt: scan.ScannedAt,
+		}
+	}
+
+	// 1. Group scan indexes by ticket code
+	groups := make(map[string][]int)
+	var order []string
+	for i, scan := range scans {
+		if _, ok := groups[scan.Code]; !ok {
+			order = append(order, scan.Code)
+		}
+		groups[scan.Code] = append(groups[scan.Code], i)
+	}
+	sort.Strings(order)
+
+	var admissions []int
+
+	for _, code := range order {
+		idxs := groups[code]
+		sort.SliceStable(idxs, func(a, b int) bool {
+			return gateScanLess(scans[idxs[a]], scans[idxs[b]])
+		})
+
+		// 2. Reject anything the server cannot honour
+		reason := ""
+		state, found := states[code]
+		switch {
+		case len(code) > models.MaxScanFieldLength:
+			reason = "malformed ticket code"
+		case !utils.VerifyTicketOffline(code):
+			reason = "invalid ticket signature"
+		case !found || state.EventID != eventID:
+			reason = "ticket not found for this event"
+		case state.Status == models.TicketStatusCanceled:
+			reason = "ticket has been canceled"
+		}
+		if reason != "" {
+			for _, i := range idxs {
+				results[i].Outcome = models.GateScanRejected
+				results[i].Reason = reason
+			}
+			continue
+		}
+
+		// 3. Replay the scans in order against the ticket's current state
+		gate := newOfflineGate(state, access[state.TicketTierID], history[state.ID])
+		for _, i := range idxs {
+			scan := scans[i]
+			switch outcome, reason, standing := gate.admit(scan); outcome {
+			case models.GateScanAccepted:
+				results[i].Outcome = outcome
+				results[i].Reason = reason
+				if reason == "" {
+					admissions = append(admissions, i)
+				}
+			case models.GateScanDuplicate:
+				results[i].Outcome = outcome
+				results[i].Reason = reason
+				if standing != nil {
+					admittedAt := standing.ScannedAt
+					results[i].AdmittedAt = &admittedAt
+					results[i].AdmittedDevice = standing.DeviceID
+					results[i].AdmittedGate = standing.GateID
+				}
+			default:
+				results[i].Outcome = outcome
+				results[i].Reason = reason
+			}
+		}
+	}
+
+	return results, admissions
+}
+
+// offlineGate tracks one ticket while its uploaded scans are replayed.
+// Offline devices only log entries, so an accepted scan leaves the holder
+// inside until a later online exit.
+type offlineGate struct {
+	ticket   models.GateTicketState
+	tier     models.TierDayAccess
+	multiDay bool
+	history  []models.TicketScan
+	standing map[time.Time]*models.GateScan // admission that stands, per day (zero key for single-day tiers)
+}
+
+func newOfflineGate(ticket models.GateTicketState, tier models.TierDayAccess, history []models.TicketScan) *offlineGate {
+	g := &offlineGate{
+		ticket:   ticket,
+		tier:     tier,
+		multiDay: multiDayTier(tier),
+		history:  append([]models.TicketScan(nil), history...),
+		standing: make(map[time.Time]*models.GateScan),
+	}
+	if !g.multiDay && ticket.IsUsed && ticket.UsedAt != nil {
+		g.standing[time.Time{}] = &models.GateScan{
+			ScannedAt: *ticket.UsedAt,
+			DeviceID:  derefString(ticket.CheckedInDevice),
+			GateID:    derefString(ticket.CheckedInGate),
+		}
+	}
+	for _, h := range history {
+		key := g.dayKey(h.ScannedAt)
+		if h.Direction == models.ScanIn && g.standing[key] == nil {
+			g.standing[key] = &models.GateScan{
+				ScannedAt: h.ScannedAt,
+				DeviceID:  derefString(h.DeviceID),
+				GateID:    derefString(h.GateID),
+			}
+		}
+	}
+	return g
+}
+
+func (g *offlineGate) dayKey(t time.Time) time.Time {
+	if !g.multiDay {
+		return time.Time{}
+	}
+	return sessionDayOf(t)
+}
+
+// admit decides one scan. Accepted with a reason means the scan was already
+// synced and needs no write; duplicates come back with the admission that stands.
+func (g *offlineGate) admit(scan models.GateScan) (models.GateScanOutcome, string, *models.GateScan) {
+	if g.alreadySynced(scan) {
+		return models.GateScanAccepted, "already synced", nil
+	}
+
+	day := sessionDayOf(scan.ScannedAt)
+	if !g.tier.Grants(day) {
+		return models.GateScanRejected, "ticket tier does not include this day", nil
+	}
+
+	var reason string
+	if g.multiDay {
+		reason = evaluateDayScan(&g.ticket, models.ScanIn, g.history, day)
+	} else {
+		reason = evaluateScan(&g.ticket, models.ScanIn)
+	}
+	key := g.dayKey(scan.ScannedAt)
+	if reason != "" {
+		return models.GateScanDuplicate, reason, g.standing[key]
+	}
+
+	g.ticket.IsUsed, g.ticket.IsInside = true, true
+	g.history = append(g.history, models.TicketScan{
+		Direction: models.ScanIn,
+		ScannedAt: scan.ScannedAt,
+		DeviceID:  optionalString(scan.DeviceID),
+		GateID:    optionalString(scan.GateID),
+	})
+	sort.SliceStable(g.history, func(a, b int) bool { return g.history[a].ScannedAt.Before(g.history[b].ScannedAt) })
+	if g.standing[key] == nil {
+		admitted := scan
+		g.standing[key] = &admitted
+	}
+	return models.GateScanAccepted, "", nil
+}
+
+// alreadySynced spots a device re-uploading a log the server already applied
+func (g *offlineGate) alreadySynced(scan models.GateScan) bool {
+	if g.ticket.UsedAt != nil && g.ticket.UsedAt.Equal(scan.ScannedAt) &&
+		g.ticket.CheckedInDevice != nil && *g.ticket.CheckedInDevice == scan.DeviceID {
+		return true
+	}
+	for _, h := range g.history {
+		if h.Direction == models.ScanIn && h.ScannedAt.Equal(scan.ScannedAt) && derefString(h.DeviceID) == scan.DeviceID {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyEventOwnership ensures only the event organizer can manage gate and schedule data
+func (s *eventService) verifyEventOwnership(ctx context.Context, eventID, organizerID uuid.UUID) error {
+	_, err := s.loadOwnedEvent(ctx, eventID, organizerID)
+	return err
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,137 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveGateScans(t *testing.T) {
+	eventID := uuid.New()
+	code := utils.GenerateUniqueTicketCode("TIX_1700000000000_abcd1234", 0)
+	base := time.Date(2025, 12, 20, 18, 0, 0, 0, time.UTC)
+
+	t.Run("Earliest scan wins regardless of upload order", func(t *testing.T) {
+		states := map[string]models.GateTicketState{
+			code: {Code: code, EventID: eventID, Status: models.TicketStatusActive},
+		}
+		scans := []models.GateScan{
+			{Code: code, GateID: "B", DeviceID: "dev-2", ScannedAt: base.Add(2 * time.Minute)},
+			{Code: code, GateID: "A", DeviceID: "dev-1", ScannedAt: base},
+		}
+
+		results, admissions := resolveGateScans(eventID, scans, states, nil, nil)
+
+		assert.Equal(t, []int{1}, admissions)
+		assert.Equal(t, models.GateScanAccepted, results[1].Outcome)
+		assert.Equal(t, models.GateScanDuplicate, results[0].Outcome)
+		assert.Equal(t, "dev-1", results[0].AdmittedDevice)
+	})
+
+	t.Run("Same time breaks ties by gate then device", func(t *testing.T) {
+		states := map[string]models.GateTicketState{
+			code: {Code: code, EventID: eventID, Status: models.TicketStatusActive},
+		}
+		scans := []models.GateScan{
+			{Code: code, GateID: "B", DeviceID: "dev-1", ScannedAt: base},
+			{Code: code, GateID: "A", DeviceID: "dev-9", ScannedAt: base},
+		}
+
+		_, admissions := resolveGateScans(eventID, scans, states, nil, nil)
+		assert.Equal(t, []int{1}, admissions)
+	})
+
+	t.Run("Re-uploaded log is idempotent", func(t *testing.T) {
+		device := "dev-1"
+		states := map[string]models.GateTicketState{
+			code: {Code: code, EventID: eventID, Status: models.TicketStatusUsed, IsUsed: true, UsedAt: &base, CheckedInDevice: &device},
+		}
+		scans := []models.GateScan{{Code: code, DeviceID: device, ScannedAt: base}}
+
+		results, admissions := resolveGateScans(eventID, scans, states, nil, nil)
+		assert.Empty(t, admissions)
+		assert.Equal(t, models.GateScanAccepted, results[0].Outcome)
+	})
+
+	t.Run("Forged and foreign tickets are rejected", func(t *testing.T) {
+		scans := []models.GateScan{
+			{Code: "FAKE12345-001-deadbeef", DeviceID: "dev-1", ScannedAt: base},
+			{Code: code, DeviceID: "dev-1", ScannedAt: base},
+		}
+		states := map[string]models.GateTicketState{
+			code: {Code: code, EventID: uuid.New(), Status: models.TicketStatusActive},
+		}
+
+		results, admissions := resolveGateScans(eventID, scans, states, nil, nil)
+		assert.Empty(t, admissions)
+		assert.Equal(t, models.GateScanRejected, results[0].Outcome)
+		assert.Equal(t, models.GateScanRejected, results[1].Outcome)
+	})
+
+	t.Run("Earlier offline scan doesn't displace an existing admission", func(t *testing.T) {
+		device, gate := "online-1", "MAIN"
+		admitted := base.Add(10 * time.Minute)
+		states := map[string]models.GateTicketState{
+			code: {
+				Code: code, EventID: eventID, Status: models.TicketStatusUsed, IsUsed: true, IsInside: true,
+				UsedAt: &admitted, CheckedInDevice: &device, CheckedInGate: &gate,
+			},
+		}
+		scans := []models.GateScan{{Code: code, GateID: "B", DeviceID: "dev-2", ScannedAt: base}}
+
+		results, admissions := resolveGateScans(eventID, scans, states, nil, nil)
+		assert.Empty(t, admissions)
+		assert.Equal(t, models.GateScanDuplicate, results[0].Outcome)
+		assert.Equal(t, device, results[0].AdmittedDevice)
+		assert.Equal(t, gate, results[0].AdmittedGate)
+		assert.True(t, admitted.Equal(*results[0].AdmittedAt))
+	})
+
+	t.Run("In/out policy lets an exited holder back in once", func(t *testing.T) {
+		states := map[string]models.GateTicketState{
+			code: {
+				Code: code, EventID: eventID, Status: models.TicketStatusUsed, IsUsed: true,
+				UsedAt: &base, ReentryPolicy: models.ReentryInOut,
+			},
+		}
+		scans := []models.GateScan{
+			{Code: code, GateID: "A", DeviceID: "dev-1", ScannedAt: base.Add(time.Hour)},
+			{Code: code, GateID: "A", DeviceID: "dev-1", ScannedAt: base.Add(2 * time.Hour)},
+		}
+
+		results, admissions := resolveGateScans(eventID, scans, states, nil, nil)
+		assert.Equal(t, []int{0}, admissions)
+		assert.Equal(t, "ticket is already inside the venue", results[1].Reason)
+	})
+
+	t.Run("Tier day access applies offline", func(t *testing.T) {
+		tierID := uuid.New()
+		lagos := eventLocation()
+		dayOne := time.Date(2026, 5, 1, 9, 0, 0, 0, lagos)
+		dayTwo := dayOne.AddDate(0, 0, 1)
+		states := map[string]models.GateTicketState{
+			code: {Code: code, EventID: eventID, TicketTierID: tierID, Status: models.TicketStatusActive},
+		}
+		scans := []models.GateScan{
+			{Code: code, DeviceID: "dev-1", ScannedAt: dayOne},
+			{Code: code, DeviceID: "dev-1", ScannedAt: dayTwo},
+		}
+
+		dayPass := map[uuid.UUID]models.TierDayAccess{
+			tierID: {TierID: tierID, Restricted: true, Days: []time.Time{sessionDayOf(dayOne)}},
+		}
+		results, admissions := resolveGateScans(eventID, scans, states, dayPass, nil)
+		assert.Equal(t, []int{0}, admissions)
+		assert.Equal(t, models.GateScanRejected, results[1].Outcome)
+
+		twoDays := map[uuid.UUID]models.TierDayAccess{
+			tierID: {TierID: tierID, Restricted: true, Days: []time.Time{sessionDayOf(dayOne), sessionDayOf(dayTwo)}},
+		}
+		_, admissions = resolveGateScans(eventID, scans, states, twoDays, nil)
+		assert.Equal(t, []int{0, 1}, admissions, "a multi-day pass is admitted once per day")
+	})
+}
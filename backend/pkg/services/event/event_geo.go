@@ -0,0 +1,53 @@
+// backend/pkg/services/event/event_geo.go
+
+package event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/services/geocoding"
+	"github.com/rs/zerolog/log"
+)
+
+// geocodeTimeout keeps a slow geocoder from holding up event writes
+const geocodeTimeout = 3 * time.Second
+
+// geocodeVenue fills in coordinates for physical events that don't have them.
+// Failures are logged, never returned: the event still saves, it just won't
+// appear in near-me searches until the venue is geocoded.
+func (s *eventService) geocodeVenue(ctx context.Context, event *models.Event) {
+	if s.geocoder == nil || event.EventType != models.TypePhysical {
+		return
+	}
+	if event.Latitude != nil && event.Longitude != nil {
+		return
+	}
+
+	address := event.VenueQuery()
+	if address == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, geocodeTimeout)
+	defer cancel()
+
+	point, err := s.geocoder.Geocode(ctx, address)
+	if err != nil {
+		if !errors.Is(err, geocoding.ErrNoMatch) {
+			log.Warn().Err(err).Str("address", address).Msg("Venue geocoding failed")
+		}
+		return
+	}
+
+	event.Latitude = &point.Lat
+	event.Longitude = &point.Lng
+}
+
+// venueChanged reports whether the update touches any address field
+func (u *EventUpdateDTO) venueChanged() bool {
+	return u.VenueName != nil || u.VenueAddress != nil || u.City != nil ||
+		u.State != nil || u.Country != nil
+}
@@ -0,0 +1,52 @@
+// backend/pkg/services/event/event_image.go
+
+package event
+
+import (
+	"context"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+)
+
+// errImageNotHosted is returned for image URLs that didn't come through the
+// upload pipeline; hotlinked images can change or vanish under us
+var errImageNotHosted = utils.NewError(utils.ErrCategoryValidation, "event image must be uploaded first: send imageAssetId from /api/v1/media/images", nil)
+
+// resolveEventImage points a new event at its uploaded hero image. A bare
+// URL is only accepted if it's already served from our blob store.
+func (s *eventService) resolveEventImage(ctx context.Context, event *models.Event) error {
+	if event.ImageAssetID == nil {
+		if !s.media.IsHostedURL(event.EventImageURL) {
+			return errImageNotHosted
+		}
+		return nil
+	}
+
+	asset, err := s.media.ResolveImage(ctx, *event.ImageAssetID, models.AssetOwner{UserID: &event.OrganizerID}, models.AssetPurposeEvent)
+	if err != nil {
+		return err
+	}
+	event.EventImageURL = asset.URL(models.VariantHero)
+	return nil
+}
+
+// prepareImageUpdate rewrites an update's image fields before they're applied.
+// Resending the event's current URL is fine, so existing events saved with an
+// external link can still be edited without re-uploading.
+func (s *eventService) prepareImageUpdate(ctx context.Context, existing *models.Event, updates *EventUpdateDTO) error {
+	if updates.ImageAssetID == nil {
+		if updates.EventImageURL != nil && *updates.EventImageURL != existing.EventImageURL && !s.media.IsHostedURL(*updates.EventImageURL) {
+			return errImageNotHosted
+		}
+		return nil
+	}
+
+	asset, err := s.media.ResolveImage(ctx, *updates.ImageAssetID, models.AssetOwner{UserID: &existing.OrganizerID}, models.AssetPurposeEvent)
+	if err != nil {
+		return err
+	}
+	url := asset.URL(models.VariantHero)
+	updates.EventImageURL = &url
+	return nil
+}
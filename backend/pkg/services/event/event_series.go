@@ -0,0 +1,456 @@
+// backend/pkg/services/event/event_series.go
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ============================================================================
+// RECURRING EVENT SERIES
+// ============================================================================
+
+const (
+	// seriesHorizon is how far ahead occurrences are materialized
+	seriesHorizon = 180 * 24 * time.Hour
+	// maxOccurrencesPerRun caps a single materialization pass
+	maxOccurrencesPerRun = 100
+)
+
+// CreateEventSeries creates a recurring series from a regular event payload.
+// The event's start/end become the first occurrence and set the duration of all others.
+func (s *eventService) CreateEventSeries(
+	ctx context.Context,
+	event *models.Event,
+	tiers []models.TicketTier,
+	rrule string,
+) (*models.EventSeries, error) {
+	rule, err := utils.ParseRRule(rrule)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryValidation, err.Error(), err)
+	}
+
+	if err := s.validateEvent(event); err != nil {
+		return nil, utils.NewError(utils.ErrCategoryValidation, err.Error(), err)
+	}
+	if err := s.resolveEventImage(ctx, event); err != nil {
+		return nil, err
+	}
+	if len(tiers) == 0 {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "at least one ticket tier is required", nil)
+	}
+
+	template := models.SeriesTemplate{Event: *event}
+	template.Event.TicketTiers = nil
+	for _, t := range tiers {
+		template.Tiers = append(template.Tiers, models.SeriesTierTemplate{
+			Name:        t.Name,
+			Description: t.Description,
+			PriceKobo:   t.PriceKobo,
+			Capacity:    t.Capacity,
+		})
+	}
+	templateJSON, err := json.Marshal(template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode series template: %w", err)
+	}
+
+	now := time.Now()
+	dtstart := event.StartDate.In(eventLocation())
+	series := &models.EventSeries{
+		ID:                uuid.New(),
+		OrganizerID:       event.OrganizerID,
+		RRule:             rule.String(),
+		DTStart:           dtstart,
+		DurationSeconds:   int64(event.EndDate.Sub(event.StartDate).Seconds()),
+		Template:          templateJSON,
+		MaterializedUntil: dtstart.Add(-time.Second),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.eventRepo.CreateSeriesTx(ctx, tx, series); err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to create series", err)
+	}
+
+	created, err := s.materializeSeriesTx(ctx, tx, series, now.Add(seriesHorizon))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit series: %w", err)
+	}
+
+	log.Info().
+		Str("series_id", series.ID.String()).
+		Str("rrule", series.RRule).
+		Int("occurrences", created).
+		Msg("🔁 Event series created")
+
+	return s.GetEventSeries(ctx, series.ID, event.OrganizerID)
+}
+
+// GetEventSeries returns a series with all of its materialized occurrences
+func (s *eventService) GetEventSeries(ctx context.Context, seriesID, organizerID uuid.UUID) (*models.EventSeries, error) {
+	series, err := s.eventRepo.GetSeriesByID(ctx, seriesID)
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			return nil, utils.NewError(utils.ErrCategoryValidation, "series not found", err)
+		}
+		return nil, err
+	}
+	if series.OrganizerID != organizerID {
+		return nil, utils.NewError(utils.ErrCategoryAuth, "unauthorized: you don't own this series", nil)
+	}
+
+	series.Occurrences, err = s.eventRepo.GetSeriesOccurrences(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// UpdateSeriesFromOccurrence applies the same DTO as UpdateEvent to this occurrence
+// and every later one, then updates the template so future occurrences inherit the change.
+// Date changes are applied as a shift relative to this occurrence.
+func (s *eventService) UpdateSeriesFromOccurrence(
+	ctx context.Context,
+	eventID, organizerID uuid.UUID,
+	updates *EventUpdateDTO,
+) (*models.Event, error) {
+	anchor, err := s.loadOwnedEvent(ctx, eventID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	if anchor.SeriesID == nil || anchor.RecurrenceID == nil {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "event is not part of a series", nil)
+	}
+	if err := s.prepareImageUpdate(ctx, anchor, updates); err != nil {
+		return nil, err
+	}
+
+	series, err := s.eventRepo.GetSeriesByID(ctx, *anchor.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, err := s.eventRepo.GetSeriesOccurrences(ctx, series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Work out the time shift and new duration from this occurrence
+	newStart, newEnd := anchor.StartDate, anchor.EndDate
+	if updates.StartDate != nil {
+		newStart = *updates.StartDate
+		if updates.EndDate == nil {
+			newEnd = anchor.EndDate.Add(newStart.Sub(anchor.StartDate))
+		}
+	}
+	if updates.EndDate != nil {
+		newEnd = *updates.EndDate
+	}
+	if !newStart.Before(newEnd) {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "start date must be before end date", nil)
+	}
+	shift := newStart.Sub(anchor.StartDate)
+	duration := newEnd.Sub(newStart)
+
+	anchorTierNames := make(map[uuid.UUID]string, len(anchor.TicketTiers))
+	for _, t := range anchor.TicketTiers {
+		anchorTierNames[t.ID] = t.Name
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 2. Apply to this and every later, non-cancelled occurrence
+	updated := 0
+	for _, occ := range occurrences {
+		if occ.RecurrenceID.Before(*anchor.RecurrenceID) || occ.CancelledAt != nil {
+			continue
+		}
+
+		target := anchor
+		if occ.EventID != anchor.ID {
+			if target, err = s.eventRepo.GetEventByID(ctx, occ.EventID, nil); err != nil {
+				return nil, fmt.Errorf("failed to load occurrence %s: %w", occ.EventID, err)
+			}
+		}
+
+		dto := *updates
+		start := target.StartDate.Add(shift)
+		end := start.Add(duration)
+		dto.StartDate, dto.EndDate = &start, &end
+		if updates.Tickets != nil {
+			dto.Tickets = translateSeriesTiers(updates.Tickets, anchorTierNames, target.TicketTiers)
+		}
+
+		if err := s.updateEventTx(ctx, tx, target, &dto); err != nil {
+			return nil, fmt.Errorf("occurrence on %s: %w", occ.StartDate.Format("2006-01-02"), err)
+		}
+		updated++
+	}
+
+	// 3. Update the template for occurrences that don't exist yet
+	var template models.SeriesTemplate
+	if err := json.Unmarshal(series.Template, &template); err != nil {
+		return nil, fmt.Errorf("failed to decode series template: %w", err)
+	}
+	templateDTO := *updates
+	templateDTO.StartDate, templateDTO.EndDate, templateDTO.Tickets = nil, nil, nil
+	s.applyUpdatesToModel(&template.Event, &templateDTO)
+	if updates.Tickets != nil {
+		template.Tiers = template.Tiers[:0]
+		for _, t := range updates.Tickets {
+			template.Tiers = append(template.Tiers, models.SeriesTierTemplate{
+				Name:        t.Name,
+				Description: t.Description,
+				PriceKobo:   t.PriceKobo,
+				Capacity:    t.Capacity,
+			})
+		}
+	}
+	if series.Template, err = json.Marshal(template); err != nil {
+		return nil, fmt.Errorf("failed to encode series template: %w", err)
+	}
+	series.OffsetSeconds += int64(shift.Seconds())
+	series.DurationSeconds = int64(duration.Seconds())
+	series.UpdatedAt = time.Now()
+
+	if err := s.eventRepo.UpdateSeriesTx(ctx, tx, series); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit series update: %w", err)
+	}
+
+	log.Info().
+		Str("series_id", series.ID.String()).
+		Str("from_event_id", eventID.String()).
+		Int("occurrences_updated", updated).
+		Msg("🔁 Series updated from occurrence")
+
+	return s.eventRepo.GetEventByID(ctx, eventID, nil)
+}
+
+// translateSeriesTiers maps tiers edited on one occurrence onto another occurrence's tiers.
+// Tiers are matched by their name on the edited occurrence; unmatched ones are created.
+func translateSeriesTiers(
+	incoming []models.TicketTier,
+	anchorTierNames map[uuid.UUID]string,
+	targetTiers []models.TicketTier,
+) []models.TicketTier {
+	targetByName := make(map[string]models.TicketTier, len(targetTiers))
+	for _, t := range targetTiers {
+		targetByName[t.Name] = t
+	}
+
+	out := make([]models.TicketTier, len(incoming))
+	for i, t := range incoming {
+		out[i] = t
+		out[i].ID = uuid.Nil
+		out[i].Sold = 0
+
+		if name, ok := anchorTierNames[t.ID]; ok {
+			if existing, ok := targetByName[name]; ok {
+				out[i].ID = existing.ID
+				out[i].Sold = existing.Sold
+			}
+		}
+	}
+	return out
+}
+
+// CancelOccurrence cancels one date of a series without touching the others.
+// Holders of that date are refunded through the normal cancellation flow.
+func (s *eventService) CancelOccurrence(ctx context.Context, eventID, organizerID uuid.UUID) error {
+	event, err := s.loadOwnedEvent(ctx, eventID, organizerID)
+	if err != nil {
+		return err
+	}
+	if event.SeriesID == nil {
+		return utils.NewError(utils.ErrCategoryValidation, "event is not part of a series", nil)
+	}
+
+	if _, err := s.CancelEvent(ctx, eventID, organizerID, "This date has been cancelled"); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("event_id", eventID.String()).
+		Str("series_id", event.SeriesID.String()).
+		Msg("🚫 Series occurrence cancelled")
+	return nil
+}
+
+// ============================================================================
+// MATERIALIZATION
+// ============================================================================
+
+// StartSeriesMaterializer keeps open-ended series stocked with upcoming occurrences
+func (s *eventService) StartSeriesMaterializer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Series Materializer started (Interval: %v, Horizon: %v)", interval, seriesHorizon)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Series Materializer shutting down...")
+			return
+		case <-ticker.C:
+			s.MaterializeDueSeries(ctx)
+		}
+	}
+}
+
+// MaterializeDueSeries extends every series whose watermark is behind the horizon
+func (s *eventService) MaterializeDueSeries(ctx context.Context) {
+	horizon := time.Now().Add(seriesHorizon)
+
+	due, err := s.eventRepo.GetSeriesNeedingMaterialization(ctx, horizon)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch series to materialize")
+		return
+	}
+
+	for i := range due {
+		series := &due[i]
+		tx, err := s.db.BeginTxx(ctx, nil)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to start materialization transaction")
+			return
+		}
+
+		created, err := s.materializeSeriesTx(ctx, tx, series, horizon)
+		if err == nil {
+			err = tx.Commit()
+		}
+		if err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Str("series_id", series.ID.String()).Msg("Failed to materialize series")
+			continue
+		}
+
+		if created > 0 {
+			log.Info().
+				Str("series_id", series.ID.String()).
+				Int("created", created).
+				Msg("🔁 Series occurrences materialized")
+		}
+	}
+}
+
+// materializeSeriesTx creates occurrences after the series watermark up to the horizon
+func (s *eventService) materializeSeriesTx(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	series *models.EventSeries,
+	horizon time.Time,
+) (int, error) {
+	rule, err := utils.ParseRRule(series.RRule)
+	if err != nil {
+		return 0, fmt.Errorf("stored rrule is invalid: %w", err)
+	}
+
+	var template models.SeriesTemplate
+	if err := json.Unmarshal(series.Template, &template); err != nil {
+		return 0, fmt.Errorf("failed to decode series template: %w", err)
+	}
+
+	dtstart := series.DTStart.In(eventLocation())
+	times := rule.Occurrences(dtstart, series.MaterializedUntil, horizon, maxOccurrencesPerRun)
+
+	offset := time.Duration(series.OffsetSeconds) * time.Second
+	duration := time.Duration(series.DurationSeconds) * time.Second
+	now := time.Now()
+
+	for _, recurrenceID := range times {
+		rid := recurrenceID
+		occurrence := template.Event
+		occurrence.ID = uuid.New()
+		occurrence.OrganizerID = series.OrganizerID
+		occurrence.SeriesID = &series.ID
+		occurrence.RecurrenceID = &rid
+		occurrence.StartDate = rid.Add(offset)
+		occurrence.EndDate = occurrence.StartDate.Add(duration)
+		occurrence.AccessToken = nil // each unlisted occurrence gets its own share link
+		if err := ensureShareToken(&occurrence); err != nil {
+			return 0, err
+		}
+		if err := s.assignSlugTx(ctx, tx, &occurrence); err != nil {
+			return 0, fmt.Errorf("failed to assign slug for occurrence %s: %w", rid.Format(time.RFC3339), err)
+		}
+		occurrence.IsDeleted = false
+		occurrence.CreatedAt = now
+		occurrence.UpdatedAt = now
+
+		eventID, err := s.eventRepo.CreateEvent(ctx, tx, &occurrence)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create occurrence %s: %w", rid.Format(time.RFC3339), err)
+		}
+
+		tiers := make([]models.TicketTier, len(template.Tiers))
+		for i, t := range template.Tiers {
+			tiers[i] = models.TicketTier{
+				ID:          uuid.New(),
+				Name:        t.Name,
+				Description: t.Description,
+				PriceKobo:   t.PriceKobo,
+				Capacity:    t.Capacity,
+				Available:   t.Capacity,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			}
+		}
+		if err := s.eventRepo.CreateTicketTiers(ctx, tx, eventID, tiers); err != nil {
+			return 0, fmt.Errorf("failed to create occurrence tiers: %w", err)
+		}
+	}
+
+	// Move the watermark; if the run was capped, resume after the last created occurrence
+	if len(times) == maxOccurrencesPerRun {
+		series.MaterializedUntil = times[len(times)-1]
+	} else if horizon.After(series.MaterializedUntil) {
+		series.MaterializedUntil = horizon
+	}
+	series.UpdatedAt = now
+
+	if err := s.eventRepo.UpdateSeriesTx(ctx, tx, series); err != nil {
+		return 0, err
+	}
+
+	// Bounded rules with nothing left to generate never need another pass
+	if !rule.IsOpenEnded() {
+		remaining := rule.Occurrences(dtstart, series.MaterializedUntil, dtstart.AddDate(100, 0, 0), 1)
+		if len(remaining) == 0 {
+			if err := s.eventRepo.MarkSeriesCompleteTx(ctx, tx, series.ID); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return len(times), nil
+}
@@ -8,6 +8,9 @@ import (
 
 	"github.com/eventify/backend/pkg/models"
 	repoevent "github.com/eventify/backend/pkg/repository/event"
+	repolike "github.com/eventify/backend/pkg/repository/like"
+	"github.com/eventify/backend/pkg/services/geocoding"
+	servicemedia "github.com/eventify/backend/pkg/services/media"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
@@ -16,47 +19,98 @@ import (
 type EventService interface {
 	CreateEvent(ctx context.Context, event *models.Event, tiers []models.TicketTier) error
 	GetEventByID(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID) (*models.Event, error)
+	GetEventBySlug(ctx context.Context, slug string, userID *uuid.UUID, token string) (*models.Event, string, error)
 	GetEventsByOrganizer(ctx context.Context, organizerID uuid.UUID, includeDeleted bool) ([]*models.Event, error)
-	GetAllEvents(ctx context.Context, filters repoevent.EventFilters) ([]*models.Event, error)
+	GetAllEvents(ctx context.Context, filters repoevent.EventFilters) ([]*models.Event, *models.PageInfo, error)
 	UpdateEvent(ctx context.Context, eventID, organizerID uuid.UUID, updates *EventUpdateDTO) (*models.Event, error)
 	SoftDeleteEvent(ctx context.Context, eventID, organizerID uuid.UUID) error
 	GetEventAnalytics(ctx context.Context, eventID, organizerID uuid.UUID) (*EventAnalytics, error)
-	
+
 	// FIXED: Signature changed to use TierID and match implementation return types
 	CheckTicketAvailability(ctx context.Context, tierID uuid.UUID, quantity int32) (bool, error)
 	ReserveTickets(ctx context.Context, tierID uuid.UUID, quantity int32) error
-	ValidateAndCheckInTicket(ctx context.Context, ticketCode string) error
+	ValidateAndCheckInTicket(ctx context.Context, req *models.CheckInRequest) (*models.TicketScan, error)
+	UndoCheckIn(ctx context.Context, eventID, organizerID uuid.UUID, code, reason string) error
+	GetCheckInStats(ctx context.Context, eventID, organizerID uuid.UUID) (*models.CheckInStats, error)
+
+	// Sessions & tier access
+	GetEventSessions(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID, token string) ([]models.EventSession, error)
+	CreateSession(ctx context.Context, eventID, organizerID uuid.UUID, input *models.EventSessionInput) (*models.EventSession, error)
+	UpdateSession(ctx context.Context, eventID, sessionID, organizerID uuid.UUID, input *models.EventSessionInput) (*models.EventSession, error)
+	DeleteSession(ctx context.Context, eventID, sessionID, organizerID uuid.UUID) error
+	SetTierAccess(ctx context.Context, eventID, tierID, organizerID uuid.UUID, input *models.TierAccessInput) error
+
+	// Offline gate scanning
+	ExportGateManifest(ctx context.Context, eventID, organizerID uuid.UUID) (*models.GateManifest, error)
+	SyncGateScans(ctx context.Context, organizerID uuid.UUID, req *models.GateSyncRequest) (*models.GateSyncResponse, error)
+
+	// Recurring series
+	CreateEventSeries(ctx context.Context, event *models.Event, tiers []models.TicketTier, rrule string) (*models.EventSeries, error)
+	GetEventSeries(ctx context.Context, seriesID, organizerID uuid.UUID) (*models.EventSeries, error)
+	UpdateSeriesFromOccurrence(ctx context.Context, eventID, organizerID uuid.UUID, updates *EventUpdateDTO) (*models.Event, error)
+	CancelOccurrence(ctx context.Context, eventID, organizerID uuid.UUID) error
+	StartSeriesMaterializer(ctx context.Context, interval time.Duration)
+	MaterializeDueSeries(ctx context.Context)
+
+	// Lifecycle & visibility
+	ChangeEventStatus(ctx context.Context, eventID, organizerID uuid.UUID, req *models.EventStatusRequest) (*models.EventStatusResponse, error)
+	GetPublicEventByID(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID, token string) (*models.Event, error)
+	StartScheduledPublisher(ctx context.Context, interval time.Duration)
+
+	// Trending
+	StartTrendingMaterializer(ctx context.Context, interval time.Duration)
+	RefreshTrending(ctx context.Context)
+
+	// Calendar export
+	GetEventCalendar(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID, token string) ([]byte, *models.Event, error)
+	GetCalendarLinks(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID, token string) (*models.CalendarLinks, error)
+	GetUserCalendarFeed(ctx context.Context, userID uuid.UUID) ([]byte, error)
+
+	// Cancellation & postponement
+	CancelEvent(ctx context.Context, eventID, organizerID uuid.UUID, reason string) (*models.RefundProgress, error)
+	PostponeEvent(ctx context.Context, eventID, organizerID uuid.UUID, req *models.PostponeEventRequest) (*models.Event, error)
+	GetRefundProgress(ctx context.Context, eventID, organizerID uuid.UUID) (*models.RefundProgress, error)
 }
 
 type eventService struct {
 	db        *sqlx.DB
 	eventRepo repoevent.EventRepository
+	likeRepo  repolike.LikeRepository
+	geocoder  geocoding.Geocoder
+	media     servicemedia.MediaService
 }
 
-func NewEventService(db *sqlx.DB, eventRepo repoevent.EventRepository) EventService {
+func NewEventService(db *sqlx.DB, eventRepo repoevent.EventRepository, likeRepo repolike.LikeRepository, geocoder geocoding.Geocoder, media servicemedia.MediaService) EventService {
 	return &eventService{
 		db:        db,
 		eventRepo: eventRepo,
+		likeRepo:  likeRepo,
+		geocoder:  geocoder,
+		media:     media,
 	}
 }
 
 // EventUpdateDTO for partial updates remains exactly as you designed
 type EventUpdateDTO struct {
-	EventTitle       *string             `json:"eventTitle"`
-	EventDescription *string             `json:"description"`
-	Category         *string             `json:"category"`
-	EventType        *models.EventType   `json:"eventType"`
-	EventImageURL    *string             `json:"imageUrl"`
-	VenueName        *string             `json:"venueName"`
-	VenueAddress     *string             `json:"venueAddress"`
-	City             *string             `json:"city"`
-	State            *string             `json:"state"`
-	Country          *string             `json:"country"`
-	VirtualPlatform  *string             `json:"virtualPlatform"`
-	MeetingLink      *string             `json:"meetingLink"`
-	StartDate        *time.Time          `json:"startDate"`
-	EndDate          *time.Time          `json:"endDate"`
-	MaxAttendees     *int32              `json:"maxAttendees"`
-	Tickets          []models.TicketTier `json:"tickets"`
-	Tags             *[]string           `json:"tags"`
-}
\ No newline at end of file
+	EventTitle       *string               `json:"eventTitle"`
+	EventDescription *string               `json:"description"`
+	Category         *string               `json:"category"`
+	EventType        *models.EventType     `json:"eventType"`
+	EventImageURL    *string               `json:"imageUrl"`
+	ImageAssetID     *uuid.UUID            `json:"imageAssetId"`
+	VenueName        *string               `json:"venueName"`
+	VenueAddress     *string               `json:"venueAddress"`
+	City             *string               `json:"city"`
+	State            *string               `json:"state"`
+	Country          *string               `json:"country"`
+	VirtualPlatform  *string               `json:"virtualPlatform"`
+	MeetingLink      *string               `json:"meetingLink"`
+	Latitude         *float64              `json:"latitude"`
+	Longitude        *float64              `json:"longitude"`
+	StartDate        *time.Time            `json:"startDate"`
+	EndDate          *time.Time            `json:"endDate"`
+	MaxAttendees     *int32                `json:"maxAttendees"`
+	Tickets          []models.TicketTier   `json:"tickets"`
+	Tags             *[]string             `json:"tags"`
+	ReentryPolicy    *models.ReentryPolicy `json:"reentryPolicy"`
+}
@@ -0,0 +1,383 @@
+// backend/pkg/services/event/event_sessions.go
+
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	repoevent "github.com/eventify/backend/pkg/repository/event"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ============================================================================
+// SESSION SCHEDULING
+// ============================================================================
+
+// eventLocation is the timezone used to decide which calendar day a session
+// falls on for day passes. Events are listed in Nigerian local time.
+func eventLocation() *time.Location {
+	loc, err := time.LoadLocation("Africa/Lagos")
+	if err != nil {
+		return time.FixedZone("WAT", 60*60)
+	}
+	return loc
+}
+
+// sessionDayOf truncates a timestamp to its local calendar day
+func sessionDayOf(t time.Time) time.Time {
+	local := t.In(eventLocation())
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// GetEventSessions returns the public schedule for an event
+func (s *eventService) GetEventSessions(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID, token string) ([]models.EventSession, error) {
+	if _, err := s.loadPublicEvent(ctx, eventID, userID, token); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.GetEventSessions(ctx, eventID)
+}
+
+// CreateSession adds a time slot to an event owned by the organizer
+func (s *eventService) CreateSession(
+	ctx context.Context,
+	eventID, organizerID uuid.UUID,
+	input *models.EventSessionInput,
+) (*models.EventSession, error) {
+	event, err := s.loadOwnedEvent(ctx, eventID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &models.EventSession{
+		ID:        uuid.New(),
+		EventID:   eventID,
+		CreatedAt: now,
+	}
+	applySessionInput(session, input)
+	session.UpdatedAt = now
+
+	if err := validateSession(event, session); err != nil {
+		return nil, err
+	}
+
+	if err := s.eventRepo.CreateSession(ctx, session); err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to create session", err)
+	}
+
+	log.Info().
+		Str("event_id", eventID.String()).
+		Str("session_id", session.ID.String()).
+		Msg("🗓️ Session created")
+
+	return session, nil
+}
+
+// UpdateSession replaces the details of an existing session
+func (s *eventService) UpdateSession(
+	ctx context.Context,
+	eventID, sessionID, organizerID uuid.UUID,
+	input *models.EventSessionInput,
+) (*models.EventSession, error) {
+	event, err := s.loadOwnedEvent(ctx, eventID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.eventRepo.GetSessionByID(ctx, sessionID)
+	if err != nil || session.EventID != eventID {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "session not found for this event", err)
+	}
+
+	applySessionInput(session, input)
+	session.UpdatedAt = time.Now()
+
+	if err := validateSession(event, session); err != nil {
+		return nil, err
+	}
+
+	if err := s.eventRepo.UpdateSession(ctx, session); err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to update session", err)
+	}
+	return session, nil
+}
+
+// DeleteSession removes a session from the schedule
+func (s *eventService) DeleteSession(ctx context.Context, eventID, sessionID, organizerID uuid.UUID) error {
+	if err := s.verifyEventOwnership(ctx, eventID, organizerID); err != nil {
+		return err
+	}
+
+	if err := s.eventRepo.DeleteSession(ctx, eventID, sessionID); err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			return utils.NewError(utils.ErrCategoryValidation, "session not found for this event", err)
+		}
+		if errors.Is(err, repoevent.ErrSessionInUse) {
+			return utils.NewConflictError("session is included in a ticket tier's access; update the tier first", err)
+		}
+		return utils.NewError(utils.ErrCategoryDatabase, "failed to delete session", err)
+	}
+	return nil
+}
+
+// SetTierAccess limits a ticket tier to specific sessions and/or days.
+// Sending empty lists turns the tier back into a full pass.
+func (s *eventService) SetTierAccess(
+	ctx context.Context,
+	eventID, tierID, organizerID uuid.UUID,
+	input *models.TierAccessInput,
+) error {
+	event, err := s.loadOwnedEvent(ctx, eventID, organizerID)
+	if err != nil {
+		return err
+	}
+
+	// 1. Tier must belong to this event
+	tierFound := false
+	for _, tier := range event.TicketTiers {
+		if tier.ID == tierID {
+			tierFound = true
+			break
+		}
+	}
+	if !tierFound {
+		return utils.NewError(utils.ErrCategoryValidation, "ticket tier not found for this event", nil)
+	}
+
+	// 2. Sessions must belong to this event
+	sessions, err := s.eventRepo.GetEventSessions(ctx, eventID)
+	if err != nil {
+		return utils.NewError(utils.ErrCategoryDatabase, "failed to load sessions", err)
+	}
+	known := make(map[uuid.UUID]bool, len(sessions))
+	for _, session := range sessions {
+		known[session.ID] = true
+	}
+	for _, id := range input.SessionIDs {
+		if !known[id] {
+			return utils.NewError(utils.ErrCategoryValidation, fmt.Sprintf("session %s does not belong to this event", id), nil)
+		}
+	}
+
+	// 3. Days must be valid dates inside the event window
+	firstDay, lastDay := sessionDayOf(event.StartDate), sessionDayOf(event.EndDate)
+	days := make([]time.Time, 0, len(input.Days))
+	for _, raw := range input.Days {
+		day, err := time.Parse("2006-01-02", strings.TrimSpace(raw))
+		if err != nil {
+			return utils.NewError(utils.ErrCategoryValidation, "days must use the YYYY-MM-DD format", err)
+		}
+		if day.Before(firstDay) || day.After(lastDay) {
+			return utils.NewError(utils.ErrCategoryValidation, fmt.Sprintf("%s is outside the event dates", raw), nil)
+		}
+		days = append(days, day)
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.eventRepo.ReplaceTierAccessTx(ctx, tx, tierID, input.SessionIDs, days); err != nil {
+		return utils.NewError(utils.ErrCategoryDatabase, "failed to save tier access", err)
+	}
+
+	return tx.Commit()
+}
+
+// attachSchedule fills in sessions and per-tier access for event detail responses
+func (s *eventService) attachSchedule(ctx context.Context, event *models.Event) error {
+	sessions, err := s.eventRepo.GetEventSessions(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	event.Sessions = sessions
+
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	rules, err := s.eventRepo.GetTierAccessRules(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	for i := range event.TicketTiers {
+		tier := &event.TicketTiers[i]
+		for _, rule := range rules {
+			if rule.TierID != tier.ID {
+				continue
+			}
+			if rule.SessionID != nil {
+				tier.SessionIDs = append(tier.SessionIDs, *rule.SessionID)
+			}
+			if rule.AccessDate != nil {
+				tier.AccessDays = append(tier.AccessDays, rule.AccessDate.Format("2006-01-02"))
+			}
+		}
+	}
+	return nil
+}
+
+// evaluateSessionScan validates a ticket against the session being scanned.
+// Single-entry events allow one admission per session, so multi-day passes keep working.
+func (s *eventService) evaluateSessionScan(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	ticket *models.GateTicketState,
+	sessionID uuid.UUID,
+	direction models.ScanDirection,
+) (string, error) {
+	if ticket.Status == models.TicketStatusCanceled {
+		return "ticket has been canceled", nil
+	}
+
+	session, err := s.eventRepo.GetSessionByID(ctx, sessionID)
+	if errors.Is(err, utils.ErrNotFound) || (err == nil && session.EventID != ticket.EventID) {
+		return "session not found for this event", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if time.Now().After(session.EndTime) {
+		return "session has ended", nil
+	}
+
+	granted, err := s.eventRepo.TierGrantsSessionTx(ctx, tx, ticket.TicketTierID, session)
+	if err != nil {
+		return "", err
+	}
+	if !granted {
+		return "ticket tier does not include this session", nil
+	}
+
+	if direction == models.ScanOut || ticket.ReentryPolicy == models.ReentryInOut {
+		return evaluateScan(ticket, direction), nil
+	}
+
+	admitted, err := s.eventRepo.HasSessionAdmissionTx(ctx, tx, ticket.ID, session.ID)
+	if err != nil {
+		return "", err
+	}
+	if admitted {
+		return "ticket already used for this session", nil
+	}
+	return "", nil
+}
+
+// evaluateGateScan handles scans that name no session. Tiers limited to
+// particular sessions must be scanned at one, and day passes only work on
+// their days. Tiers good for more than one day get a fresh admission each day.
+func (s *eventService) evaluateGateScan(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	ticket *models.GateTicketState,
+	direction models.ScanDirection,
+) (string, error) {
+	if ticket.Status == models.TicketStatusCanceled {
+		return "ticket has been canceled", nil
+	}
+
+	access, err := s.eventRepo.GetTierDayAccessTx(ctx, tx, []uuid.UUID{ticket.TicketTierID})
+	if err != nil {
+		return "", err
+	}
+	tier := access[ticket.TicketTierID]
+	day := sessionDayOf(time.Now())
+
+	if direction != models.ScanOut && !tier.Grants(day) {
+		return "ticket tier does not include this day", nil
+	}
+	if !multiDayTier(tier) {
+		return evaluateScan(ticket, direction), nil
+	}
+
+	admissions, err := s.eventRepo.GetGateAdmissionsTx(ctx, tx, []uuid.UUID{ticket.ID})
+	if err != nil {
+		return "", err
+	}
+	return evaluateDayScan(ticket, direction, admissions[ticket.ID], day), nil
+}
+
+// multiDayTier reports whether a tier admits on more than one day: a day pass
+// holding several days, or a full pass to an event spanning several
+func multiDayTier(tier models.TierDayAccess) bool {
+	if tier.Restricted {
+		return len(tier.Days) > 1
+	}
+	return sessionDayOf(tier.EventEnd).After(sessionDayOf(tier.EventStart))
+}
+
+// evaluateDayScan applies the re-entry policy to one day of a multi-day
+// ticket, as though the ticket were fresh that morning. scans are the
+// ticket's session-less gate scans, oldest first.
+func evaluateDayScan(
+	ticket *models.GateTicketState,
+	direction models.ScanDirection,
+	scans []models.TicketScan,
+	day time.Time,
+) string {
+	today := *ticket
+	today.IsUsed, today.IsInside = false, false
+	for _, scan := range scans {
+		if !sessionDayOf(scan.ScannedAt).Equal(day) {
+			continue
+		}
+		switch scan.Direction {
+		case models.ScanIn:
+			today.IsUsed, today.IsInside = true, true
+		case models.ScanOut:
+			today.IsInside = false
+		}
+	}
+	return evaluateScan(&today, direction)
+}
+
+// loadOwnedEvent fetches an event and checks that the caller organizes it
+func (s *eventService) loadOwnedEvent(ctx context.Context, eventID, organizerID uuid.UUID) (*models.Event, error) {
+	event, err := s.eventRepo.GetEventByID(ctx, eventID, nil)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "event not found", err)
+	}
+	if event.OrganizerID != organizerID {
+		return nil, utils.NewError(utils.ErrCategoryAuth, "unauthorized: you don't own this event", nil)
+	}
+	return event, nil
+}
+
+func applySessionInput(session *models.EventSession, input *models.EventSessionInput) {
+	session.Title = strings.TrimSpace(input.Title)
+	session.Description = input.Description
+	session.Track = input.Track
+	session.Room = input.Room
+	session.Speakers = input.Speakers
+	if session.Speakers == nil {
+		session.Speakers = []string{}
+	}
+	session.StartTime = input.StartTime
+	session.EndTime = input.EndTime
+	session.SessionDay = sessionDayOf(input.StartTime)
+}
+
+func validateSession(event *models.Event, session *models.EventSession) error {
+	if session.Title == "" {
+		return utils.NewError(utils.ErrCategoryValidation, "session title is required", nil)
+	}
+	if !session.StartTime.Before(session.EndTime) {
+		return utils.NewError(utils.ErrCategoryValidation, "session start time must be before end time", nil)
+	}
+	if session.StartTime.Before(event.StartDate) || session.EndTime.After(event.EndDate) {
+		return utils.NewError(utils.ErrCategoryValidation, "session must fall within the event dates", nil)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// backend/pkg/services/event/event_slug.go
+
+package event
+
+import (
+	"context"
+	"strings"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// maxSlugBaseLength leaves room for a collision suffix within a readable URL
+const maxSlugBaseLength = 80
+
+// slugBase is the slug an event would get if nothing else had claimed it.
+// Series occurrences share a title, so their start date keeps them apart.
+func slugBase(event *models.Event) string {
+	title := event.EventTitle
+	if event.RecurrenceID != nil {
+		title += " " + event.StartDate.In(eventLocation()).Format("2006-01-02")
+	}
+
+	base := utils.GenerateSlug(title)
+	if len(base) > maxSlugBaseLength {
+		base = strings.TrimRight(base[:maxSlugBaseLength], "-")
+	}
+	if base == "" {
+		base = "event"
+	}
+	return base
+}
+
+// slugHasBase reports whether slug is base itself or base with a -N suffix
+func slugHasBase(slug, base string) bool {
+	if slug == base {
+		return true
+	}
+	suffix, ok := strings.CutPrefix(slug, base+"-")
+	if !ok || suffix == "" {
+		return false
+	}
+	for _, r := range suffix {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// assignSlugTx gives a new event a unique slug
+func (s *eventService) assignSlugTx(ctx context.Context, tx *sqlx.Tx, event *models.Event) error {
+	slug, err := s.eventRepo.ReserveSlugTx(ctx, tx, event.ID, slugBase(event))
+	if err != nil {
+		return err
+	}
+	event.EventSlug = models.ToNullString(slug)
+	return nil
+}
+
+// refreshSlugTx re-slugs a renamed event and keeps the old slug as a redirect.
+// A rename that produces the same base (e.g. fixing capitalisation) keeps the URL.
+func (s *eventService) refreshSlugTx(ctx context.Context, tx *sqlx.Tx, event *models.Event, oldSlug string) error {
+	if oldSlug != "" && slugHasBase(oldSlug, slugBase(event)) {
+		event.EventSlug = models.ToNullString(oldSlug)
+		return nil
+	}
+
+	if err := s.assignSlugTx(ctx, tx, event); err != nil {
+		return err
+	}
+	if oldSlug != "" && oldSlug != event.EventSlug.String {
+		return s.eventRepo.RetireSlugTx(ctx, tx, event.ID, oldSlug)
+	}
+	return nil
+}
+
+// GetEventBySlug loads an event by its current or a previous slug. The
+// returned slug is the current one; when it differs from the requested slug
+// the caller should redirect.
+func (s *eventService) GetEventBySlug(ctx context.Context, slug string, userID *uuid.UUID, token string) (*models.Event, string, error) {
+	eventID, current, err := s.eventRepo.ResolveSlug(ctx, strings.ToLower(slug))
+	if err != nil {
+		return nil, "", err
+	}
+
+	event, err := s.eventRepo.GetEventByID(ctx, eventID, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := checkPublicVisibility(event, userID, token); err != nil {
+		return nil, "", err
+	}
+	return event, current, nil
+}
@@ -0,0 +1,41 @@
+package event
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugBase(t *testing.T) {
+	t.Run("Plain title", func(t *testing.T) {
+		assert.Equal(t, "lagos-jazz-night", slugBase(&models.Event{EventTitle: "Lagos Jazz Night!"}))
+	})
+
+	t.Run("Occurrences carry their local start date", func(t *testing.T) {
+		rid := time.Date(2026, 3, 14, 23, 30, 0, 0, time.UTC)
+		event := &models.Event{EventTitle: "Weekly Quiz", RecurrenceID: &rid, StartDate: rid}
+		// 23:30 UTC is already the 15th in Lagos
+		assert.Equal(t, "weekly-quiz-2026-03-15", slugBase(event))
+	})
+
+	t.Run("Long titles are trimmed without a trailing hyphen", func(t *testing.T) {
+		base := slugBase(&models.Event{EventTitle: strings.Repeat("abc ", 40)})
+		assert.LessOrEqual(t, len(base), maxSlugBaseLength)
+		assert.False(t, strings.HasSuffix(base, "-"))
+	})
+
+	t.Run("Titles with no ASCII letters fall back", func(t *testing.T) {
+		assert.Equal(t, "event", slugBase(&models.Event{EventTitle: "🎉🎉"}))
+	})
+}
+
+func TestSlugHasBase(t *testing.T) {
+	assert.True(t, slugHasBase("afrobeats-live", "afrobeats-live"))
+	assert.True(t, slugHasBase("afrobeats-live-3", "afrobeats-live"))
+	assert.False(t, slugHasBase("afrobeats-live-extra", "afrobeats-live"))
+	assert.False(t, slugHasBase("afrobeats-live-", "afrobeats-live"))
+	assert.False(t, slugHasBase("afrobeats", "afrobeats-live"))
+}
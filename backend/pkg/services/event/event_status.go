@@ -0,0 +1,213 @@
+// backend/pkg/services/event/event_status.go
+
+package event
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrEventNotVisible hides drafts, scheduled, archived and unlisted events
+// (without the right token) from public readers. Handlers answer 404 so the
+// event's existence isn't leaked.
+var ErrEventNotVisible = errors.New("event not found")
+
+// ============================================================================
+// LIFECYCLE
+// ============================================================================
+
+// ChangeEventStatus moves an event through draft / scheduled / published / unlisted / archived
+func (s *eventService) ChangeEventStatus(
+	ctx context.Context,
+	eventID, organizerID uuid.UUID,
+	req *models.EventStatusRequest,
+) (*models.EventStatusResponse, error) {
+	event, err := s.loadOwnedEvent(ctx, eventID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !event.Status.CanTransitionTo(req.Status) {
+		return nil, utils.NewError(utils.ErrCategoryValidation,
+			"cannot move an event from "+string(event.Status)+" to "+string(req.Status), nil)
+	}
+	if event.CancelledAt != nil && req.Status != models.EventStatusArchived {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "a cancelled event can only be archived", nil)
+	}
+	if req.Status == models.EventStatusDraft && hasTicketSales(event) {
+		return nil, utils.NewError(utils.ErrCategoryValidation,
+			"tickets have been sold; unlist or archive the event instead of returning it to draft", nil)
+	}
+
+	publishAt, accessToken, err := statusFields(req.Status, req.PublishAt, event.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.eventRepo.UpdateEventStatus(ctx, eventID, req.Status, publishAt, accessToken); err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to update event status", err)
+	}
+
+	log.Info().
+		Str("event_id", eventID.String()).
+		Str("from", string(event.Status)).
+		Str("to", string(req.Status)).
+		Msg("Event status changed")
+
+	resp := &models.EventStatusResponse{
+		EventID:   eventID.String(),
+		Status:    req.Status,
+		PublishAt: publishAt,
+	}
+	if accessToken != nil {
+		resp.ShareToken = *accessToken
+	}
+	return resp, nil
+}
+
+// statusFields works out publish_at and access_token for a target state.
+// Only scheduled events keep a publish time; only unlisted events keep a
+// share token, and an existing token is reused so shared links survive.
+func statusFields(status models.EventStatus, publishAt *time.Time, currentToken *string) (*time.Time, *string, error) {
+	var token *string
+	if status == models.EventStatusUnlisted {
+		token = currentToken
+		if token == nil || *token == "" {
+			generated, err := generateShareToken()
+			if err != nil {
+				return nil, nil, utils.NewError(utils.ErrCategoryInternal, "failed to generate share token", err)
+			}
+			token = &generated
+		}
+	}
+
+	if status != models.EventStatusScheduled {
+		return nil, token, nil
+	}
+	if publishAt == nil {
+		return nil, nil, utils.NewError(utils.ErrCategoryValidation, "publishAt is required to schedule an event", nil)
+	}
+	if !publishAt.After(time.Now()) {
+		return nil, nil, utils.NewError(utils.ErrCategoryValidation, "publishAt must be in the future", nil)
+	}
+	return publishAt, token, nil
+}
+
+// ensureShareToken gives a new unlisted event its share token
+func ensureShareToken(event *models.Event) error {
+	if event.Status != models.EventStatusUnlisted || event.AccessToken != nil {
+		return nil
+	}
+	token, err := generateShareToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate share token: %w", err)
+	}
+	event.AccessToken = &token
+	return nil
+}
+
+func generateShareToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hasTicketSales(event *models.Event) bool {
+	for _, tier := range event.TicketTiers {
+		if tier.Sold > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ============================================================================
+// PUBLIC VISIBILITY
+// ============================================================================
+
+// GetPublicEventByID loads an event for public readers, honouring its lifecycle state
+func (s *eventService) GetPublicEventByID(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID, token string) (*models.Event, error) {
+	event, err := s.GetEventByID(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPublicVisibility(event, userID, token); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// loadPublicEvent fetches an event for the public side routes (schedule,
+// calendar) under the same visibility rules as the event page
+func (s *eventService) loadPublicEvent(ctx context.Context, eventID uuid.UUID, userID *uuid.UUID, token string) (*models.Event, error) {
+	event, err := s.eventRepo.GetEventByID(ctx, eventID, nil)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "event not found", err)
+	}
+	if err := checkPublicVisibility(event, userID, token); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// checkPublicVisibility lets organizers see everything and everyone else see
+// published events, plus unlisted ones when they bring the share token.
+// The token is stripped from what non-owners get back.
+func checkPublicVisibility(event *models.Event, userID *uuid.UUID, token string) error {
+	if userID != nil && *userID == event.OrganizerID {
+		return nil
+	}
+	defer func() { event.AccessToken = nil }()
+
+	switch event.Status {
+	case models.EventStatusPublished:
+		return nil
+	case models.EventStatusUnlisted:
+		if event.AccessToken != nil && token != "" &&
+			subtle.ConstantTimeCompare([]byte(token), []byte(*event.AccessToken)) == 1 {
+			return nil
+		}
+	}
+	return ErrEventNotVisible
+}
+
+// ============================================================================
+// SCHEDULED PUBLISHER
+// ============================================================================
+
+// StartScheduledPublisher publishes scheduled events once their publish time passes
+func (s *eventService) StartScheduledPublisher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Scheduled Publisher started (Interval: %v)", interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Scheduled Publisher shutting down...")
+			return
+		case <-ticker.C:
+			published, err := s.eventRepo.PublishDueEvents(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to publish scheduled events")
+				continue
+			}
+			if published > 0 {
+				log.Info().Int64("count", published).Msg("Published scheduled events")
+			}
+		}
+	}
+}
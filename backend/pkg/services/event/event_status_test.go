@@ -0,0 +1,84 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusFields(t *testing.T) {
+	t.Run("Scheduling needs a future publish time", func(t *testing.T) {
+		_, _, err := statusFields(models.EventStatusScheduled, nil, nil)
+		assert.Error(t, err)
+
+		past := time.Now().Add(-time.Hour)
+		_, _, err = statusFields(models.EventStatusScheduled, &past, nil)
+		assert.Error(t, err)
+
+		future := time.Now().Add(time.Hour)
+		publishAt, token, err := statusFields(models.EventStatusScheduled, &future, nil)
+		require.NoError(t, err)
+		assert.Equal(t, &future, publishAt)
+		assert.Nil(t, token)
+	})
+
+	t.Run("Unlisting keeps an existing share token", func(t *testing.T) {
+		existing := "abc123"
+		_, token, err := statusFields(models.EventStatusUnlisted, nil, &existing)
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", *token)
+
+		_, token, err = statusFields(models.EventStatusUnlisted, nil, nil)
+		require.NoError(t, err)
+		assert.Len(t, *token, 32)
+	})
+
+	t.Run("Publishing drops the token and publish time", func(t *testing.T) {
+		existing := "abc123"
+		future := time.Now().Add(time.Hour)
+		publishAt, token, err := statusFields(models.EventStatusPublished, &future, &existing)
+		require.NoError(t, err)
+		assert.Nil(t, publishAt)
+		assert.Nil(t, token)
+	})
+}
+
+func TestCheckPublicVisibility(t *testing.T) {
+	organizerID := uuid.New()
+	stranger := uuid.New()
+	newEvent := func(status models.EventStatus) *models.Event {
+		token := "share-token"
+		return &models.Event{OrganizerID: organizerID, Status: status, AccessToken: &token}
+	}
+
+	assert.NoError(t, checkPublicVisibility(newEvent(models.EventStatusPublished), nil, ""))
+	assert.ErrorIs(t, checkPublicVisibility(newEvent(models.EventStatusDraft), &stranger, ""), ErrEventNotVisible)
+	assert.ErrorIs(t, checkPublicVisibility(newEvent(models.EventStatusArchived), nil, ""), ErrEventNotVisible)
+
+	t.Run("Unlisted needs the matching token", func(t *testing.T) {
+		assert.ErrorIs(t, checkPublicVisibility(newEvent(models.EventStatusUnlisted), nil, ""), ErrEventNotVisible)
+		assert.ErrorIs(t, checkPublicVisibility(newEvent(models.EventStatusUnlisted), nil, "wrong"), ErrEventNotVisible)
+
+		event := newEvent(models.EventStatusUnlisted)
+		assert.NoError(t, checkPublicVisibility(event, nil, "share-token"))
+		assert.Nil(t, event.AccessToken, "token must not be echoed to readers")
+	})
+
+	t.Run("Organizers see every state", func(t *testing.T) {
+		event := newEvent(models.EventStatusDraft)
+		assert.NoError(t, checkPublicVisibility(event, &organizerID, ""))
+		assert.NotNil(t, event.AccessToken)
+	})
+}
+
+func TestEventStatusTransitions(t *testing.T) {
+	assert.True(t, models.EventStatusDraft.CanTransitionTo(models.EventStatusScheduled))
+	assert.True(t, models.EventStatusScheduled.CanTransitionTo(models.EventStatusScheduled))
+	assert.True(t, models.EventStatusArchived.CanTransitionTo(models.EventStatusPublished))
+	assert.False(t, models.EventStatusPublished.CanTransitionTo(models.EventStatusScheduled))
+	assert.False(t, models.EventStatusArchived.CanTransitionTo(models.EventStatusDraft))
+}
@@ -4,10 +4,15 @@ package event
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/google/uuid"
+	"github.com/eventify/backend/pkg/models"
 	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 )
 
 // CheckTicketAvailability checks if a specific tier has enough inventory
@@ -40,24 +45,220 @@ func (s *eventService) ReserveTickets(
 	return tx.Commit()
 }
 
-// ValidateAndCheckInTicket handles the industry-grade "Gate Check"
+// ValidateAndCheckInTicket handles the industry-grade "Gate Check".
+// Every scan, granted or denied, is written to ticket_scans.
 func (s *eventService) ValidateAndCheckInTicket(
-    ctx context.Context, 
-    ticketCode string,
+	ctx context.Context,
+	req *models.CheckInRequest,
+) (*models.TicketScan, error) {
+	if req.Direction == "" {
+		req.Direction = models.ScanIn
+	}
+	code := strings.TrimSpace(req.Code)
+	req.DeviceID = clipScanField(strings.TrimSpace(req.DeviceID))
+	req.GateID = clipScanField(strings.TrimSpace(req.GateID))
+
+	scan := &models.TicketScan{
+		TicketCode: clipScanField(code),
+		Direction:  req.Direction,
+		Result:     models.ScanGranted,
+		DeviceID:   optionalString(req.DeviceID),
+		GateID:     optionalString(req.GateID),
+		StaffID:    req.StaffID,
+		ScannedAt:  time.Now(),
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 1. FAST PATH: Cryptographic Signature Verification
+	// This catches fake tickets immediately without a ticket lookup.
+	// Oversized codes are logged cut down to fit the audit column.
+	reason := ""
+	if len(code) > models.MaxScanFieldLength {
+		reason = "malformed ticket code"
+	} else if !utils.VerifyTicketOffline(code) {
+		reason = "invalid ticket signature"
+	}
+
+	// 2. DATABASE PATH: Lock the ticket and apply the event's re-entry policy
+	if reason == "" {
+		ticket, err := s.eventRepo.GetTicketForScanTx(ctx, tx, scan.TicketCode)
+		switch {
+		case errors.Is(err, utils.ErrNotFound):
+			reason = "ticket not found"
+		case err != nil:
+			return nil, err
+		default:
+			scan.TicketID = &ticket.ID
+			scan.EventID = &ticket.EventID
+			if req.SessionID != nil {
+				scan.SessionID = req.SessionID
+				if reason, err = s.evaluateSessionScan(ctx, tx, ticket, *req.SessionID, req.Direction); err != nil {
+					return nil, err
+				}
+			} else if reason, err = s.evaluateGateScan(ctx, tx, ticket, req.Direction); err != nil {
+				return nil, err
+			}
+		}
+
+		if reason == "" {
+			if req.Direction == models.ScanOut {
+				err = s.eventRepo.ExitTicketTx(ctx, tx, ticket.ID)
+			} else {
+				err = s.eventRepo.AdmitTicketTx(ctx, tx, ticket.ID, req.DeviceID, req.GateID)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("gate check failed: %w", err)
+			}
+		}
+	}
+
+	// 3. AUDIT: Denied scans are committed too so the organizer sees attempted fraud
+	if reason != "" {
+		scan.Result = models.ScanDenied
+		scan.DenialReason = &reason
+	}
+	if err := s.eventRepo.InsertTicketScanTx(ctx, tx, scan); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit scan: %w", err)
+	}
+
+	if reason != "" {
+		log.Warn().
+			Str("code", scan.TicketCode).
+			Str("direction", string(scan.Direction)).
+			Str("reason", reason).
+			Msg("🚫 Gate scan denied")
+		return scan, utils.NewConflictError(reason, nil)
+	}
+
+	return scan, nil
+}
+
+// evaluateScan applies the re-entry policy and returns a denial reason, or "" to allow
+func evaluateScan(ticket *models.GateTicketState, direction models.ScanDirection) string {
+	if ticket.Status == models.TicketStatusCanceled {
+		return "ticket has been canceled"
+	}
+
+	policy := ticket.ReentryPolicy
+	if policy == "" {
+		policy = models.ReentrySingle
+	}
+
+	switch direction {
+	case models.ScanOut:
+		if policy != models.ReentryInOut {
+			return "re-entry is not enabled for this event"
+		}
+		if !ticket.IsInside {
+			return "ticket is not checked in"
+		}
+	default:
+		if ticket.IsInside {
+			return "ticket is already inside the venue"
+		}
+		if policy == models.ReentrySingle && ticket.IsUsed {
+			return "ticket already used"
+		}
+	}
+	return ""
+}
+
+// UndoCheckIn lets the organizer reverse an accidental admission
+func (s *eventService) UndoCheckIn(
+	ctx context.Context,
+	eventID, organizerID uuid.UUID,
+	code, reason string,
 ) error {
-    // 1. FAST PATH: Cryptographic Signature Verification
-    // This catches fake tickets immediately without a DB hit.
-    if !utils.VerifyTicketOffline(ticketCode) {
-        return fmt.Errorf("security alert: invalid ticket signature for code %s", ticketCode)
-    }
-
-    // 2. DATABASE PATH: Mark as used
-    // This uses the atomic update we discussed: it only works if is_used = false.
-    err := s.eventRepo.MarkTicketAsUsed(ctx, ticketCode)
-    if err != nil {
-        // Here we handle "Already Used", "Not Found", or "Canceled"
-        return fmt.Errorf("gate check failed: %w", err)
-    }
-
-    return nil
-}
\ No newline at end of file
+	if err := s.verifyEventOwnership(ctx, eventID, organizerID); err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	ticket, err := s.eventRepo.GetTicketForScanTx(ctx, tx, strings.TrimSpace(code))
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			return utils.NewError(utils.ErrCategoryValidation, "ticket not found", err)
+		}
+		return err
+	}
+	if ticket.EventID != eventID {
+		return utils.NewError(utils.ErrCategoryValidation, "ticket does not belong to this event", nil)
+	}
+	if !ticket.IsUsed {
+		return utils.NewConflictError("ticket is not checked in", nil)
+	}
+
+	if err := s.eventRepo.UndoCheckInTx(ctx, tx, ticket.ID); err != nil {
+		return utils.NewConflictError("ticket is not checked in", err)
+	}
+
+	scan := &models.TicketScan{
+		TicketID:   &ticket.ID,
+		TicketCode: ticket.Code,
+		EventID:    &ticket.EventID,
+		Direction:  models.ScanUndo,
+		Result:     models.ScanGranted,
+		UndoReason: optionalString(reason),
+		StaffID:    &organizerID,
+		ScannedAt:  time.Now(),
+	}
+	if err := s.eventRepo.InsertTicketScanTx(ctx, tx, scan); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit undo: %w", err)
+	}
+
+	log.Info().
+		Str("event_id", eventID.String()).
+		Str("code", ticket.Code).
+		Msg("↩️ Check-in reversed by organizer")
+	return nil
+}
+
+// GetCheckInStats returns live admission counts for the organizer dashboard
+func (s *eventService) GetCheckInStats(
+	ctx context.Context,
+	eventID, organizerID uuid.UUID,
+) (*models.CheckInStats, error) {
+	if err := s.verifyEventOwnership(ctx, eventID, organizerID); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.eventRepo.GetCheckInStats(ctx, eventID, 20)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load check-in stats", err)
+	}
+	return stats, nil
+}
+
+// clipScanField cuts a value down to MaxScanFieldLength bytes without
+// leaving half a character behind
+func clipScanField(v string) string {
+	if len(v) <= models.MaxScanFieldLength {
+		return v
+	}
+	return strings.ToValidUTF8(v[:models.MaxScanFieldLength], "")
+}
+
+func optionalString(v string) *string {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
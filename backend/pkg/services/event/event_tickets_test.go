@@ -0,0 +1,83 @@
+package event
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateScan(t *testing.T) {
+	t.Run("Single entry blocks second admission", func(t *testing.T) {
+		ticket := &models.GateTicketState{Status: models.TicketStatusUsed, IsUsed: true, ReentryPolicy: models.ReentrySingle}
+		assert.Equal(t, "ticket already used", evaluateScan(ticket, models.ScanIn))
+		assert.Equal(t, "re-entry is not enabled for this event", evaluateScan(ticket, models.ScanOut))
+	})
+
+	t.Run("In/out policy allows re-entry after exit", func(t *testing.T) {
+		ticket := &models.GateTicketState{Status: models.TicketStatusUsed, IsUsed: true, IsInside: true, ReentryPolicy: models.ReentryInOut}
+		assert.Equal(t, "ticket is already inside the venue", evaluateScan(ticket, models.ScanIn))
+		assert.Empty(t, evaluateScan(ticket, models.ScanOut))
+
+		ticket.IsInside = false
+		assert.Empty(t, evaluateScan(ticket, models.ScanIn))
+		assert.Equal(t, "ticket is not checked in", evaluateScan(ticket, models.ScanOut))
+	})
+
+	t.Run("Canceled tickets never pass", func(t *testing.T) {
+		ticket := &models.GateTicketState{Status: models.TicketStatusCanceled, ReentryPolicy: models.ReentryInOut}
+		assert.Equal(t, "ticket has been canceled", evaluateScan(ticket, models.ScanIn))
+	})
+}
+
+func TestEvaluateDayScan(t *testing.T) {
+	lagos := eventLocation()
+	day1 := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	entered := time.Date(2026, 5, 1, 9, 30, 0, 0, lagos)
+	dayOneScans := []models.TicketScan{{Direction: models.ScanIn, ScannedAt: entered}}
+
+	for _, policy := range []models.ReentryPolicy{models.ReentrySingle, models.ReentryInOut} {
+		t.Run("Day one admission doesn't block day two ("+string(policy)+")", func(t *testing.T) {
+			// Never scanned out on day one, so the ticket still looks used and inside
+			ticket := &models.GateTicketState{
+				Status: models.TicketStatusUsed, IsUsed: true, IsInside: true,
+				UsedAt: &entered, ReentryPolicy: policy,
+			}
+			assert.NotEmpty(t, evaluateDayScan(ticket, models.ScanIn, dayOneScans, day1))
+			assert.Empty(t, evaluateDayScan(ticket, models.ScanIn, dayOneScans, day2))
+		})
+	}
+
+	t.Run("Exits only count on the day they happened", func(t *testing.T) {
+		ticket := &models.GateTicketState{Status: models.TicketStatusUsed, IsUsed: true, IsInside: true, ReentryPolicy: models.ReentryInOut}
+		scans := append(dayOneScans, models.TicketScan{Direction: models.ScanOut, ScannedAt: entered.Add(8 * time.Hour)})
+
+		assert.Empty(t, evaluateDayScan(ticket, models.ScanIn, scans, day1))
+		assert.Equal(t, "ticket is not checked in", evaluateDayScan(ticket, models.ScanOut, scans, day2))
+	})
+}
+
+func TestMultiDayTier(t *testing.T) {
+	start := time.Date(2026, 5, 1, 10, 0, 0, 0, eventLocation())
+	assert.False(t, multiDayTier(models.TierDayAccess{EventStart: start, EventEnd: start.Add(8 * time.Hour)}))
+	assert.True(t, multiDayTier(models.TierDayAccess{EventStart: start, EventEnd: start.AddDate(0, 0, 2)}))
+
+	day := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	onePass := models.TierDayAccess{Restricted: true, Days: []time.Time{day}, EventStart: start, EventEnd: start.AddDate(0, 0, 2)}
+	assert.False(t, multiDayTier(onePass))
+	assert.True(t, onePass.Grants(day))
+	assert.False(t, onePass.Grants(day.AddDate(0, 0, 1)))
+}
+
+func TestClipScanField(t *testing.T) {
+	assert.Equal(t, "GATE-A", clipScanField("GATE-A"))
+
+	long := strings.Repeat("x", models.MaxScanFieldLength-1) + "é"
+	clipped := clipScanField(long)
+	assert.Len(t, clipped, models.MaxScanFieldLength-1, "a split character is dropped")
+	assert.True(t, utf8.ValidString(clipped))
+}
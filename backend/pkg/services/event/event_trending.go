@@ -0,0 +1,134 @@
+// backend/pkg/services/event/event_trending.go
+
+package event
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// trendingHalfLife is how long a like or view takes to lose half its weight
+	trendingHalfLife = 48 * time.Hour
+	// trendingLookback ignores likes and views older than this outright
+	trendingLookback = 14 * 24 * time.Hour
+	// velocityWindow is the span ticket sales are measured over
+	velocityWindow = 24 * time.Hour
+	// snapshotRetention keeps enough sales history to cover the window
+	snapshotRetention = 3 * 24 * time.Hour
+)
+
+// Blend weights; each signal is scaled to 0..1 first
+const (
+	trendingWeightLikes    = 0.4
+	trendingWeightVelocity = 0.4
+	trendingWeightViews    = 0.2
+)
+
+// ============================================================================
+// MATERIALIZATION
+// ============================================================================
+
+// StartTrendingMaterializer refreshes the trending ranking on an interval
+func (s *eventService) StartTrendingMaterializer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Trending Materializer started (Interval: %v)", interval)
+
+	s.RefreshTrending(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Trending Materializer shutting down...")
+			return
+		case <-ticker.C:
+			s.RefreshTrending(ctx)
+		}
+	}
+}
+
+// RefreshTrending snapshots ticket sales, gathers the decayed signals and
+// rewrites event_trending_scores
+func (s *eventService) RefreshTrending(ctx context.Context) {
+	eligible, err := s.eventRepo.SnapshotTicketSales(ctx, snapshotRetention)
+	if err != nil {
+		log.Error().Err(err).Msg("Trending: failed to snapshot ticket sales")
+		return
+	}
+
+	since := time.Now().Add(-trendingLookback)
+	likes, err := s.likeRepo.GetDecayedLikeScores(ctx, trendingHalfLife, since)
+	if err != nil {
+		log.Error().Err(err).Msg("Trending: failed to load like scores")
+		return
+	}
+	views, err := s.eventRepo.GetDecayedViewScores(ctx, trendingHalfLife, since)
+	if err != nil {
+		log.Error().Err(err).Msg("Trending: failed to load view scores")
+		return
+	}
+	velocity, err := s.eventRepo.GetTicketVelocity(ctx, velocityWindow)
+	if err != nil {
+		log.Error().Err(err).Msg("Trending: failed to load ticket velocity")
+		return
+	}
+
+	scores := rankTrending(eligible, likes, velocity, views)
+	if err := s.eventRepo.ReplaceTrendingScores(ctx, scores); err != nil {
+		log.Error().Err(err).Msg("Trending: failed to save scores")
+		return
+	}
+
+	log.Info().Int("eligible", len(eligible)).Int("ranked", len(scores)).Msg("Trending scores refreshed")
+}
+
+// rankTrending blends the three signals for every eligible event. Each signal
+// is log-scaled against the strongest event so one viral listing doesn't
+// flatten the rest; events with no signal at all are left out.
+func rankTrending(eligible map[uuid.UUID]int64, likes, velocity, views map[uuid.UUID]float64) []models.TrendingScore {
+	maxLikes, maxVelocity, maxViews := 0.0, 0.0, 0.0
+	for id := range eligible {
+		maxLikes = math.Max(maxLikes, likes[id])
+		maxVelocity = math.Max(maxVelocity, velocity[id])
+		maxViews = math.Max(maxViews, views[id])
+	}
+
+	scores := make([]models.TrendingScore, 0, len(eligible))
+	for id := range eligible {
+		score := trendingWeightLikes*logShare(likes[id], maxLikes) +
+			trendingWeightVelocity*logShare(velocity[id], maxVelocity) +
+			trendingWeightViews*logShare(views[id], maxViews)
+		if score <= 0 {
+			continue
+		}
+		scores = append(scores, models.TrendingScore{
+			EventID:        id,
+			Score:          math.Round(score*1e6) / 1e6,
+			LikeScore:      likes[id],
+			TicketVelocity: velocity[id],
+			ViewScore:      views[id],
+		})
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].EventID.String() < scores[j].EventID.String()
+	})
+	return scores
+}
+
+func logShare(v, maxV float64) float64 {
+	if v <= 0 || maxV <= 0 {
+		return 0
+	}
+	return math.Log1p(v) / math.Log1p(maxV)
+}
@@ -0,0 +1,38 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankTrending(t *testing.T) {
+	hot, steady, quiet, past := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	eligible := map[uuid.UUID]int64{hot: 120, steady: 40, quiet: 0}
+
+	likes := map[uuid.UUID]float64{hot: 30, steady: 8, past: 500}
+	velocity := map[uuid.UUID]float64{hot: 12, steady: 3}
+	views := map[uuid.UUID]float64{steady: 200}
+
+	scores := rankTrending(eligible, likes, velocity, views)
+
+	t.Run("Only eligible events with some signal are ranked", func(t *testing.T) {
+		require.Len(t, scores, 2)
+		for _, s := range scores {
+			assert.NotEqual(t, past, s.EventID, "past events are never eligible")
+			assert.NotEqual(t, quiet, s.EventID)
+		}
+	})
+
+	t.Run("Signals blend into a 0..1 score", func(t *testing.T) {
+		assert.Equal(t, hot, scores[0].EventID)
+		assert.InDelta(t, 0.8, scores[0].Score, 1e-6, "top likes and velocity, no views")
+		assert.Equal(t, 30.0, scores[0].LikeScore)
+
+		assert.Equal(t, steady, scores[1].EventID)
+		assert.Greater(t, scores[1].Score, 0.6)
+		assert.Less(t, scores[1].Score, scores[0].Score)
+	})
+}
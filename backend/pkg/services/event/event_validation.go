@@ -25,6 +25,14 @@ func (s *eventService) validateEvent(event *models.Event) error {
 		return errors.New("start date must be in the future")
 	}
 
+	// Default to one admission per ticket
+	if event.ReentryPolicy == "" {
+		event.ReentryPolicy = models.ReentrySingle
+	}
+	if !event.ReentryPolicy.IsValid() {
+		return errors.New("reentry policy must be 'single' or 'in_out'")
+	}
+
 	// Physical event check
 	if event.EventType == models.TypePhysical {
 		if event.VenueName == nil || *event.VenueName == "" {
@@ -32,6 +40,26 @@ func (s *eventService) validateEvent(event *models.Event) error {
 		}
 	}
 
+	// New events go live immediately unless created as a draft, scheduled or unlisted
+	if event.Status == "" {
+		event.Status = models.EventStatusPublished
+	}
+	switch event.Status {
+	case models.EventStatusDraft, models.EventStatusPublished, models.EventStatusUnlisted:
+		event.PublishAt = nil
+	case models.EventStatusScheduled:
+		if event.PublishAt == nil || !event.PublishAt.After(time.Now()) {
+			return errors.New("scheduled events need a publishAt in the future")
+		}
+	default:
+		return errors.New("status must be draft, scheduled, published or unlisted")
+	}
+
+	// Coordinates come as a pair
+	if (event.Latitude == nil) != (event.Longitude == nil) {
+		return errors.New("latitude and longitude must be provided together")
+	}
+
 	// Virtual event check
 	if event.EventType == models.TypeVirtual {
 		if event.MeetingLink == nil || *event.MeetingLink == "" {
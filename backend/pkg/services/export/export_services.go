@@ -0,0 +1,368 @@
+// backend/pkg/services/export/export_services.go
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/eventify/backend/pkg/export"
+	"github.com/eventify/backend/pkg/models"
+	repoexport "github.com/eventify/backend/pkg/repository/export"
+	repoorder "github.com/eventify/backend/pkg/repository/order"
+	serviceanalytics "github.com/eventify/backend/pkg/services/analytics"
+	servicevendor "github.com/eventify/backend/pkg/services/vendor"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// SyncRowLimit is the most rows streamed straight back in the request;
+	// anything bigger becomes a background job
+	SyncRowLimit = 2000
+	// jobRetention is how long a finished file stays downloadable
+	jobRetention = 24 * time.Hour
+	// staleJobAfter hands a job to another worker if its first one died
+	staleJobAfter  = 15 * time.Minute
+	maxJobAttempts = 3
+	jobsPerRun     = 5
+)
+
+var (
+	ErrEventNotFound     = errors.New("event not found or deleted")
+	ErrNotEventOrganizer = errors.New("unauthorized: event does not belong to this organizer")
+	ErrJobNotFound       = repoexport.ErrJobNotFound
+	ErrJobNotReady       = errors.New("export is still being prepared")
+	ErrJobFailed         = errors.New("export failed")
+	ErrJobExpired        = errors.New("export has expired")
+)
+
+// exportTimezone is the zone times are shown in inside exported files
+const exportTimezone = "Africa/Lagos"
+
+type ExportService interface {
+	// PrepareEventExport checks ownership and returns how many rows the
+	// export will have, so the caller can choose streaming or a job
+	PrepareEventExport(ctx context.Context, req models.ExportRequest) (int, error)
+	WriteEventExport(ctx context.Context, req models.ExportRequest, w io.Writer) (int, error)
+	QueueEventExport(ctx context.Context, req models.ExportRequest) (*models.ExportJob, string, error)
+	GetJob(ctx context.Context, jobID, organizerID uuid.UUID) (*models.ExportJob, error)
+	GetDownload(ctx context.Context, token string) (*models.ExportJob, error)
+	StartExportWorker(ctx context.Context, interval time.Duration)
+
+	WriteVendorExport(ctx context.Context, vendorID, ownerID uuid.UUID, format export.Format, w io.Writer) (int, error)
+	WritePlatformExport(ctx context.Context, q models.PlatformAnalyticsQuery, format export.Format, w io.Writer) (int, error)
+}
+
+type exportService struct {
+	repo      repoexport.ExportRepository
+	orderRepo repoorder.OrderRepository
+	analytics serviceanalytics.AnalyticsService
+	vendors   servicevendor.VendorAnalyticsService
+	loc       *time.Location
+}
+
+func NewExportService(
+	repo repoexport.ExportRepository,
+	orderRepo repoorder.OrderRepository,
+	analytics serviceanalytics.AnalyticsService,
+	vendors servicevendor.VendorAnalyticsService,
+) ExportService {
+	loc, err := time.LoadLocation(exportTimezone)
+	if err != nil {
+		loc = time.FixedZone("WAT", 3600)
+	}
+	return &exportService{repo: repo, orderRepo: orderRepo, analytics: analytics, vendors: vendors, loc: loc}
+}
+
+func (s *exportService) PrepareEventExport(ctx context.Context, req models.ExportRequest) (int, error) {
+	owner, err := s.repo.GetEventOrganizerID(ctx, req.EventID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrEventNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	if owner != req.OrganizerID {
+		return 0, ErrNotEventOrganizer
+	}
+
+	switch req.Kind {
+	case models.ExportKindAttendees:
+		return s.orderRepo.CountEventAttendees(ctx, req.EventID)
+	case models.ExportKindOrders:
+		return s.orderRepo.CountEventOrders(ctx, req.EventID)
+	}
+	// Summaries are a few dozen rows whatever the event size
+	return 0, nil
+}
+
+// WriteEventExport writes the export to w and returns the number of data
+// rows. Ownership must already have been checked with PrepareEventExport.
+func (s *exportService) WriteEventExport(ctx context.Context, req models.ExportRequest, w io.Writer) (int, error) {
+	format, ok := export.ParseFormat(req.Format)
+	if !ok {
+		return 0, fmt.Errorf("unsupported export format %q", req.Format)
+	}
+	out, err := export.NewRowWriter(format, w, string(req.Kind))
+	if err != nil {
+		return 0, err
+	}
+
+	var rows int
+	switch req.Kind {
+	case models.ExportKindAttendees:
+		rows, err = s.writeAttendees(ctx, req.EventID, out)
+	case models.ExportKindOrders:
+		rows, err = s.writeOrders(ctx, req.EventID, out)
+	case models.ExportKindSummary:
+		rows, err = s.writeSummary(ctx, req, out)
+	default:
+		err = fmt.Errorf("unsupported export kind %q", req.Kind)
+	}
+	if err != nil {
+		return rows, err
+	}
+	return rows, out.Close()
+}
+
+func (s *exportService) writeAttendees(ctx context.Context, eventID uuid.UUID, out export.RowWriter) (int, error) {
+	table := &headedRows{out: out, header: []any{"Ticket Code", "First Name", "Last Name", "Email", "Phone", "Tier",
+		"Status", "Checked In", "Checked In At (WAT)", "Order Reference", "Purchased At (WAT)"}}
+
+	rows := 0
+	err := s.orderRepo.StreamEventAttendees(ctx, eventID, func(a models.AttendeeExportRow) error {
+		rows++
+		return table.WriteRow(a.Code, a.FirstName, a.LastName, a.Email, a.Phone, a.TierName,
+			a.Status, a.CheckedIn, s.local(a.CheckedInAt), a.OrderReference, s.local(a.PurchasedAt))
+	})
+	if err != nil {
+		return rows, err
+	}
+	return rows, table.finish()
+}
+
+func (s *exportService) writeOrders(ctx context.Context, eventID uuid.UUID, out export.RowWriter) (int, error) {
+	table := &headedRows{out: out, header: []any{"Reference", "Status", "First Name", "Last Name", "Email", "Phone",
+		"Tickets", "Ticket Subtotal (NGN)", "Service Fee (NGN)", "VAT (NGN)", "Order Total (NGN)",
+		"Amount Paid (NGN)", "Payment Channel", "Refund Status", "Created At (WAT)", "Paid At (WAT)"}}
+
+	rows := 0
+	err := s.orderRepo.StreamEventOrders(ctx, eventID, func(o models.OrderLedgerRow) error {
+		rows++
+		return table.WriteRow(o.Reference, o.Status, o.FirstName, o.LastName, o.Email, o.Phone,
+			o.Tickets, naira(o.EventSubtotal), naira(o.ServiceFee), naira(o.VATAmount), naira(o.FinalTotal),
+			naira(o.AmountPaid), o.PaymentChannel, o.RefundStatus, s.local(&o.CreatedAt), s.local(o.PaidAt))
+	})
+	if err != nil {
+		return rows, err
+	}
+	return rows, table.finish()
+}
+
+// headedRows holds the header row back until the first data row arrives, so
+// a stream that fails before producing anything has written nothing
+type headedRows struct {
+	out     export.RowWriter
+	header  []any
+	written bool
+}
+
+func (h *headedRows) WriteRow(cells ...any) error {
+	if err := h.finish(); err != nil {
+		return err
+	}
+	return h.out.WriteRow(cells...)
+}
+
+// finish writes the header if no data row has yet, for empty exports
+func (h *headedRows) finish() error {
+	if h.written {
+		return nil
+	}
+	h.written = true
+	return h.out.WriteRow(h.header...)
+}
+
+func (s *exportService) writeSummary(ctx context.Context, req models.ExportRequest, out export.RowWriter) (int, error) {
+	analytics, err := s.analytics.GetEventAnalytics(ctx, req.EventID, req.OrganizerID, false)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := summaryRows(analytics)
+	if err := out.WriteRow("Section", "Metric", "Value"); err != nil {
+		return 0, err
+	}
+	for _, row := range rows {
+		if err := out.WriteRow(row...); err != nil {
+			return 0, err
+		}
+	}
+	return len(rows), nil
+}
+
+// QueueEventExport records a background job and returns it with the
+// download token. The token is only ever shown here.
+func (s *exportService) QueueEventExport(ctx context.Context, req models.ExportRequest) (*models.ExportJob, string, error) {
+	format, ok := export.ParseFormat(req.Format)
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported export format %q", req.Format)
+	}
+
+	token, err := generateDownloadToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate download token: %w", err)
+	}
+
+	now := time.Now()
+	job := &models.ExportJob{
+		ID:          uuid.New(),
+		OrganizerID: req.OrganizerID,
+		EventID:     req.EventID,
+		Kind:        req.Kind,
+		Format:      string(format),
+		Status:      models.ExportJobPending,
+		Filename:    Filename(req, format, now),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(jobRetention),
+	}
+	if err := s.repo.CreateJob(ctx, job, token); err != nil {
+		return nil, "", err
+	}
+	return job, token, nil
+}
+
+func (s *exportService) GetJob(ctx context.Context, jobID, organizerID uuid.UUID) (*models.ExportJob, error) {
+	job, err := s.repo.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	// Someone else's job looks the same as a missing one
+	if job.OrganizerID != organizerID {
+		return nil, ErrJobNotFound
+	}
+	return job, nil
+}
+
+// GetDownload returns a finished job with its file. Tokens aren't consumed;
+// the same link keeps working until the job expires.
+func (s *exportService) GetDownload(ctx context.Context, token string) (*models.ExportJob, error) {
+	job, err := s.repo.GetJobByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case time.Now().After(job.ExpiresAt):
+		return nil, ErrJobExpired
+	case job.Status == models.ExportJobFailed:
+		return nil, ErrJobFailed
+	case job.Status != models.ExportJobDone:
+		return nil, ErrJobNotReady
+	}
+	return job, nil
+}
+
+// StartExportWorker builds queued exports on an interval until ctx is cancelled
+func (s *exportService) StartExportWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("📦 Export worker started (Interval: %v)", interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("📦 Export worker shutting down...")
+			return
+		case <-ticker.C:
+			s.processJobs(ctx)
+		}
+	}
+}
+
+func (s *exportService) processJobs(ctx context.Context) {
+	now := time.Now()
+	if purged, err := s.repo.PurgeExpiredJobs(ctx, now); err != nil {
+		log.Warn().Err(err).Msg("Exports: failed to purge expired jobs")
+	} else if purged > 0 {
+		log.Info().Int64("purged", purged).Msg("Exports: purged expired jobs")
+	}
+
+	jobs, err := s.repo.ClaimJobs(ctx, jobsPerRun, now.Add(-staleJobAfter))
+	if err != nil {
+		log.Error().Err(err).Msg("Exports: failed to claim jobs")
+		return
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		s.runJob(ctx, job)
+	}
+}
+
+func (s *exportService) runJob(ctx context.Context, job models.ExportJob) {
+	req := models.ExportRequest{
+		EventID:     job.EventID,
+		OrganizerID: job.OrganizerID,
+		Kind:        job.Kind,
+		Format:      job.Format,
+	}
+
+	var buf bytes.Buffer
+	rows, err := s.WriteEventExport(ctx, req, &buf)
+	if err != nil {
+		log.Warn().Err(err).Str("job_id", job.ID.String()).Int("attempt", job.Attempts).Msg("Exports: job failed")
+		if err := s.repo.FailJob(ctx, job.ID, err.Error(), maxJobAttempts); err != nil {
+			log.Error().Err(err).Str("job_id", job.ID.String()).Msg("Exports: failed to record job failure")
+		}
+		return
+	}
+
+	if err := s.repo.CompleteJob(ctx, job.ID, buf.Bytes(), rows, time.Now().Add(jobRetention)); err != nil {
+		log.Error().Err(err).Str("job_id", job.ID.String()).Msg("Exports: failed to store export")
+		return
+	}
+
+	log.Info().
+		Str("job_id", job.ID.String()).
+		Str("kind", string(job.Kind)).
+		Int("rows", rows).
+		Int("bytes", buf.Len()).
+		Msg("📦 Export ready")
+}
+
+// Filename names an event export, e.g. "event-3f2a9c1b-attendees-2025-01-15.csv"
+func Filename(req models.ExportRequest, format export.Format, at time.Time) string {
+	return format.Filename(fmt.Sprintf("event-%s-%s", req.EventID.String()[:8], req.Kind), at)
+}
+
+func (s *exportService) local(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	l := t.In(s.loc)
+	return &l
+}
+
+func naira(kobo int64) float64 {
+	return float64(kobo) / 100
+}
+
+func generateDownloadToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,270 @@
+// backend/pkg/services/export/export_summary.go
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/eventify/backend/pkg/export"
+	"github.com/eventify/backend/pkg/models"
+
+	"github.com/google/uuid"
+)
+
+// summaryRows flattens event analytics into Section / Metric / Value rows.
+// Money is shown in Naira.
+func summaryRows(a *models.AnalyticsResponse) [][]any {
+	rows := [][]any{
+		{"Event", "Title", a.EventTitle},
+		{"Event", "Status", a.Overview.Status},
+		{"Event", "Days Until Event", a.Overview.DaysUntilEvent},
+
+		{"Tickets", "Inventory", a.Tickets.TotalInventory},
+		{"Tickets", "Sold", a.Tickets.TotalSold},
+		{"Tickets", "Remaining", a.Tickets.TotalRemaining},
+		{"Tickets", "Sell-Through Rate (%)", a.Tickets.SellThroughRate},
+		{"Tickets", "Sold Per Day", a.Tickets.VelocityPerDay},
+
+		{"Revenue", "Gross (NGN)", naira(int64(a.Revenue.Gross))},
+		{"Revenue", "Service Fees (NGN)", naira(int64(a.Revenue.ServiceFees))},
+		{"Revenue", "VAT (NGN)", naira(int64(a.Revenue.VAT))},
+		{"Revenue", "Net (NGN)", naira(int64(a.Revenue.Net))},
+		{"Revenue", "Average Order Value (NGN)", a.Revenue.AverageOrderValue / 100},
+		{"Revenue", "Average Ticket Price (NGN)", a.Revenue.AverageTicketPrice / 100},
+
+		{"Orders", "Total", a.Orders.Total},
+		{"Orders", "Successful", a.Orders.Successful},
+		{"Orders", "Pending", a.Orders.Pending},
+		{"Orders", "Failed", a.Orders.Failed},
+		{"Orders", "Fraud", a.Orders.Fraud},
+		{"Orders", "Conversion Rate (%)", a.Orders.ConversionRate},
+		{"Orders", "Abandonment Rate (%)", a.Orders.AbandonmentRate},
+
+		{"Customers", "Unique Customers", a.Customers.UniqueCustomers},
+		{"Customers", "Repeat Customers", a.Customers.RepeatCustomers},
+	}
+
+	for _, t := range a.Tiers {
+		section := "Tier: " + t.TierName
+		rows = append(rows,
+			[]any{section, "Price (NGN)", naira(int64(t.PriceKobo))},
+			[]any{section, "Stock", t.TotalStock},
+			[]any{section, "Sold", t.Sold},
+			[]any{section, "Revenue (NGN)", naira(int64(t.Revenue))},
+			[]any{section, "Sell-Through Rate (%)", t.SellThroughRate},
+		)
+	}
+
+	for _, stage := range a.Funnel.Stages {
+		section := "Funnel: " + stage.Stage
+		rows = append(rows,
+			[]any{section, "Count", stage.Count},
+			[]any{section, "Visitors", stage.Visitors},
+			[]any{section, "Rate From Previous (%)", stage.RateFromPrevious},
+		)
+	}
+	rows = append(rows, []any{"Funnel", "Conversion Rate (%)", a.Funnel.ConversionRate})
+
+	for _, ch := range a.Payments.Channels {
+		section := "Payments: " + ch.Channel
+		rows = append(rows,
+			[]any{section, "Orders", ch.OrderCount},
+			[]any{section, "Revenue (NGN)", naira(int64(ch.Revenue))},
+			[]any{section, "Success Rate (%)", ch.SuccessRate},
+		)
+	}
+
+	for _, c := range a.Customers.TopCountries {
+		rows = append(rows, []any{"Country: " + c.Country, "Revenue (NGN)", naira(int64(c.Revenue))})
+	}
+
+	return rows
+}
+
+// WriteVendorExport writes a vendor's analytics summary for the vendor's
+// owner. Vendor summaries are small, so they are always streamed.
+func (s *exportService) WriteVendorExport(ctx context.Context, vendorID, ownerID uuid.UUID, format export.Format, w io.Writer) (int, error) {
+	if err := s.vendors.CheckVendorOwner(ctx, vendorID, ownerID); err != nil {
+		return 0, err
+	}
+
+	analytics, err := s.vendors.GetVendorAnalytics(ctx, vendorID)
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := export.NewRowWriter(format, w, "Vendor Analytics")
+	if err != nil {
+		return 0, err
+	}
+	rows := vendorSummaryRows(analytics)
+	if err := out.WriteRow("Section", "Metric", "Value"); err != nil {
+		return 0, err
+	}
+	for _, row := range rows {
+		if err := out.WriteRow(row...); err != nil {
+			return 0, err
+		}
+	}
+	return len(rows), out.Close()
+}
+
+func vendorSummaryRows(a *models.VendorAnalyticsResponse) [][]any {
+	rows := [][]any{
+		{"Vendor", "Name", a.VendorName},
+		{"Vendor", "Category", a.Category},
+		{"Vendor", "PVS Score", a.Overview.CurrentPVSScore},
+		{"Vendor", "Profile Completion (%)", a.Overview.ProfileCompletion},
+		{"Vendor", "Verified", a.Overview.IsVerified},
+		{"Vendor", "Days On Platform", a.Performance.DaysOnPlatform},
+
+		{"Inquiries", "Total", a.Inquiries.Total},
+		{"Inquiries", "Pending", a.Inquiries.Pending},
+		{"Inquiries", "Responded", a.Inquiries.Responded},
+		{"Inquiries", "Closed", a.Inquiries.Closed},
+		{"Inquiries", "Response Rate (%)", a.Inquiries.ResponseRate},
+		{"Inquiries", "Average Response Time", a.Inquiries.AverageResponseTime},
+		{"Inquiries", "Trend", a.Inquiries.InquiryTrend},
+
+		{"Reviews", "Total", a.Reviews.TotalReviews},
+		{"Reviews", "Approved", a.Reviews.ApprovedReviews},
+		{"Reviews", "Average Rating", a.Reviews.AverageRating},
+		{"Reviews", "5 Star", a.Reviews.RatingDistribution.FiveStar},
+		{"Reviews", "4 Star", a.Reviews.RatingDistribution.FourStar},
+		{"Reviews", "3 Star", a.Reviews.RatingDistribution.ThreeStar},
+		{"Reviews", "2 Star", a.Reviews.RatingDistribution.TwoStar},
+		{"Reviews", "1 Star", a.Reviews.RatingDistribution.OneStar},
+	}
+
+	for _, period := range []struct {
+		name string
+		m    models.PeriodMetrics
+	}{{"Last 7 Days", a.Trends.Last7Days}, {"Last 30 Days", a.Trends.Last30Days}} {
+		rows = append(rows,
+			[]any{period.name, "Inquiries", period.m.InquiryCount},
+			[]any{period.name, "Responded", period.m.RespondedCount},
+			[]any{period.name, "Response Rate (%)", period.m.ResponseRate},
+			[]any{period.name, "New Reviews", period.m.NewReviews},
+			[]any{period.name, "Average Rating", period.m.AverageRating},
+		)
+	}
+
+	for _, period := range []struct {
+		name string
+		m    models.VisibilityMetrics
+	}{{"Visibility (7 Days)", a.Visibility.Last7Days}, {"Visibility (30 Days)", a.Visibility.Last30Days}} {
+		rows = append(rows,
+			[]any{period.name, "List Impressions", period.m.ListImpressions},
+			[]any{period.name, "Profile Views", period.m.ProfileViews},
+			[]any{period.name, "Phone Reveals", period.m.PhoneReveals},
+			[]any{period.name, "Email Reveals", period.m.EmailReveals},
+			[]any{period.name, "Click-Through Rate (%)", period.m.ClickThroughRate},
+			[]any{period.name, "View To Inquiry Rate (%)", period.m.InquiryConversionRate},
+		)
+	}
+
+	for i, insight := range a.Insights {
+		rows = append(rows, []any{fmt.Sprintf("Insight %d", i+1), insight.Title, insight.Description})
+	}
+
+	return rows
+}
+
+// WritePlatformExport writes the admin platform summary followed by its
+// daily series as a second table. Like vendor summaries, it is always
+// streamed.
+func (s *exportService) WritePlatformExport(ctx context.Context, q models.PlatformAnalyticsQuery, format export.Format, w io.Writer) (int, error) {
+	analytics, err := s.analytics.GetPlatformAnalytics(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := export.NewRowWriter(format, w, "Platform Analytics")
+	if err != nil {
+		return 0, err
+	}
+
+	rows := platformSummaryRows(analytics)
+	if err := out.WriteRow("Section", "Metric", "Value"); err != nil {
+		return 0, err
+	}
+	for _, row := range rows {
+		if err := out.WriteRow(row...); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := out.WriteRow(); err != nil {
+		return 0, err
+	}
+	if err := out.WriteRow("Day", "GMV (NGN)", "Platform Fees (NGN)", "Successful Orders", "New Vendors", "New Users", "Active Users", "Feedback"); err != nil {
+		return 0, err
+	}
+	for _, d := range analytics.Daily {
+		if err := out.WriteRow(d.Day, naira(d.GMV), naira(d.PlatformFees), d.SuccessfulOrders, d.NewVendors, d.NewUsers, d.ActiveUsers, d.Feedback); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(rows) + len(analytics.Daily), out.Close()
+}
+
+func platformSummaryRows(a *models.PlatformAnalytics) [][]any {
+	rows := [][]any{
+		{"Range", "From", a.From},
+		{"Range", "To", a.To},
+		{"Range", "Timezone", a.Timezone},
+
+		{"Sales", "GMV (NGN)", naira(a.Sales.GMV)},
+		{"Sales", "Platform Fees (NGN)", naira(a.Sales.PlatformFees)},
+		{"Sales", "Take Rate (%)", a.Sales.TakeRate},
+		{"Sales", "Successful Orders", a.Sales.SuccessfulOrders},
+		{"Sales", "Average Order Value (NGN)", a.Sales.AverageOrderValue / 100},
+
+		{"Orders", "Total", a.Orders.Total},
+	}
+
+	for _, status := range sortedKeys(a.Orders.ByStatus) {
+		rows = append(rows, []any{"Orders", "Status: " + status, a.Orders.ByStatus[status]})
+	}
+
+	rows = append(rows,
+		[]any{"Vendors", "New In Range", a.Vendors.NewInRange},
+		[]any{"Vendors", "Active", a.Vendors.Funnel.Active},
+		[]any{"Vendors", "Suspended", a.Vendors.Funnel.Suspended},
+	)
+	for _, stage := range a.Vendors.Stages {
+		section := "Vendor Funnel: " + stage.Stage
+		rows = append(rows,
+			[]any{section, "Count", stage.Count},
+			[]any{section, "Rate From Previous (%)", stage.RateFromPrevious},
+		)
+	}
+
+	rows = append(rows, []any{"Feedback", "Total", a.Feedback.Total})
+	for _, t := range sortedKeys(a.Feedback.ByType) {
+		rows = append(rows, []any{"Feedback", "Type: " + t, a.Feedback.ByType[t]})
+	}
+
+	rows = append(rows,
+		[]any{"Users", "New In Range", a.Users.NewInRange},
+		[]any{"Users", "Active In Range", a.Users.ActiveInRange},
+		[]any{"Users", "Average DAU", a.Users.AverageDAU},
+		[]any{"Users", "Peak DAU", a.Users.PeakDAU},
+		[]any{"Users", "Peak Day", a.Users.PeakDay},
+	)
+
+	return rows
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/eventify/backend/pkg/models"
 	repofeedback "github.com/eventify/backend/pkg/repository/feedback"
+	servicemedia "github.com/eventify/backend/pkg/services/media"
 	
 	"github.com/google/uuid"
 )
@@ -20,11 +21,13 @@ type FeedbackService interface {
 }
 
 type feedbackService struct {
-	repo repofeedback.FeedbackRepository
+	repo  repofeedback.FeedbackRepository
+	media servicemedia.MediaService
 }
 
-func NewFeedbackService(repo repofeedback.FeedbackRepository) FeedbackService {
+func NewFeedbackService(repo repofeedback.FeedbackRepository, media servicemedia.MediaService) FeedbackService {
 	return &feedbackService{
-		repo: repo,
+		repo:  repo,
+		media: media,
 	}
 }
\ No newline at end of file
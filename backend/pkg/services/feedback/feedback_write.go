@@ -44,8 +44,20 @@ func (s *feedbackService) CreateFeedback(ctx context.Context, req models.CreateF
 		log.Debug().Str("guest_id", guestID).Msg("Feedback from guest user")
 	}
 
-	// Handle optional ImageURL - convert from pointer to sql.NullString
-	feedback.ImageURL = models.ToSQLNullString(req.ImageURL)
+	// Handle optional image: an uploaded asset wins; a bare URL must be one we host
+	if req.ImageAssetID != nil {
+		asset, err := s.media.ResolveImage(ctx, *req.ImageAssetID, models.AssetOwner{UserID: userID, GuestID: guestID}, models.AssetPurposeFeedback)
+		if err != nil {
+			return nil, err
+		}
+		feedback.ImageAssetID = sql.Null[uuid.UUID]{V: asset.ID, Valid: true}
+		feedback.ImageURL = sql.NullString{String: asset.URL(models.VariantHero), Valid: true}
+	} else {
+		feedback.ImageURL = models.ToSQLNullString(req.ImageURL)
+		if feedback.ImageURL.Valid && !s.media.IsHostedURL(feedback.ImageURL.String) {
+			return nil, models.NewValidationError("image must be uploaded first: send imageAssetId from /api/v1/media/images")
+		}
+	}
 	if feedback.ImageURL.Valid {
 		log.Debug().Str("image_url", feedback.ImageURL.String).Msg("Feedback includes image")
 	}
@@ -0,0 +1,152 @@
+// backend/pkg/services/geocoding/geocoder.go
+
+package geocoding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+)
+
+// ErrNoMatch is returned when an address can't be resolved
+var ErrNoMatch = errors.New("no coordinates found for address")
+
+// Geocoder turns a free-text venue address into coordinates
+type Geocoder interface {
+	Geocode(ctx context.Context, address string) (*models.GeoPoint, error)
+}
+
+// NewGeocoderFromEnv picks the geocoder from GEOCODER ("nominatim" or "stub").
+// The stub is the default so local development never calls out to the network.
+func NewGeocoderFromEnv() Geocoder {
+	switch strings.ToLower(os.Getenv("GEOCODER")) {
+	case "nominatim":
+		baseURL := os.Getenv("NOMINATIM_URL")
+		if baseURL == "" {
+			baseURL = "https://nominatim.openstreetmap.org"
+		}
+		return &NominatimGeocoder{
+			BaseURL:    baseURL,
+			UserAgent:  "eventify-backend",
+			HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		}
+	default:
+		return NewStubGeocoder()
+	}
+}
+
+// ============================================================================
+// OFFLINE STUB
+// ============================================================================
+
+// StubGeocoder resolves addresses to the centre of a known city. It needs no
+// network access and is good enough for "near me" in development and tests.
+type StubGeocoder struct {
+	cities map[string]models.GeoPoint
+}
+
+func NewStubGeocoder() *StubGeocoder {
+	return &StubGeocoder{cities: map[string]models.GeoPoint{
+		"lagos":         {Lat: 6.5244, Lng: 3.3792},
+		"ikeja":         {Lat: 6.6018, Lng: 3.3515},
+		"lekki":         {Lat: 6.4698, Lng: 3.5852},
+		"abuja":         {Lat: 9.0765, Lng: 7.3986},
+		"port harcourt": {Lat: 4.8156, Lng: 7.0498},
+		"ibadan":        {Lat: 7.3775, Lng: 3.9470},
+		"kano":          {Lat: 12.0022, Lng: 8.5920},
+		"enugu":         {Lat: 6.5244, Lng: 7.5105},
+		"benin city":    {Lat: 6.3350, Lng: 5.6037},
+		"kaduna":        {Lat: 10.5105, Lng: 7.4165},
+		"abeokuta":      {Lat: 7.1475, Lng: 3.3619},
+		"calabar":       {Lat: 4.9757, Lng: 8.3417},
+		"jos":           {Lat: 9.8965, Lng: 8.8583},
+		"uyo":           {Lat: 5.0377, Lng: 7.9128},
+		"accra":         {Lat: 5.6037, Lng: -0.1870},
+		"nairobi":       {Lat: -1.2921, Lng: 36.8219},
+	}}
+}
+
+// Geocode returns the known place that appears earliest in the address, so a
+// district in the street address (Ikeja, Lekki) beats the city listed after it.
+func (g *StubGeocoder) Geocode(_ context.Context, address string) (*models.GeoPoint, error) {
+	lower := strings.ToLower(address)
+
+	var best string
+	bestPos := -1
+	for name := range g.cities {
+		if pos := strings.Index(lower, name); pos >= 0 && (bestPos < 0 || pos < bestPos) {
+			best, bestPos = name, pos
+		}
+	}
+	if bestPos < 0 {
+		return nil, ErrNoMatch
+	}
+
+	point := g.cities[best]
+	return &point, nil
+}
+
+// ============================================================================
+// NOMINATIM (OpenStreetMap)
+// ============================================================================
+
+// NominatimGeocoder calls a Nominatim-compatible /search endpoint
+type NominatimGeocoder struct {
+	BaseURL    string
+	UserAgent  string
+	HTTPClient *http.Client
+}
+
+func (g *NominatimGeocoder) Geocode(ctx context.Context, address string) (*models.GeoPoint, error) {
+	q := url.Values{}
+	q.Set("q", address)
+	q.Set("format", "json")
+	q.Set("limit", "1")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.BaseURL+"/search?"+q.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create geocode request: %w", err)
+	}
+	// Nominatim's usage policy requires an identifying User-Agent
+	req.Header.Set("User-Agent", g.UserAgent)
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("geocode request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("geocoder returned status %d", resp.StatusCode)
+	}
+
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, fmt.Errorf("failed to decode geocode response: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNoMatch
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latitude in geocode response: %w", err)
+	}
+	lng, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid longitude in geocode response: %w", err)
+	}
+	return &models.GeoPoint{Lat: lat, Lng: lng}, nil
+}
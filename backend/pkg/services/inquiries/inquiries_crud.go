@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -50,21 +51,29 @@ func (s *inquiryService) CreateInquiry(ctx context.Context, inquiry *models.Inqu
 	return nil
 }
 
-func (s *inquiryService) GetInquiriesByVendor(ctx context.Context, vendorID string) ([]models.Inquiry, error) {
+func (s *inquiryService) GetInquiriesByVendor(ctx context.Context, vendorID, cursor string, limit int) ([]models.Inquiry, *models.PageInfo, error) {
 	if vendorID == "" {
-		return nil, errors.New("vendor ID required")
+		return nil, nil, errors.New("vendor ID required")
 	}
 
 	vendorUUID, err := uuid.Parse(vendorID)
 	if err != nil {
-		return nil, errors.New("invalid vendor ID format: " + err.Error())
+		return nil, nil, errors.New("invalid vendor ID format: " + err.Error())
 	}
 
-	inquiries, err := s.InquiryReadRepo.GetByVendorID(ctx, vendorUUID)
+	inquiries, nextCursor, err := s.InquiryReadRepo.GetPageByVendorID(ctx, vendorUUID, cursor, limit)
 	if err != nil {
+		if errors.Is(err, utils.ErrInvalidCursor) {
+			return nil, nil, utils.NewError(utils.ErrCategoryValidation, "invalid or expired cursor", err)
+		}
 		log.Error().Err(err).Str("vendorID", vendorID).Msg("failed to fetch inquiries")
-		return nil, err
+		return nil, nil, err
 	}
 
-	return inquiries, nil
+	total, err := s.InquiryReadRepo.CountByVendorID(ctx, vendorUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inquiries, &models.PageInfo{NextCursor: nextCursor, Total: total, Limit: limit}, nil
 }
\ No newline at end of file
@@ -16,7 +16,7 @@ import (
 
 type InquiryService interface {
 	CreateInquiry(ctx context.Context, inquiry *models.Inquiry, userID *uuid.UUID) error
-	GetInquiriesByVendor(ctx context.Context, vendorID string) ([]models.Inquiry, error)
+	GetInquiriesByVendor(ctx context.Context, vendorID, cursor string, limit int) ([]models.Inquiry, *models.PageInfo, error)
 	UpdateInquiryStatus(ctx context.Context, inquiryID, status, response string) error
 	DeleteInquiry(ctx context.Context, id string) error
 }
@@ -42,6 +42,12 @@ func (s *inquiryService) UpdateInquiryStatus(ctx context.Context, inquiryID, sta
 		return err
 	}
 
+	if response != "" || status == "responded" {
+		if err := s.InquiryWriteRepo.MarkResponded(ctx, inquiryUUID); err != nil {
+			log.Warn().Err(err).Str("inquiryID", inquiryID).Msg("Failed to record inquiry response time")
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,268 @@
+// backend/pkg/services/media/image.go
+
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // register GIF decoder
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/eventify/backend/pkg/models"
+)
+
+// MaxUploadBytes caps a single image upload
+const MaxUploadBytes = 10 << 20
+
+// maxSourcePixels guards against decompression bombs: a tiny file that
+// claims enormous dimensions would otherwise allocate gigabytes on decode
+const maxSourcePixels = 40_000_000
+
+const jpegQuality = 85
+
+var (
+	ErrUnsupportedImage = errors.New("unsupported image type: upload a JPEG, PNG or GIF")
+	ErrImageTooLarge    = errors.New("image is too large")
+	ErrCorruptImage     = errors.New("image could not be decoded")
+)
+
+// variantSpec describes one rendition. Cropped variants fill the box exactly
+// (centre crop); the rest are scaled to fit inside it. Nothing is upscaled.
+type variantSpec struct {
+	name   string
+	width  int
+	height int
+	crop   bool
+}
+
+var variantSpecs = []variantSpec{
+	{name: models.VariantThumbnail, width: 200, height: 200, crop: true},
+	{name: models.VariantCard, width: 600, height: 400, crop: true},
+	{name: models.VariantHero, width: 1600, height: 1600, crop: false},
+}
+
+// renderedVariant is an encoded variant ready for the blob store
+type renderedVariant struct {
+	name        string
+	data        []byte
+	contentType string
+	ext         string
+	width       int
+	height      int
+}
+
+// processedImage is the result of running an upload through the pipeline
+type processedImage struct {
+	contentType string // sniffed type of the upload
+	width       int    // upright dimensions after EXIF orientation
+	height      int
+	variants    []renderedVariant
+}
+
+// sniffImage checks the leading bytes rather than trusting the client's
+// Content-Type header or file extension
+func sniffImage(data []byte) (string, error) {
+	switch ct := http.DetectContentType(data); ct {
+	case "image/jpeg", "image/png", "image/gif":
+		return ct, nil
+	default:
+		return "", ErrUnsupportedImage
+	}
+}
+
+// processImage decodes an upload, applies its EXIF orientation and renders
+// every variant. Re-encoding from pixels drops EXIF (GPS, camera serials)
+// and any other metadata the original carried.
+func processImage(data []byte) (*processedImage, error) {
+	if len(data) > MaxUploadBytes {
+		return nil, ErrImageTooLarge
+	}
+	contentType, err := sniffImage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCorruptImage
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxSourcePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCorruptImage
+	}
+
+	src := toRGBA(decoded)
+	if contentType == "image/jpeg" {
+		src = applyOrientation(src, exifOrientation(data))
+	}
+
+	out := &processedImage{
+		contentType: contentType,
+		width:       src.Bounds().Dx(),
+		height:      src.Bounds().Dy(),
+	}
+	for _, spec := range variantSpecs {
+		img := renderVariant(src, spec)
+		v, err := encodeVariant(img, contentType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s variant: %w", spec.name, err)
+		}
+		v.name = spec.name
+		out.variants = append(out.variants, v)
+	}
+	return out, nil
+}
+
+// encodeVariant keeps photos as JPEG; PNG and GIF become PNG so transparency
+// survives (only the first GIF frame is kept)
+func encodeVariant(img *image.RGBA, sourceType string) (renderedVariant, error) {
+	var buf bytes.Buffer
+	v := renderedVariant{width: img.Bounds().Dx(), height: img.Bounds().Dy()}
+
+	if sourceType == "image/jpeg" {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return v, err
+		}
+		v.contentType, v.ext = "image/jpeg", "jpg"
+	} else {
+		if err := png.Encode(&buf, img); err != nil {
+			return v, err
+		}
+		v.contentType, v.ext = "image/png", "png"
+	}
+	v.data = buf.Bytes()
+	return v, nil
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	return dst
+}
+
+// renderVariant crops and scales src for one variant spec
+func renderVariant(src *image.RGBA, spec variantSpec) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	region := src.Bounds()
+
+	if spec.crop {
+		region = centreCrop(sw, sh, spec.width, spec.height)
+		cw, ch := region.Dx(), region.Dy()
+		if cw <= spec.width && ch <= spec.height {
+			return resizeBox(src, region, cw, ch)
+		}
+		return resizeBox(src, region, spec.width, spec.height)
+	}
+
+	dw, dh := fitWithin(sw, sh, spec.width, spec.height)
+	return resizeBox(src, region, dw, dh)
+}
+
+// centreCrop returns the largest centred rectangle with the target aspect ratio
+func centreCrop(sw, sh, tw, th int) image.Rectangle {
+	if sw*th > sh*tw {
+		cw := sh * tw / th
+		x0 := (sw - cw) / 2
+		return image.Rect(x0, 0, x0+cw, sh)
+	}
+	ch := sw * th / tw
+	y0 := (sh - ch) / 2
+	return image.Rect(0, y0, sw, y0+ch)
+}
+
+// fitWithin scales (sw, sh) down to fit the box, keeping the aspect ratio
+func fitWithin(sw, sh, maxW, maxH int) (int, int) {
+	if sw <= maxW && sh <= maxH {
+		return sw, sh
+	}
+	if sw*maxH > sh*maxW {
+		return maxW, max(1, sh*maxW/sw)
+	}
+	return max(1, sw*maxH/sh), maxH
+}
+
+// resizeBox downsamples region of src to dw x dh by averaging every source
+// pixel that falls inside each destination pixel. It works on premultiplied
+// RGBA so transparent edges don't darken.
+func resizeBox(src *image.RGBA, region image.Rectangle, dw, dh int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	sw, sh := region.Dx(), region.Dy()
+
+	for y := 0; y < dh; y++ {
+		y0 := region.Min.Y + y*sh/dh
+		y1 := max(region.Min.Y+(y+1)*sh/dh, y0+1)
+
+		for x := 0; x < dw; x++ {
+			x0 := region.Min.X + x*sw/dw
+			x1 := max(region.Min.X+(x+1)*sw/dw, x0+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[src.PixOffset(x0, sy):]
+				for i := 0; i < (x1-x0)*4; i += 4 {
+					r += uint64(row[i])
+					g += uint64(row[i+1])
+					b += uint64(row[i+2])
+					a += uint64(row[i+3])
+					n++
+				}
+			}
+
+			o := dst.PixOffset(x, y)
+			dst.Pix[o] = uint8(r / n)
+			dst.Pix[o+1] = uint8(g / n)
+			dst.Pix[o+2] = uint8(b / n)
+			dst.Pix[o+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// applyOrientation rotates/flips src so it displays upright. Orientation
+// values follow the EXIF spec (1 = already upright).
+func applyOrientation(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirror horizontally
+				dx, dy = w-1-x, y
+			case 3: // rotate 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirror vertically
+				dx, dy = x, h-1-y
+			case 5: // transpose
+				dx, dy = y, x
+			case 6: // rotate 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // transverse
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotate 90 counter-clockwise
+				dx, dy = y, w-1-x
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], src.Pix[src.PixOffset(x, y):src.PixOffset(x, y)+4])
+		}
+	}
+	return dst
+}
@@ -0,0 +1,129 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+// withOrientation splices an EXIF APP1 segment carrying the orientation tag
+// in right after the JPEG SOI marker
+func withOrientation(jpg []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = append(tiff, 0x00, 0x01)                         // one IFD entry
+	tiff = append(tiff, 0x01, 0x12, 0x00, 0x03)             // orientation, SHORT
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x01)             // count 1
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation) // value
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00) // padding + next IFD
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, segment...)
+	return append(out, jpg[2:]...)
+}
+
+func variantByName(t *testing.T, img *processedImage, name string) renderedVariant {
+	for _, v := range img.variants {
+		if v.name == name {
+			return v
+		}
+	}
+	t.Fatalf("variant %s missing", name)
+	return renderedVariant{}
+}
+
+func TestImagePipeline(t *testing.T) {
+	t.Run("Rejects non-image uploads", func(t *testing.T) {
+		_, err := processImage([]byte("<html><script>alert(1)</script></html>"))
+		assert.ErrorIs(t, err, ErrUnsupportedImage)
+	})
+
+	t.Run("Rejects oversized uploads", func(t *testing.T) {
+		_, err := processImage(make([]byte, MaxUploadBytes+1))
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+	})
+
+	t.Run("PNG renders cropped and fitted variants", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, testImage(1000, 500)))
+
+		img, err := processImage(buf.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", img.contentType)
+
+		thumb := variantByName(t, img, models.VariantThumbnail)
+		assert.Equal(t, [2]int{200, 200}, [2]int{thumb.width, thumb.height})
+		card := variantByName(t, img, models.VariantCard)
+		assert.Equal(t, [2]int{600, 400}, [2]int{card.width, card.height})
+		hero := variantByName(t, img, models.VariantHero)
+		assert.Equal(t, [2]int{1000, 500}, [2]int{hero.width, hero.height}, "never upscaled")
+		assert.Equal(t, "image/png", hero.contentType)
+
+		decoded, err := png.Decode(bytes.NewReader(card.data))
+		require.NoError(t, err)
+		assert.Equal(t, 600, decoded.Bounds().Dx())
+	})
+
+	t.Run("Small sources are cropped but not upscaled", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, jpeg.Encode(&buf, testImage(100, 50), nil))
+
+		img, err := processImage(buf.Bytes())
+		require.NoError(t, err)
+
+		thumb := variantByName(t, img, models.VariantThumbnail)
+		assert.Equal(t, [2]int{50, 50}, [2]int{thumb.width, thumb.height})
+		card := variantByName(t, img, models.VariantCard)
+		assert.Equal(t, [2]int{75, 50}, [2]int{card.width, card.height})
+	})
+
+	t.Run("EXIF orientation is applied and then stripped", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, jpeg.Encode(&buf, testImage(80, 40), nil))
+		upload := withOrientation(buf.Bytes(), 6)
+		require.Equal(t, 6, exifOrientation(upload))
+
+		img, err := processImage(upload)
+		require.NoError(t, err)
+		assert.Equal(t, 40, img.width)
+		assert.Equal(t, 80, img.height)
+
+		hero := variantByName(t, img, models.VariantHero)
+		assert.Equal(t, "image/jpeg", hero.contentType)
+		assert.False(t, bytes.Contains(hero.data, []byte("Exif")))
+		assert.Equal(t, 1, exifOrientation(hero.data))
+	})
+
+	t.Run("Rotate 90 clockwise turns a row into a column", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+		src.Set(0, 0, color.RGBA{R: 255, A: 255})
+		src.Set(1, 0, color.RGBA{B: 255, A: 255})
+
+		dst := applyOrientation(src, 6)
+		assert.Equal(t, image.Rect(0, 0, 1, 2), dst.Bounds())
+		assert.Equal(t, color.RGBA{R: 255, A: 255}, dst.RGBAAt(0, 0))
+		assert.Equal(t, color.RGBA{B: 255, A: 255}, dst.RGBAAt(0, 1))
+	})
+}
@@ -0,0 +1,158 @@
+// backend/pkg/services/media/media_services.go
+
+package media
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	repomedia "github.com/eventify/backend/pkg/repository/media"
+	"github.com/eventify/backend/pkg/services/storage"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// MediaService owns uploaded images. Other services call ResolveImage to turn
+// a client-supplied asset ID into a URL instead of accepting arbitrary links.
+type MediaService interface {
+	UploadImage(ctx context.Context, owner models.AssetOwner, purpose models.AssetPurpose, data []byte) (*models.Asset, error)
+	GetAsset(ctx context.Context, id uuid.UUID) (*models.Asset, error)
+	ResolveImage(ctx context.Context, assetID uuid.UUID, owner models.AssetOwner, purpose models.AssetPurpose) (*models.Asset, error)
+	IsHostedURL(url string) bool
+}
+
+type mediaService struct {
+	repo  repomedia.AssetRepository
+	store storage.BlobStore
+}
+
+func NewMediaService(repo repomedia.AssetRepository, store storage.BlobStore) MediaService {
+	return &mediaService{repo: repo, store: store}
+}
+
+func (s *mediaService) UploadImage(ctx context.Context, owner models.AssetOwner, purpose models.AssetPurpose, data []byte) (*models.Asset, error) {
+	if !purpose.IsValid() {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "purpose must be one of: event, vendor, feedback", nil)
+	}
+	// Only feedback forms are open to guests
+	if owner.UserID == nil && (purpose != models.AssetPurposeFeedback || owner.GuestID == "") {
+		return nil, utils.NewError(utils.ErrCategoryAuth, "authentication required to upload this image", nil)
+	}
+
+	img, err := processImage(data)
+	if err != nil {
+		if errors.Is(err, ErrUnsupportedImage) || errors.Is(err, ErrImageTooLarge) || errors.Is(err, ErrCorruptImage) {
+			return nil, utils.NewError(utils.ErrCategoryValidation, err.Error(), err)
+		}
+		return nil, utils.NewError(utils.ErrCategoryInternal, "failed to process image", err)
+	}
+
+	asset := &models.Asset{
+		ID:          uuid.New(),
+		Purpose:     purpose,
+		ContentType: img.contentType,
+		Width:       img.width,
+		Height:      img.height,
+		SizeBytes:   int64(len(data)),
+		Variants:    models.AssetVariants{},
+		CreatedAt:   time.Now().UTC(),
+	}
+	if owner.UserID != nil {
+		asset.OwnerID = sql.Null[uuid.UUID]{V: *owner.UserID, Valid: true}
+	} else {
+		asset.GuestID = sql.NullString{String: owner.GuestID, Valid: true}
+	}
+
+	for _, v := range img.variants {
+		key := fmt.Sprintf("images/%s/%s.%s", asset.ID, v.name, v.ext)
+		if err := s.store.Put(ctx, key, v.data, v.contentType); err != nil {
+			s.removeVariants(asset)
+			return nil, utils.NewError(utils.ErrCategoryInternal, "failed to store image", err)
+		}
+		asset.Variants[v.name] = models.AssetVariant{
+			Key:         key,
+			URL:         s.store.URL(key),
+			ContentType: v.contentType,
+			Width:       v.width,
+			Height:      v.height,
+			SizeBytes:   int64(len(v.data)),
+		}
+	}
+
+	if err := s.repo.CreateAsset(ctx, asset); err != nil {
+		s.removeVariants(asset)
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to save image", err)
+	}
+
+	log.Info().
+		Str("asset_id", asset.ID.String()).
+		Str("purpose", string(purpose)).
+		Str("content_type", asset.ContentType).
+		Int("width", asset.Width).
+		Int("height", asset.Height).
+		Msg("Image uploaded")
+
+	return asset, nil
+}
+
+func (s *mediaService) GetAsset(ctx context.Context, id uuid.UUID) (*models.Asset, error) {
+	asset, err := s.repo.GetAssetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshURLs(asset)
+	return asset, nil
+}
+
+// ResolveImage checks that an asset exists, was uploaded by the caller and was
+// uploaded for this kind of record
+func (s *mediaService) ResolveImage(ctx context.Context, assetID uuid.UUID, owner models.AssetOwner, purpose models.AssetPurpose) (*models.Asset, error) {
+	asset, err := s.repo.GetAssetByID(ctx, assetID)
+	if errors.Is(err, repomedia.ErrAssetNotFound) {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "image asset not found", err)
+	}
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load image asset", err)
+	}
+	if !owner.Owns(asset) {
+		return nil, utils.NewError(utils.ErrCategoryAuth, "unauthorized: you didn't upload this image", nil)
+	}
+	if asset.Purpose != purpose {
+		return nil, utils.NewError(utils.ErrCategoryValidation, fmt.Sprintf("image was uploaded for %s, not %s", asset.Purpose, purpose), nil)
+	}
+	s.refreshURLs(asset)
+	return asset, nil
+}
+
+// IsHostedURL reports whether url is served from our own blob store. Records
+// saved before asset IDs existed can keep their URL only if it's one of ours.
+func (s *mediaService) IsHostedURL(url string) bool {
+	return url != "" && strings.HasPrefix(url, s.store.URL(""))
+}
+
+// refreshURLs rebuilds variant URLs from their keys so moving the store
+// (or putting a CDN in front of it) doesn't strand old assets
+func (s *mediaService) refreshURLs(asset *models.Asset) {
+	for name, v := range asset.Variants {
+		v.URL = s.store.URL(v.Key)
+		asset.Variants[name] = v
+	}
+}
+
+// removeVariants is best-effort cleanup after a failed upload
+func (s *mediaService) removeVariants(asset *models.Asset) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, v := range asset.Variants {
+		if err := s.store.Delete(ctx, v.Key); err != nil {
+			log.Warn().Err(err).Str("key", v.Key).Msg("Failed to clean up image variant")
+		}
+	}
+}
@@ -126,6 +126,8 @@ func (s *OrderServiceImpl) InitializePendingOrder(
         return nil, "", err // If DB fails, we stop here
     }
 
+    s.trackFunnelStep(pendingOrder, models.TrackCheckoutStart)
+
     // 5. EXTERNAL HANDSHAKE: Initialize Paystack Transaction
     // We do this OUTSIDE the DB transaction to avoid holding DB locks 
     // while waiting for an external network response.
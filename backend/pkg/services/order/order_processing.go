@@ -6,7 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	//"strings"
+	"strings"
 	"sync"
 	"time"
 	"encoding/json"
@@ -181,6 +181,8 @@ They can arrive:
 2. After successful verification (idempotent)
 3. Multiple times for the same transaction (idempotent)
 
+Refund events (refund.*) are routed to handleRefundWebhook.
+
 This function ensures:
 - Idempotent processing (duplicate webhooks are safe)
 - Doesn't fail for missing orders (prevents webhook retries)
@@ -196,6 +198,10 @@ func (s *OrderServiceImpl) ProcessWebhook(
 		return errors.New("webhook data is nil")
 	}
 
+	if strings.HasPrefix(payload.Event, "refund.") {
+		return s.handleRefundWebhook(ctx, payload.Event, data)
+	}
+
 	// Fetch order by Paystack reference
 	order, err := s.OrderRepo.GetOrderByReference(ctx, data.Reference)
 	if err != nil {
@@ -342,6 +348,10 @@ func (s *OrderServiceImpl) finalizeOrder(
             "ticket_codes": ticketCodes,
         }
         
+        if ics := s.buildOrderCalendar(ctx, order); ics != nil {
+            payload["calendar_ics"] = string(ics)
+        }
+
         payloadBytes, _ := json.Marshal(payload)
 
         outboxEntry := &models.EmailOutbox{
@@ -359,6 +369,9 @@ func (s *OrderServiceImpl) finalizeOrder(
         return order, fmt.Errorf("atomic finalization failed: %w", err)
     }
 
+    s.trackFunnelStep(order, models.TrackPurchase)
+    s.refreshAnalytics(order)
+
     log.Info().Str("ref", order.Reference).Msg("Order and Email successfully queued")
     return order, nil
 }
@@ -510,4 +523,29 @@ func (s *OrderServiceImpl) releaseReservedStockTx(ctx context.Context, tx *sqlx.
         Msg("Inventory successfully restored for failed/expired order")
         
     return nil
-}
\ No newline at end of file
+}
+// buildOrderCalendar renders an .ics covering every event in the order so the
+// ticket email can carry it as an attachment. Failures only skip the attachment.
+func (s *OrderServiceImpl) buildOrderCalendar(ctx context.Context, order *models.Order) []byte {
+	seen := make(map[uuid.UUID]bool)
+	var entries []utils.CalendarEvent
+
+	for _, item := range order.Items {
+		if seen[item.EventID] {
+			continue
+		}
+		seen[item.EventID] = true
+
+		event, err := s.EventRepo.GetEventByID(ctx, item.EventID, nil)
+		if err != nil {
+			log.Warn().Err(err).Str("event_id", item.EventID.String()).Msg("Skipping calendar attachment for event")
+			continue
+		}
+		entries = append(entries, event.CalendarEvent())
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+	return utils.BuildICalendar("", entries)
+}
@@ -0,0 +1,394 @@
+// backend/pkg/services/order/order_refunds.go
+
+package order
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	repoorder "github.com/eventify/backend/pkg/repository/order"
+	"github.com/eventify/backend/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ============================================================================
+// REFUNDS
+// ============================================================================
+
+const (
+	refundBatchSize      = 20
+	maxRefundAttempts    = 5
+	paystackRefundsPause = 200 * time.Millisecond // Keeps batches under Paystack's rate limit
+	// refundStaleAfter is how long a refund can sit in processing before it
+	// is treated as abandoned by a crashed worker
+	refundStaleAfter      = 15 * time.Minute
+	markSubmittedAttempts = 3
+)
+
+// RequestEventRefund lets a holder opt into a refund after the event was postponed
+func (s *OrderServiceImpl) RequestEventRefund(
+	ctx context.Context,
+	reference string,
+	eventID uuid.UUID,
+	userID *uuid.UUID,
+	guestID string,
+) (*models.OrderRefund, error) {
+	order, err := s.GetOrderByReference(ctx, reference, userID, guestID)
+	if err != nil || order == nil {
+		return nil, utils.NewError(utils.ErrCategoryAuth, "order not found", err)
+	}
+	if order.Status != models.OrderStatusSuccess {
+		return nil, utils.NewConflictError("only paid orders can be refunded", nil)
+	}
+
+	hasEvent := false
+	for _, item := range order.Items {
+		if item.EventID == eventID {
+			hasEvent = true
+			break
+		}
+	}
+	if !hasEvent {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "order has no tickets for this event", nil)
+	}
+
+	event, err := s.EventRepo.GetEventByID(ctx, eventID, nil)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryValidation, "event not found", err)
+	}
+	if event.CancelledAt != nil {
+		return nil, utils.NewConflictError("event was cancelled; your refund is already being processed", nil)
+	}
+	if event.RefundDeadline == nil {
+		return nil, utils.NewConflictError("refunds are only available for postponed events", nil)
+	}
+	if time.Now().After(*event.RefundDeadline) {
+		return nil, utils.NewConflictError("the refund window for this event has closed", nil)
+	}
+
+	refund, err := s.OrderRepo.CreateOptInRefund(ctx, order.ID, eventID)
+	if err != nil {
+		if errors.Is(err, repoorder.ErrRefundExists) {
+			return nil, utils.NewConflictError(err.Error(), err)
+		}
+		return nil, err
+	}
+	refund.OrderReference = order.Reference
+
+	log.Info().
+		Str("ref", order.Reference).
+		Str("event_id", eventID.String()).
+		Int64("amount_kobo", refund.AmountKobo).
+		Msg("Refund requested after postponement")
+
+	return refund, nil
+}
+
+// StartRefundWorker submits queued refunds to Paystack in small batches
+func (s *OrderServiceImpl) StartRefundWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Refund Worker started (Interval: %v, Batch: %d)", interval, refundBatchSize)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Refund Worker shutting down...")
+			return
+		case <-ticker.C:
+			s.ProcessPendingRefunds(ctx)
+		}
+	}
+}
+
+// ProcessPendingRefunds claims one batch of refunds and sends each to Paystack.
+// Every refund carries its own ID as Paystack's merchant note, and a refund
+// on a second or later attempt is first looked up there: an earlier attempt
+// may have been accepted even though recording it failed, and sending it
+// again would refund the buyer twice. Tickets are only released once
+// Paystack reports the refund processed (see ProcessWebhook).
+func (s *OrderServiceImpl) ProcessPendingRefunds(ctx context.Context) {
+	reclaimed, err := s.OrderRepo.ReclaimStaleRefunds(ctx, refundStaleAfter, maxRefundAttempts)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to reclaim stale refunds")
+	} else if reclaimed > 0 {
+		log.Warn().Int64("count", reclaimed).Msg("Requeued refunds left in processing")
+	}
+
+	refunds, err := s.OrderRepo.ClaimPendingRefunds(ctx, refundBatchSize)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to claim pending refunds")
+		return
+	}
+
+	for i := range refunds {
+		refund := &refunds[i]
+
+		paystackRefundID, err := s.sendRefund(ctx, refund)
+		if err != nil {
+			log.Error().Err(err).
+				Str("ref", refund.OrderReference).
+				Int("attempt", refund.Attempts).
+				Msg("Refund attempt failed")
+			if ferr := s.OrderRepo.FailRefund(ctx, refund.ID, err.Error(), maxRefundAttempts); ferr != nil {
+				log.Error().Err(ferr).Str("refund_id", refund.ID.String()).Msg("Failed to requeue refund")
+			}
+			continue
+		}
+
+		if err := s.markRefundSubmitted(ctx, refund.ID, paystackRefundID); err != nil {
+			// Left in processing; the retry finds this refund on Paystack instead of resending it
+			log.Error().Err(err).
+				Str("ref", refund.OrderReference).
+				Str("paystack_refund_id", paystackRefundID).
+				Msg("Paystack accepted a refund that could not be recorded; it will be picked up on retry")
+			continue
+		}
+
+		log.Info().
+			Str("ref", refund.OrderReference).
+			Int64("amount_kobo", refund.AmountKobo).
+			Msg("💸 Refund submitted to Paystack")
+
+		time.Sleep(paystackRefundsPause)
+	}
+
+	s.releaseRefundedTickets(ctx)
+
+	if err := s.OrderRepo.CompleteFinishedRefundBatches(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to update refund batches")
+	}
+}
+
+// sendRefund submits a refund to Paystack, unless an earlier attempt already
+// got through, in which case that refund's ID is returned
+func (s *OrderServiceImpl) sendRefund(ctx context.Context, refund *models.OrderRefund) (string, error) {
+	note := refund.ID.String()
+	if refund.Attempts > 1 {
+		paystackRefundID, found, err := s.PaystackClient.FindRefund(ctx, refund.OrderReference, note)
+		if err != nil {
+			return "", fmt.Errorf("could not check for an earlier refund: %w", err)
+		}
+		if found {
+			log.Warn().
+				Str("ref", refund.OrderReference).
+				Str("paystack_refund_id", paystackRefundID).
+				Msg("Found refund from an earlier attempt on Paystack; not sending again")
+			return paystackRefundID, nil
+		}
+	}
+	return s.PaystackClient.CreateRefund(ctx, refund.OrderReference, refund.AmountKobo, note)
+}
+
+// handleRefundWebhook applies Paystack's refund.processed and refund.failed
+// events. Processed refunds have their tickets released by the refund worker;
+// failed ones are left for the organizer to follow up.
+func (s *OrderServiceImpl) handleRefundWebhook(ctx context.Context, event string, data *models.PaystackData) error {
+	var status models.RefundStatus
+	reason := ""
+	switch event {
+	case "refund.processed":
+		status = models.RefundStatusRefunded
+	case "refund.failed":
+		status = models.RefundStatusFailed
+		reason = "Paystack could not process the refund"
+		if data.Message != nil && *data.Message != "" {
+			reason += ": " + *data.Message
+		}
+	default:
+		// refund.pending and refund.processing change nothing here
+		return nil
+	}
+
+	paystackRefundID := ""
+	if data.ID != 0 {
+		paystackRefundID = strconv.FormatInt(data.ID, 10)
+	}
+	transactionRef := data.TransactionReference
+	if transactionRef == "" {
+		transactionRef = data.Reference
+	}
+
+	refund, err := s.OrderRepo.RecordRefundOutcome(ctx, paystackRefundID, transactionRef, int64(data.Amount), status, reason)
+	if err != nil {
+		return err
+	}
+	if refund == nil {
+		log.Warn().
+			Str("event", event).
+			Str("ref", transactionRef).
+			Str("paystack_refund_id", paystackRefundID).
+			Msg("Refund webhook matched no submitted refund")
+		return nil
+	}
+
+	logEvent := log.Info()
+	if status == models.RefundStatusFailed {
+		logEvent = log.Error()
+	}
+	logEvent.
+		Str("ref", refund.OrderReference).
+		Str("event_id", refund.EventID.String()).
+		Str("status", string(status)).
+		Msg("Refund outcome received from Paystack")
+
+	if s.Analytics != nil {
+		s.Analytics.RefreshEventFactsAsync(refund.EventID)
+	}
+	return nil
+}
+
+// markRefundSubmitted retries the write that records Paystack's acceptance,
+// so a refund it already took is rarely left for the retry lookup
+func (s *OrderServiceImpl) markRefundSubmitted(ctx context.Context, refundID uuid.UUID, paystackRefundID string) error {
+	var err error
+	for attempt := 1; attempt <= markSubmittedAttempts; attempt++ {
+		if err = s.OrderRepo.MarkRefundSubmitted(ctx, refundID, paystackRefundID); err == nil {
+			return nil
+		}
+		time.Sleep(time.Duration(attempt) * paystackRefundsPause)
+	}
+	return err
+}
+
+// releaseRefundedTickets cancels the tickets of refunds Paystack has
+// processed, including any an earlier run failed to release
+func (s *OrderServiceImpl) releaseRefundedTickets(ctx context.Context) {
+	refunds, err := s.OrderRepo.GetUnreleasedRefunds(ctx, refundBatchSize)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list refunds awaiting ticket release")
+		return
+	}
+
+	for i := range refunds {
+		refund := &refunds[i]
+		err := s.OrderRepo.RunInTransaction(ctx, func(tx *sqlx.Tx) error {
+			return s.OrderRepo.ReleaseRefundTx(ctx, tx, refund)
+		})
+		if err != nil {
+			log.Error().Err(err).Str("ref", refund.OrderReference).Msg("Failed to release refunded tickets; will retry")
+			continue
+		}
+
+		if s.Analytics != nil {
+			s.Analytics.RefreshEventFactsAsync(refund.EventID)
+		}
+	}
+}
+
+/*
+CreateRefund asks Paystack to refund (part of) a transaction.
+
+Paystack queues the refund and reports the final outcome via webhook;
+a successful call here means the refund was accepted. note is stored as
+the merchant note so the refund can be found again with FindRefund.
+*/
+func (c *PaystackClientImpl) CreateRefund(ctx context.Context, reference string, amountKobo int64, note string) (string, error) {
+	url := "https://api.paystack.co/refund"
+
+	payload := map[string]interface{}{
+		"transaction":   reference,
+		"amount":        amountKobo,
+		"merchant_note": note,
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal paystack refund payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return "", fmt.Errorf("failed to create paystack refund request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.SecretKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("paystack refund request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("paystack refund returned status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var res struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			ID int64 `json:"id"`
+		} `json:"data"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return "", fmt.Errorf("failed to decode paystack refund response: %w", err)
+	}
+
+	if !res.Status {
+		return "", fmt.Errorf("paystack refund error: %s", res.Message)
+	}
+
+	return strconv.FormatInt(res.Data.ID, 10), nil
+}
+
+// FindRefund looks for a refund on a transaction carrying the given merchant
+// note and returns its Paystack ID
+func (c *PaystackClientImpl) FindRefund(ctx context.Context, reference, note string) (string, bool, error) {
+	url := "https://api.paystack.co/refund?perPage=100&transaction=" + neturl.QueryEscape(reference)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create paystack refund lookup: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.SecretKey)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", false, fmt.Errorf("paystack refund lookup failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return "", false, fmt.Errorf("paystack refund lookup returned status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var res struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    []struct {
+			ID           int64  `json:"id"`
+			MerchantNote string `json:"merchant_note"`
+			Status       string `json:"status"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return "", false, fmt.Errorf("failed to decode paystack refund lookup: %w", err)
+	}
+	if !res.Status {
+		return "", false, fmt.Errorf("paystack refund lookup error: %s", res.Message)
+	}
+
+	for _, r := range res.Data {
+		if r.MerchantNote == note && r.Status != "failed" {
+			return strconv.FormatInt(r.ID, 10), true, nil
+		}
+	}
+	return "", false, nil
+}
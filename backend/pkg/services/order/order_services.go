@@ -19,6 +19,7 @@ import (
 	"github.com/eventify/backend/pkg/models"
 	repoevent "github.com/eventify/backend/pkg/repository/event"
 	repoorder "github.com/eventify/backend/pkg/repository/order"
+	servicetracking "github.com/eventify/backend/pkg/services/tracking"
 
 	"github.com/google/uuid"
 )
@@ -36,6 +37,13 @@ type PricingService interface {
 type PaystackClient interface {
 	InitializeTransaction(ctx context.Context, email string, amountKobo int64, reference string) (string, error)
 	VerifyTransaction(ctx context.Context, reference string) (*models.PaystackVerificationResponse, error)
+	CreateRefund(ctx context.Context, reference string, amountKobo int64, note string) (string, error)
+	FindRefund(ctx context.Context, reference, note string) (string, bool, error)
+}
+
+// AnalyticsRefresher rebuilds an event's analytics facts after its sales change
+type AnalyticsRefresher interface {
+	RefreshEventFactsAsync(eventIDs ...uuid.UUID)
 }
 
 // OrderService defines the core order processing operations
@@ -59,6 +67,10 @@ type OrderService interface {
 	VerifyWebhookSignature(body []byte, signature string) bool
 	StartStockReleaseWorker(ctx context.Context, interval time.Duration, expiry time.Duration)
 
+	// Refunds
+	RequestEventRefund(ctx context.Context, reference string, eventID uuid.UUID, userID *uuid.UUID, guestID string) (*models.OrderRefund, error)
+	StartRefundWorker(ctx context.Context, interval time.Duration)
+
 }
 
 // ============================================================================
@@ -79,6 +91,8 @@ type OrderServiceImpl struct {
 	PricingService PricingService
 	PaystackClient PaystackClient
 	PaystackSecret string
+	Tracking       servicetracking.TrackingService
+	Analytics      AnalyticsRefresher
 }
 
 // NewOrderService creates a new order service instance
@@ -87,6 +101,8 @@ func NewOrderService(
 	eventRepo repoevent.EventRepository,
 	pricingService PricingService,
 	psClient PaystackClient,
+	tracking servicetracking.TrackingService,
+	analytics AnalyticsRefresher,
 ) OrderService {
 	return &OrderServiceImpl{
 		OrderRepo:      orderRepo,
@@ -94,6 +110,43 @@ func NewOrderService(
 		PricingService: pricingService,
 		PaystackClient: psClient,
 		PaystackSecret: os.Getenv("PAYSTACK_SECRET_KEY"),
+		Tracking:       tracking,
+		Analytics:      analytics,
+	}
+}
+
+// refreshAnalytics queues a read model refresh for every event in the order
+func (s *OrderServiceImpl) refreshAnalytics(order *models.Order) {
+	if s.Analytics == nil {
+		return
+	}
+	eventIDs := make([]uuid.UUID, 0, len(order.Items))
+	for _, item := range order.Items {
+		eventIDs = append(eventIDs, item.EventID)
+	}
+	s.Analytics.RefreshEventFactsAsync(eventIDs...)
+}
+
+// trackFunnelStep records a checkout funnel step once per event in the order
+func (s *OrderServiceImpl) trackFunnelStep(order *models.Order, step models.TrackingEventType) {
+	if s.Tracking == nil {
+		return
+	}
+	seen := make(map[uuid.UUID]bool, len(order.Items))
+	for _, item := range order.Items {
+		if seen[item.EventID] {
+			continue
+		}
+		seen[item.EventID] = true
+
+		orderID := order.ID
+		s.Tracking.TrackAsync(models.TrackingEvent{
+			EventID: item.EventID,
+			Type:    step,
+			UserID:  order.UserID,
+			GuestID: order.GuestID.String,
+			OrderID: &orderID,
+		})
 	}
 }
 
@@ -0,0 +1,193 @@
+// backend/pkg/services/recommendation/recommendation_services.go
+
+package recommendation
+
+import (
+	"context"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	reporecommendation "github.com/eventify/backend/pkg/repository/recommendation"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// storedPerSubject is how many ranked events are kept per person
+	storedPerSubject = 50
+	// candidatePoolSize caps the upcoming events scored in one pass
+	candidatePoolSize = 1000
+	// activeWindow picks who the background job recomputes for
+	activeWindow = 90 * 24 * time.Hour
+	// maxSubjectsPerRun bounds a single recompute pass
+	maxSubjectsPerRun = 5000
+	// staleAfter drops lists for people who've gone quiet
+	staleAfter = 30 * 24 * time.Hour
+)
+
+type RecommendationService interface {
+	GetRecommendations(ctx context.Context, subject models.RecommendationSubject, limit int) (*models.RecommendationsResponse, error)
+	RecomputeAll(ctx context.Context)
+	StartRecommendationWorker(ctx context.Context, interval time.Duration)
+}
+
+type recommendationService struct {
+	repo reporecommendation.RecommendationRepository
+}
+
+func NewRecommendationService(repo reporecommendation.RecommendationRepository) RecommendationService {
+	return &recommendationService{repo: repo}
+}
+
+// GetRecommendations serves the stored list for the subject. People the
+// background job hasn't scored yet, or who have no likes or purchases, get
+// the shared popular list instead.
+func (s *recommendationService) GetRecommendations(ctx context.Context, subject models.RecommendationSubject, limit int) (*models.RecommendationsResponse, error) {
+	if !subject.IsZero() {
+		recs, computedAt, err := s.repo.GetRecommendations(ctx, subject.Key(), limit)
+		if err != nil {
+			return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load recommendations", err)
+		}
+		if len(recs) > 0 {
+			return &models.RecommendationsResponse{Recommendations: recs, Personalized: true, ComputedAt: computedAt}, nil
+		}
+	}
+
+	return s.popular(ctx, limit)
+}
+
+// popular serves the cold-start list, building it if the job hasn't yet
+func (s *recommendationService) popular(ctx context.Context, limit int) (*models.RecommendationsResponse, error) {
+	recs, computedAt, err := s.repo.GetRecommendations(ctx, models.PopularSubjectKey, limit)
+	if err != nil {
+		return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load recommendations", err)
+	}
+
+	if computedAt == nil {
+		candidates, err := s.repo.GetCandidateEvents(ctx, candidatePoolSize)
+		if err != nil {
+			return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load events", err)
+		}
+		if err := s.computePopular(ctx, candidates); err != nil {
+			return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to compute recommendations", err)
+		}
+		if recs, computedAt, err = s.repo.GetRecommendations(ctx, models.PopularSubjectKey, limit); err != nil {
+			return nil, utils.NewError(utils.ErrCategoryDatabase, "failed to load recommendations", err)
+		}
+	}
+
+	if recs == nil {
+		recs = []models.RecommendedEvent{}
+	}
+	return &models.RecommendationsResponse{Recommendations: recs, ComputedAt: computedAt}, nil
+}
+
+// computeForSubject scores candidates for one person and stores the result.
+// It reports false (and stores nothing) when they have no history yet.
+func (s *recommendationService) computeForSubject(
+	ctx context.Context,
+	subject models.RecommendationSubject,
+	candidates []models.EventFeatures,
+	pairs models.CoInteractions,
+) (bool, error) {
+	interactions, err := s.repo.GetInteractions(ctx, subject)
+	if err != nil {
+		return false, err
+	}
+	if len(interactions) == 0 {
+		return false, nil
+	}
+
+	seeds := make([]uuid.UUID, len(interactions))
+	for i, in := range interactions {
+		seeds[i] = in.EventID
+	}
+	scored := scoreCandidates(buildProfile(interactions), candidates, coCountsFor(pairs, seeds), storedPerSubject)
+	if err := s.repo.SaveRecommendations(ctx, subject.Key(), scored); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *recommendationService) computePopular(ctx context.Context, candidates []models.EventFeatures) error {
+	scored := scoreCandidates(buildProfile(nil), candidates, nil, storedPerSubject)
+	return s.repo.SaveRecommendations(ctx, models.PopularSubjectKey, scored)
+}
+
+// RecomputeAll refreshes the popular list and every recently active person
+func (s *recommendationService) RecomputeAll(ctx context.Context) {
+	start := time.Now()
+
+	candidates, err := s.repo.GetCandidateEvents(ctx, candidatePoolSize)
+	if err != nil {
+		log.Error().Err(err).Msg("Recommendations: failed to load candidate events")
+		return
+	}
+	if err := s.computePopular(ctx, candidates); err != nil {
+		log.Error().Err(err).Msg("Recommendations: failed to refresh popular list")
+	}
+
+	keys, err := s.repo.GetActiveSubjects(ctx, start.Add(-activeWindow), maxSubjectsPerRun)
+	if err != nil {
+		log.Error().Err(err).Msg("Recommendations: failed to load active subjects")
+		return
+	}
+
+	candidateIDs := make([]uuid.UUID, len(candidates))
+	for i, c := range candidates {
+		candidateIDs[i] = c.EventID
+	}
+	pairs, err := s.repo.GetCoInteractions(ctx, candidateIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("Recommendations: failed to load co-interactions")
+		return
+	}
+
+	computed, failed := 0, 0
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			return
+		}
+		subject, ok := models.ParseRecommendationSubject(key)
+		if !ok {
+			continue
+		}
+		if _, err := s.computeForSubject(ctx, subject, candidates, pairs); err != nil {
+			failed++
+			log.Warn().Err(err).Str("subject", key).Msg("Recommendations: failed to recompute")
+			continue
+		}
+		computed++
+	}
+
+	purged, err := s.repo.PurgeStaleRecommendations(ctx, start.Add(-staleAfter))
+	if err != nil {
+		log.Warn().Err(err).Msg("Recommendations: failed to purge stale lists")
+	}
+
+	log.Info().
+		Int("candidates", len(candidates)).
+		Int("computed", computed).
+		Int("failed", failed).
+		Int64("purged", purged).
+		Dur("took", time.Since(start)).
+		Msg("🎯 Recommendations recomputed")
+}
+
+// StartRecommendationWorker recomputes recommendations on an interval until ctx is cancelled
+func (s *recommendationService) StartRecommendationWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	s.RecomputeAll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RecomputeAll(ctx)
+		}
+	}
+}
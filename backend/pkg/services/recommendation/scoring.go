@@ -0,0 +1,183 @@
+// backend/pkg/services/recommendation/scoring.go
+
+package recommendation
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+// Signal weights; they sum to 1 so a perfect match on everything scores 1
+const (
+	weightCategory   = 0.30
+	weightTags       = 0.15
+	weightCity       = 0.15
+	weightSimilar    = 0.25
+	weightPopularity = 0.15
+)
+
+// minReasonContribution keeps weak signals out of the "why" list
+const minReasonContribution = 0.05
+
+// affinityProfile holds the subject's preferences, each normalised to 0..1
+// against their strongest preference of that kind
+type affinityProfile struct {
+	categories map[string]float64
+	tags       map[string]float64
+	cities     map[string]float64
+	seen       map[uuid.UUID]bool
+}
+
+func (p affinityProfile) isEmpty() bool {
+	return len(p.seen) == 0
+}
+
+func buildProfile(interactions []models.EventInteraction) affinityProfile {
+	p := affinityProfile{
+		categories: map[string]float64{},
+		tags:       map[string]float64{},
+		cities:     map[string]float64{},
+		seen:       map[uuid.UUID]bool{},
+	}
+	for _, in := range interactions {
+		p.seen[in.EventID] = true
+		if c := normalise(in.Category); c != "" {
+			p.categories[c] += in.Weight
+		}
+		if c := normalise(in.City); c != "" {
+			p.cities[c] += in.Weight
+		}
+		for _, tag := range in.Tags {
+			if t := normalise(tag); t != "" {
+				p.tags[t] += in.Weight
+			}
+		}
+	}
+	scaleToMax(p.categories)
+	scaleToMax(p.tags)
+	scaleToMax(p.cities)
+	return p
+}
+
+// scoreCandidates ranks candidates for the profile, skipping events the
+// subject already liked or bought. With an empty profile only popularity
+// counts, which gives the cold-start list.
+func scoreCandidates(profile affinityProfile, candidates []models.EventFeatures, coCounts map[uuid.UUID]int, limit int) []models.ScoredEvent {
+	maxPopularity, maxOverlap := 0.0, 0
+	for _, c := range candidates {
+		maxPopularity = math.Max(maxPopularity, c.Popularity)
+	}
+	for _, n := range coCounts {
+		maxOverlap = max(maxOverlap, n)
+	}
+
+	type contribution struct {
+		reason string
+		value  float64
+	}
+
+	scored := make([]models.ScoredEvent, 0, len(candidates))
+	for _, c := range candidates {
+		if profile.seen[c.EventID] {
+			continue
+		}
+
+		var parts []contribution
+		if profile.isEmpty() {
+			parts = append(parts, contribution{models.ReasonPopular, logScale(c.Popularity, maxPopularity)})
+		} else {
+			parts = append(parts,
+				contribution{models.ReasonCategory, weightCategory * profile.categories[normalise(c.Category)]},
+				contribution{models.ReasonTags, weightTags * tagAffinity(profile.tags, c.Tags)},
+				contribution{models.ReasonCity, weightCity * profile.cities[normalise(c.City)]},
+				contribution{models.ReasonSimilar, weightSimilar * ratio(coCounts[c.EventID], maxOverlap)},
+				contribution{models.ReasonPopular, weightPopularity * logScale(c.Popularity, maxPopularity)},
+			)
+		}
+
+		sort.SliceStable(parts, func(i, j int) bool { return parts[i].value > parts[j].value })
+		event := models.ScoredEvent{EventID: c.EventID, Reasons: []string{}}
+		for _, part := range parts {
+			event.Score += part.value
+			if part.value >= minReasonContribution {
+				event.Reasons = append(event.Reasons, part.reason)
+			}
+		}
+		if event.Score > 0 {
+			event.Score = math.Round(event.Score*10000) / 10000
+			scored = append(scored, event)
+		}
+	}
+
+	// Candidates arrive soonest-first, so a stable sort breaks ties by date
+	sort.SliceStable(scored, func(i, j int) bool { return scored[i].Score > scored[j].Score })
+	if len(scored) > limit {
+		scored = scored[:limit]
+	}
+	return scored
+}
+
+// coCountsFor adds up, per candidate, how many people shared each of the
+// subject's events with it. Someone who overlaps on several seeds counts once
+// per seed, which favours events close to more of the subject's history.
+func coCountsFor(pairs models.CoInteractions, seeds []uuid.UUID) map[uuid.UUID]int {
+	counts := make(map[uuid.UUID]int)
+	for _, seed := range seeds {
+		for eventID, n := range pairs[seed] {
+			counts[eventID] += n
+		}
+	}
+	return counts
+}
+
+// tagAffinity averages the subject's affinity over the event's tags, so one
+// strong tag isn't drowned out but many weak ones don't add up past 1
+func tagAffinity(profile map[string]float64, tags []string) float64 {
+	if len(tags) == 0 || len(profile) == 0 {
+		return 0
+	}
+	best, total := 0.0, 0.0
+	for _, tag := range tags {
+		v := profile[normalise(tag)]
+		best = math.Max(best, v)
+		total += v
+	}
+	return (best + total/float64(len(tags))) / 2
+}
+
+// logScale dampens popularity so one sold-out festival doesn't flatten
+// every other event to zero
+func logScale(v, maxV float64) float64 {
+	if maxV <= 0 || v <= 0 {
+		return 0
+	}
+	return math.Log1p(v) / math.Log1p(maxV)
+}
+
+func ratio(n, maxN int) float64 {
+	if maxN == 0 {
+		return 0
+	}
+	return float64(n) / float64(maxN)
+}
+
+func scaleToMax(m map[string]float64) {
+	maxV := 0.0
+	for _, v := range m {
+		maxV = math.Max(maxV, v)
+	}
+	if maxV == 0 {
+		return
+	}
+	for k, v := range m {
+		m[k] = v / maxV
+	}
+}
+
+func normalise(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
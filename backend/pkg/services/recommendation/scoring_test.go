@@ -0,0 +1,89 @@
+package recommendation
+
+import (
+	"testing"
+
+	"github.com/eventify/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func candidate(category, city string, popularity float64, tags ...string) models.EventFeatures {
+	return models.EventFeatures{
+		EventID:    uuid.New(),
+		Category:   category,
+		Tags:       tags,
+		City:       city,
+		Popularity: popularity,
+	}
+}
+
+func liked(f models.EventFeatures, weight float64) models.EventInteraction {
+	return models.EventInteraction{EventFeatures: f, Weight: weight}
+}
+
+func TestScoreCandidates(t *testing.T) {
+	t.Run("Category and city affinity outrank raw popularity", func(t *testing.T) {
+		history := candidate("Music", "Lagos", 0, "afrobeats")
+		profile := buildProfile([]models.EventInteraction{liked(history, 3)})
+
+		match := candidate("music", "lagos", 1, "Afrobeats")
+		popular := candidate("Tech", "Abuja", 500)
+
+		scored := scoreCandidates(profile, []models.EventFeatures{popular, match}, nil, 10)
+		require.Len(t, scored, 2)
+		assert.Equal(t, match.EventID, scored[0].EventID)
+		assert.Equal(t, []string{models.ReasonCategory, models.ReasonTags, models.ReasonCity}, scored[0].Reasons)
+		assert.Equal(t, []string{models.ReasonPopular}, scored[1].Reasons)
+	})
+
+	t.Run("Events already liked or bought are not recommended", func(t *testing.T) {
+		history := candidate("Music", "Lagos", 10)
+		profile := buildProfile([]models.EventInteraction{liked(history, 1)})
+
+		scored := scoreCandidates(profile, []models.EventFeatures{history}, nil, 10)
+		assert.Empty(t, scored)
+	})
+
+	t.Run("Similar attendees lift an otherwise unrelated event", func(t *testing.T) {
+		profile := buildProfile([]models.EventInteraction{liked(candidate("Music", "Lagos", 0), 1)})
+
+		plain := candidate("Art", "Abuja", 0)
+		shared := candidate("Art", "Abuja", 0)
+		coCounts := map[uuid.UUID]int{shared.EventID: 4}
+
+		scored := scoreCandidates(profile, []models.EventFeatures{plain, shared}, coCounts, 10)
+		require.Len(t, scored, 1, "events with no signal at all are dropped")
+		assert.Equal(t, shared.EventID, scored[0].EventID)
+		assert.Equal(t, []string{models.ReasonSimilar}, scored[0].Reasons)
+	})
+
+	t.Run("Cold start ranks by popularity and keeps date order on ties", func(t *testing.T) {
+		soon := candidate("Music", "Lagos", 5)
+		later := candidate("Tech", "Abuja", 5)
+		hit := candidate("Art", "Abuja", 80)
+		dead := candidate("Art", "Abuja", 0)
+
+		scored := scoreCandidates(buildProfile(nil), []models.EventFeatures{soon, later, hit, dead}, nil, 2)
+		require.Len(t, scored, 2)
+		assert.Equal(t, hit.EventID, scored[0].EventID)
+		assert.Equal(t, 1.0, scored[0].Score)
+		assert.Equal(t, soon.EventID, scored[1].EventID)
+	})
+}
+
+func TestCoCountsFor(t *testing.T) {
+	seedA, seedB, other := uuid.New(), uuid.New(), uuid.New()
+	concert, festival := uuid.New(), uuid.New()
+	pairs := models.CoInteractions{
+		seedA: {concert: 2, festival: 1},
+		seedB: {concert: 3},
+		other: {festival: 9},
+	}
+
+	counts := coCountsFor(pairs, []uuid.UUID{seedA, seedB})
+	assert.Equal(t, 5, counts[concert])
+	assert.Equal(t, 1, counts[festival], "events outside the subject's history don't contribute")
+	assert.Empty(t, coCountsFor(pairs, nil))
+}
@@ -4,16 +4,30 @@ package review
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/eventify/backend/pkg/models"
+	"github.com/eventify/backend/pkg/utils"
 	"github.com/google/uuid"
 )
 
-func (s *reviewServiceImpl) GetReviewsByVendor(ctx context.Context, vendorID string) ([]models.Review, error) {
+func (s *reviewServiceImpl) GetReviewsByVendor(ctx context.Context, vendorID, cursor string, limit int) ([]models.Review, *models.PageInfo, error) {
 	parsedID, err := uuid.Parse(vendorID)
-	if err != nil { return nil, err }
-	return s.reviewRepo.GetByVendorID(ctx, parsedID)
+	if err != nil { return nil, nil, err }
+
+	reviews, nextCursor, err := s.reviewRepo.GetPageByVendorID(ctx, parsedID, cursor, limit)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidCursor) {
+			return nil, nil, utils.NewError(utils.ErrCategoryValidation, "invalid or expired cursor", err)
+		}
+		return nil, nil, err
+	}
+
+	total, err := s.reviewRepo.CountByVendorID(ctx, parsedID)
+	if err != nil { return nil, nil, err }
+
+	return reviews, &models.PageInfo{NextCursor: nextCursor, Total: total, Limit: limit}, nil
 }
 
 func (s *reviewServiceImpl) CalculateAndUpdateVendorRating(ctx context.Context, vendorID string) error {
@@ -13,7 +13,7 @@ import (
 
 type ReviewService interface {
 	CreateReview(ctx context.Context, review *models.Review) error
-	GetReviewsByVendor(ctx context.Context, vendorID string) ([]models.Review, error)
+	GetReviewsByVendor(ctx context.Context, vendorID, cursor string, limit int) ([]models.Review, *models.PageInfo, error)
 	CalculateAndUpdateVendorRating(ctx context.Context, vendorID string) error
 }
 
@@ -0,0 +1,62 @@
+// backend/pkg/services/storage/blobstore.go
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"strings"
+)
+
+// ErrInvalidKey is returned for keys that are empty or try to escape the store
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// BlobStore keeps uploaded files and knows the public URL they're served from
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+// NewBlobStoreFromEnv picks the store from BLOB_STORE ("filesystem" or "s3").
+// Files land on local disk by default so development needs no credentials.
+func NewBlobStoreFromEnv() BlobStore {
+	switch strings.ToLower(os.Getenv("BLOB_STORE")) {
+	case "s3":
+		return &S3Store{
+			Endpoint:        strings.TrimRight(os.Getenv("S3_ENDPOINT"), "/"),
+			Region:          envOr("S3_REGION", "us-east-1"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			PublicURL:       strings.TrimRight(os.Getenv("S3_PUBLIC_URL"), "/"),
+		}
+	default:
+		return NewFilesystemStore(
+			envOr("MEDIA_ROOT", "./uploads"),
+			os.Getenv("MEDIA_BASE_URL"),
+			envOr("MEDIA_URL_PREFIX", "/media"),
+		)
+	}
+}
+
+// cleanKey normalises a key to a relative slash path and rejects traversal
+func cleanKey(key string) (string, error) {
+	if key == "" || strings.Contains(key, "\\") {
+		return "", ErrInvalidKey
+	}
+	cleaned := path.Clean("/" + key)[1:]
+	if cleaned == "" || cleaned != strings.TrimPrefix(key, "/") {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
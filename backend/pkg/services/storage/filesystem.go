@@ -0,0 +1,76 @@
+// backend/pkg/services/storage/filesystem.go
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FilesystemStore writes blobs under Root. The router serves Root at
+// ServePath, so URLs point straight back at this API (BaseURL is only
+// needed when the frontend is on another origin).
+type FilesystemStore struct {
+	Root      string
+	BaseURL   string
+	ServePath string
+}
+
+func NewFilesystemStore(root, baseURL, servePath string) *FilesystemStore {
+	return &FilesystemStore{
+		Root:      root,
+		BaseURL:   strings.TrimRight(baseURL, "/"),
+		ServePath: "/" + strings.Trim(servePath, "/"),
+	}
+}
+
+// Put writes to a temp file first so readers never see a half-written image
+func (s *FilesystemStore) Put(_ context.Context, key string, data []byte, _ string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+
+	dest := filepath.Join(s.Root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dest), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("failed to set blob permissions: %w", err)
+	}
+	return os.Rename(tmp.Name(), dest)
+}
+
+func (s *FilesystemStore) Delete(_ context.Context, key string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(filepath.Join(s.Root, filepath.FromSlash(key)))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+func (s *FilesystemStore) URL(key string) string {
+	return s.BaseURL + s.ServePath + "/" + strings.TrimPrefix(key, "/")
+}
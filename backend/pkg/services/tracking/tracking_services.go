@@ -0,0 +1,112 @@
+// backend/pkg/services/tracking/tracking_services.go
+
+package tracking
+
+import (
+	"context"
+	"time"
+
+	"github.com/eventify/backend/pkg/models"
+	repotracking "github.com/eventify/backend/pkg/repository/tracking"
+	"github.com/eventify/backend/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// trackTimeout bounds the fire-and-forget insert
+	trackTimeout = 2 * time.Second
+	// rawRetention keeps raw rows long enough to re-roll recent days
+	rawRetention = 90 * 24 * time.Hour
+)
+
+type TrackingService interface {
+	// Track records a step synchronously; TrackAsync doesn't hold up the caller
+	Track(ctx context.Context, ev models.TrackingEvent) error
+	TrackAsync(ev models.TrackingEvent)
+	RollupRecent(ctx context.Context)
+	StartRollupWorker(ctx context.Context, interval time.Duration)
+}
+
+type trackingService struct {
+	repo repotracking.TrackingRepository
+}
+
+func NewTrackingService(repo repotracking.TrackingRepository) TrackingService {
+	return &trackingService{repo: repo}
+}
+
+func (s *trackingService) Track(ctx context.Context, ev models.TrackingEvent) error {
+	if !ev.Type.IsValid() {
+		return utils.NewError(utils.ErrCategoryValidation, "unknown tracking event type", nil)
+	}
+	if ev.EventID == uuid.Nil {
+		return utils.NewError(utils.ErrCategoryValidation, "event ID is required", nil)
+	}
+	if ev.ID == uuid.Nil {
+		ev.ID = uuid.New()
+	}
+	if ev.OccurredAt.IsZero() {
+		ev.OccurredAt = time.Now().UTC()
+	}
+
+	if err := s.repo.InsertEvent(ctx, &ev); err != nil {
+		return utils.NewError(utils.ErrCategoryDatabase, "failed to record tracking event", err)
+	}
+	return nil
+}
+
+func (s *trackingService) TrackAsync(ev models.TrackingEvent) {
+	if ev.OccurredAt.IsZero() {
+		ev.OccurredAt = time.Now().UTC()
+	}
+	go func() {
+		// Use a background context as the request context might expire
+		ctx, cancel := context.WithTimeout(context.Background(), trackTimeout)
+		defer cancel()
+		if err := s.Track(ctx, ev); err != nil {
+			log.Warn().Err(err).
+				Str("event_id", ev.EventID.String()).
+				Str("type", string(ev.Type)).
+				Msg("Failed to record tracking event")
+		}
+	}()
+}
+
+// RollupRecent recounts yesterday and today, so late rows from just before
+// midnight still land in the right day
+func (s *trackingService) RollupRecent(ctx context.Context) {
+	now := time.Now().UTC()
+
+	rows, err := s.repo.RollupSince(ctx, now.Add(-24*time.Hour))
+	if err != nil {
+		log.Error().Err(err).Msg("Tracking: rollup failed")
+		return
+	}
+
+	purged, err := s.repo.PurgeBefore(ctx, now.Add(-rawRetention))
+	if err != nil {
+		log.Warn().Err(err).Msg("Tracking: purge failed")
+	}
+
+	log.Debug().Int64("rows", rows).Int64("purged", purged).Msg("Tracking rollup complete")
+}
+
+// StartRollupWorker keeps the daily funnel counts current until ctx is cancelled
+func (s *trackingService) StartRollupWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Tracking Rollup Worker started (Interval: %v)", interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Tracking Rollup Worker shutting down...")
+			return
+		case <-ticker.C:
+			s.RollupRecent(ctx)
+		}
+	}
+}
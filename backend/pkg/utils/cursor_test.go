@@ -0,0 +1,78 @@
+// backend/pkg/utils/cursor_test.go
+
+package utils
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	start := time.Date(2026, 3, 14, 18, 30, 0, 123456000, time.UTC)
+	token := EncodeCursor("start_date", start, "0b8e2f2c-6f6b-4a53-9a55-2b0e6f0f2a11")
+
+	values, err := DecodeCursor(token, "start_date", CursorTime, CursorUUID)
+	require.NoError(t, err)
+
+	assert.True(t, values[0].(time.Time).Equal(start))
+	assert.Equal(t, uuid.MustParse("0b8e2f2c-6f6b-4a53-9a55-2b0e6f0f2a11"), values[1])
+}
+
+func TestCursorFloatsSurviveExactly(t *testing.T) {
+	token := EncodeCursor("distance", 12.345678901234567, uuid.Nil)
+
+	values, err := DecodeCursor(token, "distance", CursorNumber, CursorUUID)
+	require.NoError(t, err)
+	assert.Equal(t, 12.345678901234567, values[0])
+}
+
+func TestDecodeCursorRejectsMismatches(t *testing.T) {
+	token := EncodeCursor("relevance", 0.5, uuid.Nil)
+
+	_, err := DecodeCursor(token, "start_date", CursorNumber, CursorUUID)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = DecodeCursor(token, "relevance", CursorNumber, CursorUUID, CursorUUID)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = DecodeCursor("not-base64!!", "relevance", CursorNumber, CursorUUID)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = DecodeCursor(EncodeCursor("relevance", nil, uuid.Nil), "relevance", CursorNumber, CursorUUID)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestDecodeCursorRejectsWrongTypes(t *testing.T) {
+	nested := base64.RawURLEncoding.EncodeToString(
+		[]byte(`{"s":"start_date","v":[{"$gt":"2026-01-01"},"0b8e2f2c-6f6b-4a53-9a55-2b0e6f0f2a11"]}`))
+	_, err := DecodeCursor(nested, "start_date", CursorTime, CursorUUID)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	for name, tc := range map[string]struct {
+		token string
+		first CursorKey
+	}{
+		"array for a number": {EncodeCursor("start_date", []int{1}, uuid.Nil), CursorNumber},
+		"bad timestamp":      {EncodeCursor("start_date", "yesterday", uuid.Nil), CursorTime},
+		"bad uuid":           {EncodeCursor("start_date", time.Now(), "1; DROP TABLE events"), CursorTime},
+		"number for a time":  {EncodeCursor("start_date", 1700000000, uuid.Nil), CursorTime},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeCursor(tc.token, "start_date", tc.first, CursorUUID)
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
+
+func TestParsePageLimit(t *testing.T) {
+	assert.Equal(t, 20, ParsePageLimit("", 20, 100))
+	assert.Equal(t, 20, ParsePageLimit("abc", 20, 100))
+	assert.Equal(t, 20, ParsePageLimit("-5", 20, 100))
+	assert.Equal(t, 35, ParsePageLimit("35", 20, 100))
+	assert.Equal(t, 100, ParsePageLimit("500", 20, 100))
+}
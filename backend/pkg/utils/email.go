@@ -58,16 +58,32 @@ func SendPasswordResetEmail(to, name, resetLink string) error {
 	return err
 }
 
+// EmailAttachment is a file sent alongside an email body
+type EmailAttachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
 func MockSendEmail(to string, subject string, body string) error {
+	return MockSendEmailWithAttachments(to, subject, body, nil)
+}
+
+func MockSendEmailWithAttachments(to string, subject string, body string, attachments []EmailAttachment) error {
 	// 1. Log to console for immediate visibility
 	log.Info().
 		Str("to", to).
 		Str("subject", subject).
+		Int("attachments", len(attachments)).
 		Msg("📧 [MOCK EMAIL SENT]")
 
 	// 2. Write to a local file (email_debug.log) so you can review the content
-	content := fmt.Sprintf("\n--- %s ---\nTo: %s\nSubject: %s\nBody: %s\n-------------------\n", 
+	content := fmt.Sprintf("\n--- %s ---\nTo: %s\nSubject: %s\nBody: %s\n", 
 		time.Now().Format(time.RFC822), to, subject, body)
+	for _, a := range attachments {
+		content += fmt.Sprintf("Attachment: %s (%s)\n%s\n", a.Filename, a.ContentType, a.Content)
+	}
+	content += "-------------------\n"
 
 	f, err := os.OpenFile("email_debug.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
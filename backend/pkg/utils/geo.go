@@ -0,0 +1,70 @@
+// backend/pkg/utils/geo.go
+
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// GEO HELPERS
+// ============================================================================
+// Distances are great-circle (haversine) on a spherical Earth, which is well
+// within a few metres of accuracy for "events near me" radii.
+
+const earthRadiusKm = 6371.0
+
+// HaversineKm returns the great-circle distance between two points in kilometres
+func HaversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return earthRadiusKm * 2 * math.Asin(math.Sqrt(math.Min(1, a)))
+}
+
+// BoundingBox returns the lat/lng box that contains every point within radiusKm.
+// It is used as a cheap, index-friendly prefilter before the exact distance check.
+func BoundingBox(lat, lng, radiusKm float64) (minLat, maxLat, minLng, maxLng float64) {
+	dLat := radiusKm / earthRadiusKm * 180 / math.Pi
+	minLat, maxLat = math.Max(lat-dLat, -90), math.Min(lat+dLat, 90)
+
+	// Near the poles every longitude is in range
+	if minLat <= -90 || maxLat >= 90 {
+		return minLat, maxLat, -180, 180
+	}
+
+	dLng := dLat / math.Cos(toRadians(lat))
+	minLng, maxLng = lng-dLng, lng+dLng
+	if minLng < -180 || maxLng > 180 {
+		// The box crosses the antimeridian; fall back to all longitudes
+		return minLat, maxLat, -180, 180
+	}
+	return minLat, maxLat, minLng, maxLng
+}
+
+// ParseLatLng parses a "lat,lng" pair such as "6.5244,3.3792"
+func ParseLatLng(value string) (float64, float64, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected \"lat,lng\", got %q", value)
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return 0, 0, fmt.Errorf("invalid latitude %q", parts[0])
+	}
+	lng, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil || lng < -180 || lng > 180 {
+		return 0, 0, fmt.Errorf("invalid longitude %q", parts[1])
+	}
+	return lat, lng, nil
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
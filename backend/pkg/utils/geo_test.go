@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoHelpers(t *testing.T) {
+	t.Run("Haversine between Lagos and Abuja", func(t *testing.T) {
+		km := HaversineKm(6.5244, 3.3792, 9.0765, 7.3986)
+		assert.InDelta(t, 524, km, 5)
+	})
+
+	t.Run("Bounding box contains the radius", func(t *testing.T) {
+		minLat, maxLat, minLng, maxLng := BoundingBox(6.5244, 3.3792, 10)
+		assert.InDelta(t, 10, HaversineKm(6.5244, 3.3792, maxLat, 3.3792), 0.01)
+		assert.InDelta(t, 10, HaversineKm(6.5244, 3.3792, minLat, 3.3792), 0.01)
+		assert.GreaterOrEqual(t, HaversineKm(6.5244, 3.3792, 6.5244, maxLng), 9.99)
+		assert.Less(t, minLng, 3.3792)
+	})
+
+	t.Run("Parse lat,lng", func(t *testing.T) {
+		lat, lng, err := ParseLatLng(" 6.5244, 3.3792 ")
+		require.NoError(t, err)
+		assert.Equal(t, 6.5244, lat)
+		assert.Equal(t, 3.3792, lng)
+
+		_, _, err = ParseLatLng("95,3")
+		assert.Error(t, err)
+		_, _, err = ParseLatLng("6.5")
+		assert.Error(t, err)
+	})
+}
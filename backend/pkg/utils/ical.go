@@ -0,0 +1,166 @@
+// backend/pkg/utils/ical.go
+
+package utils
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// iCALENDAR (RFC 5545)
+// ============================================================================
+
+const (
+	icsTimeLayout   = "20060102T150405Z"
+	icsMaxLineBytes = 75
+	calendarProdID  = "-//Eventify//Events//EN"
+)
+
+// CalendarEvent is the calendar-facing view of an event
+type CalendarEvent struct {
+	UID          string
+	Title        string
+	Description  string
+	Location     string
+	URL          string
+	Start        time.Time
+	End          time.Time
+	LastModified time.Time
+	Cancelled    bool
+}
+
+// BuildICalendar renders one VCALENDAR containing every event.
+// Times are written in UTC so no VTIMEZONE block is needed.
+func BuildICalendar(name string, events []CalendarEvent) []byte {
+	var buf bytes.Buffer
+	now := time.Now().UTC().Format(icsTimeLayout)
+
+	writeICSLine(&buf, "BEGIN:VCALENDAR")
+	writeICSLine(&buf, "VERSION:2.0")
+	writeICSLine(&buf, "PRODID:"+calendarProdID)
+	writeICSLine(&buf, "CALSCALE:GREGORIAN")
+	writeICSLine(&buf, "METHOD:PUBLISH")
+	if name != "" {
+		writeICSLine(&buf, "X-WR-CALNAME:"+escapeICSText(name))
+	}
+
+	for _, ev := range events {
+		writeICSLine(&buf, "BEGIN:VEVENT")
+		writeICSLine(&buf, "UID:"+ev.UID)
+		writeICSLine(&buf, "DTSTAMP:"+now)
+		writeICSLine(&buf, "DTSTART:"+ev.Start.UTC().Format(icsTimeLayout))
+		writeICSLine(&buf, "DTEND:"+ev.End.UTC().Format(icsTimeLayout))
+		if !ev.LastModified.IsZero() {
+			writeICSLine(&buf, "LAST-MODIFIED:"+ev.LastModified.UTC().Format(icsTimeLayout))
+		}
+		writeICSLine(&buf, "SUMMARY:"+escapeICSText(ev.Title))
+		if ev.Description != "" {
+			writeICSLine(&buf, "DESCRIPTION:"+escapeICSText(ev.Description))
+		}
+		if ev.Location != "" {
+			writeICSLine(&buf, "LOCATION:"+escapeICSText(ev.Location))
+		}
+		if ev.URL != "" {
+			writeICSLine(&buf, "URL:"+ev.URL)
+		}
+		if ev.Cancelled {
+			writeICSLine(&buf, "STATUS:CANCELLED")
+		} else {
+			writeICSLine(&buf, "STATUS:CONFIRMED")
+		}
+		writeICSLine(&buf, "END:VEVENT")
+	}
+
+	writeICSLine(&buf, "END:VCALENDAR")
+	return buf.Bytes()
+}
+
+// GoogleCalendarURL builds an "Add to Google Calendar" link
+func GoogleCalendarURL(ev CalendarEvent) string {
+	q := url.Values{}
+	q.Set("action", "TEMPLATE")
+	q.Set("text", ev.Title)
+	q.Set("dates", ev.Start.UTC().Format(icsTimeLayout)+"/"+ev.End.UTC().Format(icsTimeLayout))
+	q.Set("details", ev.Description)
+	q.Set("location", ev.Location)
+	return "https://calendar.google.com/calendar/render?" + q.Encode()
+}
+
+// OutlookCalendarURL builds an "Add to Outlook" link
+func OutlookCalendarURL(ev CalendarEvent) string {
+	q := url.Values{}
+	q.Set("path", "/calendar/action/compose")
+	q.Set("rru", "addevent")
+	q.Set("subject", ev.Title)
+	q.Set("startdt", ev.Start.UTC().Format(time.RFC3339))
+	q.Set("enddt", ev.End.UTC().Format(time.RFC3339))
+	q.Set("body", ev.Description)
+	q.Set("location", ev.Location)
+	return "https://outlook.live.com/calendar/0/deeplink/compose?" + q.Encode()
+}
+
+// SignCalendarFeedToken produces the secret token for a user's subscription URL.
+// Calendar apps can't send auth headers, so the token itself is the credential.
+func SignCalendarFeedToken(userID string) string {
+	return userID + "." + calendarFeedSignature(userID)
+}
+
+// VerifyCalendarFeedToken returns the user ID carried by a valid feed token
+func VerifyCalendarFeedToken(token string) (string, bool) {
+	userID, signature, ok := strings.Cut(token, ".")
+	if !ok || userID == "" {
+		return "", false
+	}
+	expected := calendarFeedSignature(userID)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return "", false
+	}
+	return userID, true
+}
+
+func calendarFeedSignature(userID string) string {
+	h := hmac.New(sha256.New, []byte(ticketSigningSecret()))
+	h.Write([]byte("calendar-feed:" + userID))
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+// escapeICSText escapes TEXT values per RFC 5545 section 3.3.11
+func escapeICSText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(s)
+}
+
+// writeICSLine folds content lines at 75 octets without splitting UTF-8 characters.
+// Continuation lines start with a space, which counts towards their 75.
+func writeICSLine(buf *bytes.Buffer, line string) {
+	limit := icsMaxLineBytes
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isUTF8Start(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icsMaxLineBytes - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+func isUTF8Start(b byte) bool {
+	return b&0xC0 != 0x80
+}
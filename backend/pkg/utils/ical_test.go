@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildICalendar(t *testing.T) {
+	start := time.Date(2026, 3, 7, 19, 0, 0, 0, time.FixedZone("WAT", 60*60))
+	ics := string(BuildICalendar("", []CalendarEvent{{
+		UID:         "abc@eventify",
+		Title:       "Jazz, Wine; Friends",
+		Description: strings.Repeat("Long description line. ", 10) + "\nSecond line",
+		Location:    "Muri Okunola Park, Lagos",
+		Start:       start,
+		End:         start.Add(3 * time.Hour),
+	}}))
+
+	t.Run("Uses CRLF and UTC times", func(t *testing.T) {
+		assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+		assert.Contains(t, ics, "DTSTART:20260307T180000Z\r\n")
+		assert.Contains(t, ics, "DTEND:20260307T210000Z\r\n")
+	})
+
+	t.Run("Escapes text values", func(t *testing.T) {
+		assert.Contains(t, ics, `SUMMARY:Jazz\, Wine\; Friends`)
+		assert.Contains(t, ics, `LOCATION:Muri Okunola Park\, Lagos`)
+	})
+
+	t.Run("Folds long lines", func(t *testing.T) {
+		for _, line := range strings.Split(ics, "\r\n") {
+			assert.LessOrEqual(t, len(line), 75)
+		}
+		unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+		assert.Contains(t, unfolded, `Long description line. \nSecond line`)
+	})
+}
+
+func TestCalendarFeedToken(t *testing.T) {
+	token := SignCalendarFeedToken("8379eaa2-0f99-4eda-a6f3-d783db819c6c")
+
+	userID, ok := VerifyCalendarFeedToken(token)
+	assert.True(t, ok)
+	assert.Equal(t, "8379eaa2-0f99-4eda-a6f3-d783db819c6c", userID)
+
+	_, ok = VerifyCalendarFeedToken("8379eaa2-0f99-4eda-a6f3-d783db819c6d" + token[36:])
+	assert.False(t, ok)
+}
@@ -0,0 +1,339 @@
+// backend/pkg/utils/rrule.go
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// RFC 5545 RECURRENCE RULES (subset)
+// ============================================================================
+// Supported parts: FREQ (DAILY, WEEKLY, MONTHLY), INTERVAL, COUNT, UNTIL,
+// BYDAY (with ordinals for MONTHLY, e.g. 1FR or -1SA) and BYMONTHDAY.
+// Anything else is rejected so organizers never get a silently different schedule.
+
+// maxRRuleIterations guards against rules that can never produce an occurrence
+const maxRRuleIterations = 10000
+
+// RRuleWeekday is a BYDAY entry. N is the ordinal within the month (0 = every).
+type RRuleWeekday struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// RRule is a parsed recurrence rule
+type RRule struct {
+	Freq       string
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []RRuleWeekday
+	ByMonthDay []int
+}
+
+var rruleDayCodes = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// ParseRRule parses an RRULE value, with or without the "RRULE:" prefix
+func ParseRRule(value string) (*RRule, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(strings.ToUpper(value), "RRULE:")
+	if value == "" {
+		return nil, errors.New("rrule is empty")
+	}
+
+	rule := &RRule{Interval: 1}
+
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid rrule part %q", part)
+		}
+		key, val := kv[0], kv[1]
+
+		switch key {
+		case "FREQ":
+			switch val {
+			case "DAILY", "WEEKLY", "MONTHLY":
+				rule.Freq = val
+			default:
+				return nil, fmt.Errorf("unsupported FREQ %q", val)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q", val)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid COUNT %q", val)
+			}
+			rule.Count = n
+		case "UNTIL":
+			t, err := parseRRuleTime(val)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &t
+		case "BYDAY":
+			for _, code := range strings.Split(val, ",") {
+				day, err := parseRRuleWeekday(code)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, day)
+			}
+		case "BYMONTHDAY":
+			for _, raw := range strings.Split(val, ",") {
+				n, err := strconv.Atoi(raw)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid BYMONTHDAY %q", raw)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		case "WKST":
+			if val != "MO" {
+				return nil, errors.New("only WKST=MO is supported")
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rrule part %q", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, errors.New("rrule must include FREQ")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, errors.New("rrule cannot have both COUNT and UNTIL")
+	}
+	if rule.Freq != "MONTHLY" {
+		if len(rule.ByMonthDay) > 0 {
+			return nil, errors.New("BYMONTHDAY is only supported with FREQ=MONTHLY")
+		}
+		for _, d := range rule.ByDay {
+			if d.N != 0 {
+				return nil, errors.New("ordinal BYDAY is only supported with FREQ=MONTHLY")
+			}
+		}
+	}
+
+	return rule, nil
+}
+
+// IsOpenEnded reports whether the rule repeats forever
+func (r *RRule) IsOpenEnded() bool {
+	return r.Count == 0 && r.Until == nil
+}
+
+// String renders the rule back into canonical RRULE form
+func (r *RRule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			code := ""
+			for k, v := range rruleDayCodes {
+				if v == d.Weekday {
+					code = k
+				}
+			}
+			if d.N != 0 {
+				code = strconv.Itoa(d.N) + code
+			}
+			codes[i] = code
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, d := range r.ByMonthDay {
+			days[i] = strconv.Itoa(d)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences expands the rule starting at dtstart and returns every occurrence
+// that is strictly after `after` and not later than `before`, up to limit results.
+// dtstart is always the first occurrence, as RFC 5545 requires.
+// Wall-clock times are kept in dtstart's location.
+func (r *RRule) Occurrences(dtstart, after, before time.Time, limit int) []time.Time {
+	var out []time.Time
+	emitted := 0
+
+	emit := func(t time.Time) bool {
+		if r.Until != nil && t.After(*r.Until) {
+			return false
+		}
+		if r.Count > 0 && emitted >= r.Count {
+			return false
+		}
+		if t.After(before) {
+			return false
+		}
+		emitted++
+		if t.After(after) {
+			out = append(out, t)
+		}
+		return limit <= 0 || len(out) < limit
+	}
+
+	if !emit(dtstart) {
+		return out
+	}
+
+	for period := 0; period < maxRRuleIterations; period++ {
+		for _, candidate := range r.candidates(dtstart, period) {
+			if !candidate.After(dtstart) {
+				continue
+			}
+			if !emit(candidate) {
+				return out
+			}
+		}
+	}
+	return out
+}
+
+// candidates returns the sorted instances for the n-th period of the rule
+func (r *RRule) candidates(dtstart time.Time, period int) []time.Time {
+	loc := dtstart.Location()
+	h, m, s := dtstart.Clock()
+	at := func(y int, mo time.Month, d int) time.Time {
+		return time.Date(y, mo, d, h, m, s, 0, loc)
+	}
+
+	var result []time.Time
+
+	switch r.Freq {
+	case "DAILY":
+		day := dtstart.AddDate(0, 0, period*r.Interval)
+		if len(r.ByDay) == 0 || r.matchesWeekday(day.Weekday()) {
+			result = append(result, day)
+		}
+
+	case "WEEKLY":
+		// Weeks start on Monday (WKST=MO)
+		offset := (int(dtstart.Weekday()) + 6) % 7
+		weekStart := dtstart.AddDate(0, 0, -offset+period*7*r.Interval)
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []RRuleWeekday{{Weekday: dtstart.Weekday()}}
+		}
+		for _, d := range days {
+			shift := (int(d.Weekday) + 6) % 7
+			day := weekStart.AddDate(0, 0, shift)
+			result = append(result, at(day.Year(), day.Month(), day.Day()))
+		}
+
+	case "MONTHLY":
+		first := time.Date(dtstart.Year(), dtstart.Month(), 1, 0, 0, 0, 0, loc).AddDate(0, period*r.Interval, 0)
+		year, month := first.Year(), first.Month()
+		daysIn := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+
+		switch {
+		case len(r.ByMonthDay) > 0:
+			for _, md := range r.ByMonthDay {
+				d := md
+				if md < 0 {
+					d = daysIn + md + 1
+				}
+				if d >= 1 && d <= daysIn {
+					result = append(result, at(year, month, d))
+				}
+			}
+		case len(r.ByDay) > 0:
+			for _, bd := range r.ByDay {
+				var matches []int
+				for d := 1; d <= daysIn; d++ {
+					if time.Date(year, month, d, 0, 0, 0, 0, loc).Weekday() == bd.Weekday {
+						matches = append(matches, d)
+					}
+				}
+				switch {
+				case bd.N == 0:
+					for _, d := range matches {
+						result = append(result, at(year, month, d))
+					}
+				case bd.N > 0 && bd.N <= len(matches):
+					result = append(result, at(year, month, matches[bd.N-1]))
+				case bd.N < 0 && -bd.N <= len(matches):
+					result = append(result, at(year, month, matches[len(matches)+bd.N]))
+				}
+			}
+		default:
+			// Months without the start day are skipped, per RFC 5545
+			if dtstart.Day() <= daysIn {
+				result = append(result, at(year, month, dtstart.Day()))
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+	return result
+}
+
+func (r *RRule) matchesWeekday(w time.Weekday) bool {
+	for _, d := range r.ByDay {
+		if d.Weekday == w {
+			return true
+		}
+	}
+	return false
+}
+
+func parseRRuleWeekday(code string) (RRuleWeekday, error) {
+	code = strings.TrimSpace(code)
+	if len(code) < 2 {
+		return RRuleWeekday{}, fmt.Errorf("invalid BYDAY %q", code)
+	}
+	day, ok := rruleDayCodes[code[len(code)-2:]]
+	if !ok {
+		return RRuleWeekday{}, fmt.Errorf("invalid BYDAY %q", code)
+	}
+	n := 0
+	if prefix := code[:len(code)-2]; prefix != "" {
+		v, err := strconv.Atoi(prefix)
+		if err != nil || v == 0 || v < -5 || v > 5 {
+			return RRuleWeekday{}, fmt.Errorf("invalid BYDAY %q", code)
+		}
+		n = v
+	}
+	return RRuleWeekday{Weekday: day, N: n}, nil
+}
+
+func parseRRuleTime(val string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, val); err == nil {
+			if layout == "20060102" {
+				// Date-only UNTIL includes the whole day
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", val)
+}
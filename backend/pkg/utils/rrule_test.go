@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRRuleOccurrences(t *testing.T) {
+	lagos := time.FixedZone("WAT", 60*60)
+	start := time.Date(2026, 1, 2, 20, 0, 0, 0, lagos) // Friday 8pm
+	far := start.AddDate(2, 0, 0)
+
+	t.Run("Weekly with count", func(t *testing.T) {
+		rule, err := ParseRRule("RRULE:FREQ=WEEKLY;COUNT=3")
+		require.NoError(t, err)
+
+		got := rule.Occurrences(start, start.Add(-time.Second), far, 0)
+		require.Len(t, got, 3)
+		assert.Equal(t, start, got[0])
+		assert.Equal(t, start.AddDate(0, 0, 14), got[2])
+	})
+
+	t.Run("Weekly on several days keeps wall-clock time", func(t *testing.T) {
+		rule, err := ParseRRule("FREQ=WEEKLY;BYDAY=FR,SA;COUNT=4")
+		require.NoError(t, err)
+
+		got := rule.Occurrences(start, start.Add(-time.Second), far, 0)
+		require.Len(t, got, 4)
+		assert.Equal(t, time.Saturday, got[1].Weekday())
+		assert.Equal(t, 20, got[3].Hour())
+	})
+
+	t.Run("Last Saturday of the month until a date", func(t *testing.T) {
+		rule, err := ParseRRule("FREQ=MONTHLY;BYDAY=-1SA;UNTIL=20260331")
+		require.NoError(t, err)
+
+		got := rule.Occurrences(start, start, far, 0)
+		require.Len(t, got, 3)
+		assert.Equal(t, 31, got[0].Day())
+		assert.Equal(t, 28, got[1].Day())
+		assert.Equal(t, 28, got[2].Day())
+	})
+
+	t.Run("After bound skips already materialized occurrences", func(t *testing.T) {
+		rule, err := ParseRRule("FREQ=DAILY;INTERVAL=2;COUNT=5")
+		require.NoError(t, err)
+
+		got := rule.Occurrences(start, start.AddDate(0, 0, 3), far, 0)
+		assert.Len(t, got, 3)
+	})
+
+	t.Run("Unsupported parts are rejected", func(t *testing.T) {
+		_, err := ParseRRule("FREQ=YEARLY")
+		assert.Error(t, err)
+		_, err = ParseRRule("FREQ=WEEKLY;BYSETPOS=1")
+		assert.Error(t, err)
+		_, err = ParseRRule("FREQ=WEEKLY;COUNT=2;UNTIL=20260101")
+		assert.Error(t, err)
+	})
+}
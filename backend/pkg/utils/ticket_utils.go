@@ -28,13 +28,7 @@ func GenerateUniqueTicketCode(baseRef string, index int) string {
 	payload := fmt.Sprintf("%s-%s", refSuffix, paddedIndex)
 
 	// 3. Sign the payload using a secret key from environment variables
-	// Use a fallback for local dev, but require it in production
-	secret := os.Getenv("TICKET_SIGNING_SECRET")
-	if secret == "" {
-		secret = "local-dev-secret-key-12345"
-	}
-
-	h := hmac.New(sha256.New, []byte(secret))
+	h := hmac.New(sha256.New, []byte(ticketSigningSecret()))
 	h.Write([]byte(payload))
 	
 	// Use only the first 8 characters of the signature to keep the ticket short
@@ -54,13 +48,8 @@ func VerifyTicketOffline(code string) bool {
 
 	payload := fmt.Sprintf("%s-%s", parts[0], parts[1])
 
-	secret := os.Getenv("TICKET_SIGNING_SECRET")
-	if secret == "" {
-		secret = "local-dev-secret-key-12345"
-	}
-
 	// Re-calculate the HMAC for the extracted payload
-	h := hmac.New(sha256.New, []byte(secret))
+	h := hmac.New(sha256.New, []byte(ticketSigningSecret()))
 	h.Write([]byte(payload))
 	expectedSignature := hex.EncodeToString(h.Sum(nil))[:8]
 
@@ -68,6 +57,24 @@ func VerifyTicketOffline(code string) bool {
 	return hmac.Equal([]byte(parts[2]), []byte(expectedSignature))
 }
 
+// SignGateManifest returns the full hex HMAC of a canonical manifest payload.
+// Scanner devices hold the same secret they use for VerifyTicketOffline.
+func SignGateManifest(payload []byte) string {
+	h := hmac.New(sha256.New, []byte(ticketSigningSecret()))
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ticketSigningSecret reads the signing key from the environment.
+// Use a fallback for local dev, but require it in production
+func ticketSigningSecret() string {
+	secret := os.Getenv("TICKET_SIGNING_SECRET")
+	if secret == "" {
+		secret = "local-dev-secret-key-12345"
+	}
+	return secret
+}
+
 // GenerateUniqueTransactionReference remains largely the same but cleaned up
 func GenerateUniqueTransactionReference() string {
 	timestamp := time.Now().UnixMilli()